@@ -9,8 +9,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	io "io"
 	_ "istio.io/gogo-genproto/googleapis/google/api"
 	_ "k8s.io/api/apps/v1"
@@ -31,6 +31,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ConditionStatus int32
+
+const (
+	ConditionStatus_Unknown ConditionStatus = 0
+	ConditionStatus_True    ConditionStatus = 1
+	ConditionStatus_False   ConditionStatus = 2
+)
+
+var ConditionStatus_name = map[int32]string{
+	0: "Unknown",
+	1: "True",
+	2: "False",
+}
+
+var ConditionStatus_value = map[string]int32{
+	"Unknown": 0,
+	"True":    1,
+	"False":   2,
+}
+
+func (x ConditionStatus) String() string {
+	return proto.EnumName(ConditionStatus_name, int32(x))
+}
+
+func (ConditionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_53057eb05156167c, []int{0}
+}
+
+type ApplyResult int32
+
+const (
+	ApplyResult_NotApplied  ApplyResult = 0
+	ApplyResult_Applied     ApplyResult = 1
+	ApplyResult_ApplyFailed ApplyResult = 2
+)
+
+var ApplyResult_name = map[int32]string{
+	0: "NotApplied",
+	1: "Applied",
+	2: "ApplyFailed",
+}
+
+var ApplyResult_value = map[string]int32{
+	"NotApplied":  0,
+	"Applied":     1,
+	"ApplyFailed": 2,
+}
+
+func (x ApplyResult) String() string {
+	return proto.EnumName(ApplyResult_name, int32(x))
+}
+
+func (ApplyResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_53057eb05156167c, []int{1}
+}
+
 type ConfigState int32
 
 const (
@@ -65,7 +121,7 @@ func (x ConfigState) String() string {
 }
 
 func (ConfigState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_53057eb05156167c, []int{0}
+	return fileDescriptor_53057eb05156167c, []int{2}
 }
 
 type K8SResourceOverlayPatch_Type int32
@@ -1749,6 +1805,270 @@ func (m *K8SResourceOverlayPatch_Patch) GetType() K8SResourceOverlayPatch_Type {
 	return K8SResourceOverlayPatch_unspecified
 }
 
+// Condition contains details for one aspect of the current state of a resource
+type Condition struct {
+	// Type of the condition in CamelCase
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status of the condition
+	Status ConditionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConditionStatus" json:"status,omitempty"`
+	// Generation of the resource the condition was set based upon
+	ObservedGeneration int64 `protobuf:"varint,3,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	// Last time the condition transitioned from one status to another
+	LastTransitionTime *types.Timestamp `protobuf:"bytes,4,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	// Reason for the last transition of the condition in CamelCase
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Human readable message with details about the last transition
+	Message              string   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53057eb05156167c, []int{13}
+}
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Condition) GetStatus() ConditionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ConditionStatus_Unknown
+}
+
+func (m *Condition) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func (m *Condition) GetLastTransitionTime() *types.Timestamp {
+	if m != nil {
+		return m.LastTransitionTime
+	}
+	return nil
+}
+
+func (m *Condition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Condition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// ComponentStatus describes the reconciliation state and the objects of a component of the operator
+type ComponentStatus struct {
+	// Name of the component
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Reconciliation status of the component
+	Status ConfigState `protobuf:"varint,2,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
+	// Objects rendered and applied by the component during the last reconciliation
+	Resources            []ResourceStatus `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ComponentStatus) Reset()         { *m = ComponentStatus{} }
+func (m *ComponentStatus) String() string { return proto.CompactTextString(m) }
+func (*ComponentStatus) ProtoMessage()    {}
+func (*ComponentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53057eb05156167c, []int{14}
+}
+func (m *ComponentStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComponentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComponentStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComponentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentStatus.Merge(m, src)
+}
+func (m *ComponentStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComponentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComponentStatus proto.InternalMessageInfo
+
+func (m *ComponentStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ComponentStatus) GetStatus() ConfigState {
+	if m != nil {
+		return m.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (m *ComponentStatus) GetResources() []ResourceStatus {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// ResourceStatus describes an object rendered and applied by a component of the operator
+type ResourceStatus struct {
+	// API version of the object
+	ApiVersion string `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	// Kind of the object
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Namespace of the object, empty for cluster scoped objects
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the object
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// SHA256 hash of the desired state of the object
+	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Result of the last apply of the object
+	LastApplyResult ApplyResult `protobuf:"varint,6,opt,name=lastApplyResult,proto3,enum=istio_operator.v2.api.v1alpha1.ApplyResult" json:"lastApplyResult,omitempty"`
+	// Error message of the last apply if it failed
+	Message              string   `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceStatus) Reset()         { *m = ResourceStatus{} }
+func (m *ResourceStatus) String() string { return proto.CompactTextString(m) }
+func (*ResourceStatus) ProtoMessage()    {}
+func (*ResourceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53057eb05156167c, []int{15}
+}
+func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceStatus.Merge(m, src)
+}
+func (m *ResourceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
+
+func (m *ResourceStatus) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetLastApplyResult() ApplyResult {
+	if m != nil {
+		return m.LastApplyResult
+	}
+	return ApplyResult_NotApplied
+}
+
+func (m *ResourceStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 // Quantity is a fixed-point representation of a number. It provides convenient marshaling/unmarshaling in JSON and YAML, in addition to String() and Int64() accessors.
 // +cue-gen-param:intorstring=true
 // +cue-gen-param:set=pattern:^(\\+|-)?(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\\+|-)?(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))))?$
@@ -1757,6 +2077,8 @@ func (m *K8SResourceOverlayPatch_Patch) GetType() K8SResourceOverlayPatch_Type {
 // +cue-gen-param:intorstring=true
 
 func init() {
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ConditionStatus", ConditionStatus_name, ConditionStatus_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ApplyResult", ApplyResult_name, ApplyResult_value)
 	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ConfigState", ConfigState_name, ConfigState_value)
 	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.K8SResourceOverlayPatch_Type", K8SResourceOverlayPatch_Type_name, K8SResourceOverlayPatch_Type_value)
 	proto.RegisterType((*K8SObjectMeta)(nil), "istio_operator.v2.api.v1alpha1.K8sObjectMeta")
@@ -1782,137 +2104,157 @@ func init() {
 	proto.RegisterType((*K8SResourceOverlayPatch)(nil), "istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch")
 	proto.RegisterType((*K8SResourceOverlayPatch_GroupVersionKind)(nil), "istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.GroupVersionKind")
 	proto.RegisterType((*K8SResourceOverlayPatch_Patch)(nil), "istio_operator.v2.api.v1alpha1.K8sResourceOverlayPatch.Patch")
+	proto.RegisterType((*Condition)(nil), "istio_operator.v2.api.v1alpha1.Condition")
+	proto.RegisterType((*ComponentStatus)(nil), "istio_operator.v2.api.v1alpha1.ComponentStatus")
+	proto.RegisterType((*ResourceStatus)(nil), "istio_operator.v2.api.v1alpha1.ResourceStatus")
 }
 
 func init() { proto.RegisterFile("api/v1alpha1/common.proto", fileDescriptor_53057eb05156167c) }
 
 var fileDescriptor_53057eb05156167c = []byte{
-	// 1994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x0f, 0x29, 0xca, 0x22, 0x1f, 0x25, 0x99, 0x59, 0x3b, 0x13, 0x84, 0xf1, 0xc8, 0x1e, 0xb6,
-	0x93, 0x51, 0xff, 0x04, 0x8c, 0x65, 0x67, 0xea, 0xb8, 0xad, 0x13, 0x51, 0x8e, 0x53, 0x55, 0xfe,
-	0x43, 0x43, 0x96, 0x0e, 0x9e, 0xb4, 0xee, 0x12, 0x78, 0x02, 0xb7, 0x06, 0x77, 0x91, 0xc5, 0x82,
-	0x15, 0x7d, 0xea, 0xf4, 0xd0, 0x5e, 0xfa, 0x01, 0x7a, 0xec, 0x4c, 0xbf, 0x40, 0xbf, 0x41, 0x8f,
-	0xcd, 0x4c, 0xa7, 0x33, 0xed, 0x17, 0x48, 0x3b, 0x3e, 0xf6, 0xd8, 0x63, 0x4f, 0x9d, 0x5d, 0x00,
-	0x14, 0x48, 0xc2, 0xa1, 0x44, 0xdb, 0xb7, 0x5c, 0x24, 0xec, 0xe2, 0xbd, 0xdf, 0xfb, 0xb3, 0xfb,
-	0xdb, 0xf7, 0x16, 0x84, 0x77, 0x68, 0xc8, 0xda, 0xc3, 0xab, 0x34, 0x08, 0xfb, 0xf4, 0x6a, 0xdb,
-	0x15, 0x83, 0x81, 0xe0, 0x76, 0x28, 0x85, 0x12, 0x64, 0x83, 0x45, 0x8a, 0x89, 0x27, 0x22, 0x44,
-	0x49, 0x95, 0x90, 0xf6, 0x70, 0xcb, 0xa6, 0x21, 0xb3, 0x33, 0xe1, 0xe6, 0x86, 0x2f, 0x84, 0x1f,
-	0x60, 0xdb, 0x48, 0xf7, 0xe2, 0xa3, 0xf6, 0xaf, 0x24, 0x0d, 0x43, 0x94, 0x51, 0xa2, 0xdf, 0xbc,
-	0xe8, 0x0b, 0x5f, 0x98, 0xc7, 0xb6, 0x7e, 0x4a, 0x67, 0x2f, 0xa7, 0x5a, 0xda, 0xee, 0x11, 0xc3,
-	0xc0, 0x7b, 0xd2, 0xc3, 0x3e, 0x1d, 0x32, 0x21, 0x53, 0x81, 0xd6, 0xd3, 0x1b, 0x91, 0xcd, 0x84,
-	0x11, 0x70, 0x85, 0xc4, 0xf6, 0xf0, 0x6a, 0xdb, 0x47, 0xae, 0x9d, 0x40, 0xaf, 0x40, 0x86, 0x86,
-	0x61, 0x54, 0x24, 0x73, 0xfd, 0x44, 0x66, 0x40, 0xdd, 0x3e, 0xe3, 0x28, 0x47, 0xed, 0xf0, 0xa9,
-	0xaf, 0x27, 0xa2, 0xf6, 0x00, 0x15, 0x2d, 0xd2, 0xba, 0x32, 0x1d, 0x94, 0x87, 0x91, 0x2b, 0x59,
-	0xa8, 0xc6, 0xfe, 0x99, 0x8c, 0x89, 0x50, 0x31, 0xc1, 0xa3, 0xec, 0x7f, 0xf2, 0xaa, 0xf5, 0x97,
-	0x32, 0xac, 0xed, 0xdd, 0x88, 0x1e, 0xf4, 0x7e, 0x89, 0xae, 0xba, 0x87, 0x8a, 0x92, 0x87, 0x70,
-	0x2e, 0xa0, 0x3d, 0x0c, 0x22, 0xab, 0x7e, 0x65, 0x69, 0xb3, 0xbe, 0xf5, 0x91, 0xfd, 0xf5, 0x49,
-	0xb5, 0x27, 0xd4, 0xed, 0xbb, 0x46, 0xf7, 0x53, 0xae, 0xe4, 0xc8, 0x49, 0x81, 0xc8, 0x2f, 0xa0,
-	0x4e, 0x39, 0x17, 0x8a, 0x1a, 0xcb, 0xd6, 0xaa, 0xc1, 0xbd, 0x75, 0x36, 0xdc, 0xed, 0x13, 0x80,
-	0x04, 0x3c, 0x0f, 0xd9, 0xfc, 0x08, 0xea, 0x39, 0xc3, 0xa4, 0x01, 0x4b, 0x4f, 0x71, 0x64, 0x95,
-	0xae, 0x94, 0x36, 0x6b, 0x8e, 0x7e, 0x24, 0x17, 0x61, 0x79, 0x48, 0x83, 0x18, 0xad, 0xb2, 0x99,
-	0x4b, 0x06, 0x37, 0xcb, 0x37, 0x4a, 0xcd, 0x5b, 0xd0, 0x98, 0xc6, 0x3e, 0x8b, 0x7e, 0xeb, 0xaf,
-	0x25, 0x78, 0x77, 0x47, 0x70, 0x45, 0xf5, 0x72, 0xed, 0x0e, 0xa8, 0x8f, 0x3b, 0x82, 0x1f, 0x31,
-	0x3f, 0x96, 0x06, 0x51, 0x63, 0xf5, 0xe3, 0x5e, 0x86, 0xd5, 0x8f, 0x7b, 0x7a, 0x46, 0x51, 0x3f,
-	0x45, 0xd2, 0x8f, 0x64, 0x13, 0xce, 0x33, 0xad, 0xd9, 0x8d, 0x83, 0xa0, 0x2b, 0x02, 0xe6, 0x8e,
-	0xac, 0x25, 0xf3, 0x76, 0x7a, 0x9a, 0x3c, 0x86, 0xc6, 0x78, 0x6a, 0x1f, 0x5d, 0x89, 0x2a, 0xb2,
-	0x2a, 0x26, 0x9f, 0x9b, 0x76, 0xb2, 0x7b, 0x4c, 0x12, 0xf5, 0x2e, 0xb4, 0x87, 0x57, 0xed, 0xbb,
-	0xc2, 0xa5, 0x41, 0x92, 0x45, 0x07, 0x8f, 0x50, 0x22, 0x77, 0xb1, 0x53, 0xf9, 0xf2, 0xab, 0xcb,
-	0x6f, 0x38, 0x33, 0x38, 0xad, 0xaf, 0xca, 0xf0, 0xed, 0x0e, 0x8d, 0x70, 0x2f, 0xee, 0xa1, 0xe4,
-	0xa8, 0x30, 0x1a, 0xc7, 0x35, 0x19, 0xd2, 0x45, 0x58, 0x36, 0xca, 0x69, 0x50, 0xc9, 0x80, 0x6c,
-	0xc1, 0x12, 0xf2, 0xa1, 0x55, 0x36, 0xde, 0x34, 0x8b, 0xbc, 0xf9, 0x94, 0x0f, 0x0f, 0xa9, 0x4c,
-	0xed, 0x6b, 0x61, 0xe2, 0x40, 0x4d, 0x62, 0x24, 0x62, 0xe9, 0x62, 0x64, 0x42, 0xae, 0x6f, 0x5d,
-	0x9f, 0xb7, 0x2f, 0x9c, 0x54, 0xc1, 0xc1, 0x2f, 0x62, 0x26, 0x71, 0x80, 0x5c, 0x45, 0xce, 0x09,
-	0x0c, 0xb9, 0x07, 0xe7, 0x23, 0x74, 0x63, 0xc9, 0xd4, 0x48, 0xfb, 0x8f, 0xc7, 0xca, 0xaa, 0x18,
-	0xe4, 0x6f, 0x15, 0xf9, 0xb4, 0x3f, 0x29, 0xea, 0x4c, 0xeb, 0x92, 0x5d, 0x58, 0x1d, 0x8a, 0x20,
-	0x1e, 0xe0, 0x3d, 0x11, 0x73, 0x15, 0x59, 0xcb, 0x26, 0xbe, 0xcb, 0x45, 0x58, 0x87, 0x27, 0x72,
-	0x69, 0x90, 0x13, 0xaa, 0xad, 0xdf, 0xae, 0xc2, 0xa5, 0xc9, 0x04, 0x67, 0xb1, 0x24, 0xf9, 0x25,
-	0xbb, 0x50, 0xd5, 0x2c, 0xf7, 0xa8, 0xa2, 0x26, 0xb7, 0xf5, 0xad, 0xf7, 0xcf, 0xc4, 0x12, 0x67,
-	0xac, 0x7e, 0xb2, 0x46, 0xe5, 0x82, 0x35, 0x5a, 0x5a, 0x78, 0x8d, 0x2a, 0xaf, 0x66, 0x8d, 0x24,
-	0xac, 0x72, 0xe1, 0xe1, 0x3e, 0x06, 0xe8, 0x2a, 0x21, 0xd3, 0xa4, 0xde, 0x9f, 0x07, 0xfb, 0x75,
-	0xc9, 0xb3, 0xef, 0xe7, 0x00, 0x93, 0x23, 0x62, 0xc2, 0x06, 0xb9, 0x01, 0x55, 0x7a, 0x74, 0xc4,
-	0x38, 0x53, 0x23, 0xeb, 0x9c, 0x09, 0xe3, 0x52, 0x51, 0x02, 0xb6, 0x53, 0x19, 0x67, 0x2c, 0x5d,
-	0xb4, 0xa3, 0x56, 0x5e, 0x62, 0x47, 0x15, 0xb0, 0xbd, 0x7a, 0x7a, 0xb6, 0xd7, 0x5e, 0x0d, 0xdb,
-	0xc9, 0xf7, 0xe1, 0xcd, 0x50, 0x32, 0x61, 0x1c, 0x0b, 0x68, 0x14, 0xdd, 0xa7, 0x03, 0xb4, 0xc0,
-	0xf8, 0x31, 0xfb, 0x82, 0xdc, 0x81, 0xba, 0x12, 0x01, 0xca, 0xf4, 0x08, 0x4f, 0x4a, 0xc3, 0x46,
-	0x91, 0x13, 0x8f, 0xc6, 0x62, 0xa9, 0xe9, 0xbc, 0x22, 0xb9, 0x09, 0x2b, 0x09, 0x25, 0xb2, 0x32,
-	0xd0, 0x7c, 0x31, 0x91, 0x52, 0xfd, 0x4c, 0x61, 0x86, 0x89, 0x6b, 0x0b, 0x33, 0x91, 0xdc, 0x86,
-	0xaa, 0xc4, 0x30, 0x60, 0x2e, 0x8d, 0xac, 0x75, 0xb3, 0x94, 0x9b, 0xf3, 0xb7, 0x74, 0x22, 0xef,
-	0x8c, 0x35, 0xc9, 0x03, 0xa8, 0x87, 0xc2, 0xbb, 0x97, 0x31, 0xf6, 0xfc, 0x22, 0x8c, 0xcd, 0x23,
-	0x10, 0x84, 0x0b, 0xa1, 0xf0, 0x6e, 0xb3, 0x48, 0xc6, 0xa6, 0x4a, 0x77, 0x62, 0xcf, 0x47, 0x65,
-	0x35, 0x0c, 0xf0, 0xb5, 0x79, 0xc0, 0xdd, 0x59, 0x55, 0xa7, 0x08, 0x8f, 0xf4, 0x80, 0x78, 0x18,
-	0x06, 0x62, 0xa4, 0x79, 0xb9, 0xaf, 0x74, 0x33, 0xe1, 0x8f, 0xac, 0x37, 0x8d, 0x95, 0xad, 0x79,
-	0x56, 0x6e, 0xcf, 0x68, 0x3a, 0x05, 0x68, 0xe4, 0x10, 0x48, 0x28, 0xbc, 0x29, 0x2e, 0x58, 0xc4,
-	0xd8, 0x78, 0xaf, 0x68, 0xc9, 0xba, 0x33, 0xd2, 0x4e, 0x01, 0x02, 0xf9, 0x18, 0xd6, 0x02, 0x36,
-	0x44, 0x8e, 0x51, 0xd4, 0x95, 0xa2, 0x87, 0xd6, 0x05, 0x03, 0xf9, 0x4e, 0x21, 0xa4, 0x16, 0x70,
-	0x26, 0xe5, 0xc9, 0x36, 0xac, 0x4b, 0xa4, 0x1e, 0x3b, 0x41, 0xb8, 0x38, 0x0f, 0x61, 0x4a, 0xa1,
-	0xf9, 0x31, 0xbc, 0x39, 0x73, 0xd8, 0x9c, 0xa9, 0x67, 0xf8, 0x4f, 0x19, 0xc8, 0x6c, 0x1e, 0x09,
-	0x81, 0x8a, 0x1a, 0x85, 0x59, 0x59, 0x35, 0xcf, 0x24, 0x84, 0x35, 0x29, 0x82, 0x80, 0x71, 0xff,
-	0x20, 0xf4, 0xa8, 0x4a, 0xc0, 0xea, 0x5b, 0x3f, 0x3d, 0xfb, 0x32, 0xd9, 0x4e, 0x1e, 0xe7, 0xe4,
-	0xbd, 0x33, 0x69, 0xa0, 0xf9, 0xf7, 0x12, 0xbc, 0xfd, 0x02, 0x51, 0xf2, 0x73, 0x58, 0x1f, 0xd0,
-	0xe3, 0x03, 0x4e, 0x87, 0x94, 0x05, 0xb4, 0x17, 0x60, 0x5a, 0xa6, 0xbe, 0x37, 0xcf, 0x9d, 0x5d,
-	0xae, 0x1e, 0xc8, 0x7d, 0x25, 0x19, 0xf7, 0x3b, 0xb5, 0xff, 0xfd, 0xe6, 0x77, 0x4b, 0x15, 0x25,
-	0x63, 0x74, 0xa6, 0xd0, 0x88, 0x03, 0xd5, 0x01, 0x3d, 0xde, 0x8f, 0xa5, 0x9f, 0x05, 0xba, 0x28,
-	0xf2, 0x18, 0xa7, 0xf5, 0xcf, 0x12, 0x5c, 0x28, 0xa0, 0x06, 0x79, 0x0c, 0xab, 0x03, 0xc6, 0xb7,
-	0x5f, 0x51, 0x24, 0x13, 0x58, 0x05, 0x79, 0x2a, 0xbf, 0xca, 0x3c, 0xb5, 0x7e, 0xbd, 0x02, 0x2b,
-	0xfb, 0x28, 0x87, 0xcc, 0xc5, 0x89, 0xa6, 0xa1, 0xf1, 0x72, 0x4d, 0xc3, 0x1e, 0x2c, 0x87, 0x42,
-	0xaa, 0xc8, 0x2a, 0x99, 0xa3, 0x75, 0xae, 0xb7, 0xa9, 0x0b, 0x5d, 0x21, 0x55, 0xa7, 0xfa, 0x7c,
-	0xbb, 0x54, 0x36, 0x47, 0x6d, 0x82, 0x41, 0x1e, 0x42, 0x35, 0xca, 0xea, 0x7b, 0xd2, 0x14, 0x7e,
-	0x78, 0x4a, 0x3c, 0x7b, 0xb2, 0x8c, 0x8f, 0x61, 0xc8, 0x25, 0xa8, 0xb9, 0x41, 0x1c, 0x29, 0x94,
-	0xbb, 0xdd, 0xb4, 0x43, 0x3e, 0x99, 0x20, 0x56, 0x4a, 0x1f, 0xdd, 0xa3, 0xd4, 0x3a, 0x15, 0xed,
-	0x4f, 0x4a, 0xa2, 0x2b, 0x50, 0xc7, 0x63, 0x85, 0x92, 0xd3, 0x60, 0xb7, 0x9b, 0xb4, 0x70, 0x35,
-	0x27, 0x3f, 0xa5, 0x6b, 0x72, 0x84, 0x51, 0xc4, 0x04, 0xcf, 0xea, 0xbf, 0x29, 0xf1, 0x35, 0x67,
-	0x7a, 0x9a, 0xbc, 0x07, 0xeb, 0x81, 0xa0, 0x5e, 0x87, 0x06, 0x94, 0xbb, 0xc6, 0x91, 0xa4, 0x78,
-	0x4f, 0xcd, 0x92, 0x9b, 0x60, 0xe5, 0x67, 0xf6, 0x93, 0x7e, 0x88, 0x72, 0x1f, 0x93, 0x1a, 0x5e,
-	0x73, 0x5e, 0xf8, 0x9e, 0xb4, 0x60, 0x35, 0x73, 0x2e, 0x57, 0x96, 0x27, 0xe6, 0xc8, 0x75, 0x78,
-	0x2b, 0x1b, 0x3f, 0x92, 0xba, 0x55, 0x71, 0xd3, 0x5e, 0xa2, 0x6e, 0x84, 0x8b, 0x5f, 0x92, 0x0f,
-	0xe0, 0x42, 0x1f, 0x69, 0xa0, 0xfa, 0x3b, 0x7d, 0x74, 0x9f, 0xea, 0x53, 0x4c, 0x2f, 0x9e, 0xb5,
-	0x7a, 0xa5, 0xb4, 0xb9, 0xec, 0x14, 0xbd, 0x22, 0x9f, 0x83, 0x15, 0xc6, 0xbd, 0x80, 0x45, 0xfd,
-	0xfb, 0x42, 0x39, 0x48, 0xbd, 0xd1, 0xb6, 0xe7, 0x49, 0x8c, 0x22, 0xd4, 0x15, 0xb8, 0x64, 0x4a,
-	0x78, 0x72, 0x03, 0xb5, 0xb3, 0x1b, 0xa8, 0xdd, 0x11, 0x22, 0x38, 0xd4, 0xa7, 0x5d, 0xa7, 0xf2,
-	0xc7, 0x7f, 0x5d, 0x2e, 0x39, 0x2f, 0x44, 0x20, 0x4f, 0xe0, 0xad, 0xa9, 0x04, 0x27, 0xdd, 0x5c,
-	0x5a, 0x95, 0xbf, 0x53, 0xdc, 0x60, 0x15, 0x28, 0x38, 0xc5, 0x38, 0xa4, 0x09, 0x55, 0x16, 0xde,
-	0xa1, 0x03, 0x16, 0x8c, 0x4c, 0x81, 0xae, 0x39, 0xe3, 0x71, 0xf3, 0x87, 0xb0, 0xb6, 0xf8, 0x19,
-	0xfe, 0xfb, 0x15, 0x20, 0x07, 0x5c, 0x87, 0x8c, 0xae, 0x42, 0xef, 0x35, 0xb0, 0xf1, 0xb3, 0x97,
-	0x60, 0x63, 0x25, 0xcf, 0xc4, 0xcf, 0x67, 0x98, 0xf8, 0xc9, 0x3c, 0xac, 0xd9, 0xc8, 0x16, 0x24,
-	0x25, 0xc9, 0x93, 0xf2, 0x1b, 0x3a, 0x7e, 0x43, 0xc7, 0xd7, 0x44, 0xc7, 0xbf, 0x95, 0xa0, 0x9e,
-	0x23, 0x80, 0xde, 0x77, 0x5c, 0xaf, 0x55, 0xda, 0x4b, 0xe9, 0x67, 0x6d, 0xdc, 0xe4, 0xc4, 0x15,
-	0x41, 0x0a, 0x30, 0x1e, 0xeb, 0xe2, 0xa1, 0xc9, 0x62, 0x36, 0xf0, 0x72, 0x56, 0x3c, 0xf4, 0x0c,
-	0x39, 0x04, 0x50, 0x54, 0xfa, 0xa8, 0xcc, 0xd2, 0x54, 0x5e, 0xaa, 0x8e, 0xe7, 0x90, 0xb4, 0x37,
-	0x3c, 0x5b, 0xf0, 0x65, 0xb3, 0xe0, 0xe3, 0x71, 0xab, 0x03, 0xeb, 0x7a, 0x57, 0x45, 0x21, 0x75,
-	0xd1, 0x33, 0xfb, 0xab, 0x28, 0x9e, 0x4b, 0x50, 0xe3, 0x99, 0x54, 0x1a, 0xd0, 0xc9, 0x44, 0xeb,
-	0xcf, 0x4b, 0x70, 0xb1, 0xe8, 0x1e, 0x4e, 0x7a, 0x70, 0x2e, 0x60, 0x03, 0x36, 0x3e, 0x58, 0x3e,
-	0x59, 0xe4, 0x36, 0x6f, 0xdf, 0x35, 0x10, 0x66, 0xa1, 0x3a, 0x55, 0x1d, 0xe1, 0xd2, 0x80, 0x86,
-	0x4e, 0x8a, 0x4c, 0xfa, 0xfa, 0x82, 0xf5, 0x45, 0x8c, 0x91, 0x8a, 0xd2, 0x23, 0xa7, 0xb3, 0x90,
-	0x15, 0x27, 0x05, 0x99, 0xb6, 0x33, 0x46, 0x6f, 0xba, 0x50, 0xcf, 0xb9, 0x52, 0xb0, 0x67, 0x6e,
-	0xe5, 0xf7, 0xcc, 0x29, 0x2e, 0x7a, 0x0f, 0x63, 0xca, 0x15, 0x53, 0xa3, 0xfc, 0x47, 0x42, 0x84,
-	0xb5, 0x09, 0x4f, 0x5e, 0x8f, 0x99, 0xd6, 0x1f, 0xca, 0x50, 0xcd, 0xee, 0x99, 0xe4, 0x07, 0xb0,
-	0xec, 0xea, 0xdb, 0x6a, 0xda, 0x98, 0xbe, 0x3b, 0x43, 0xeb, 0x5d, 0xae, 0xae, 0x6d, 0xe5, 0x79,
-	0x9d, 0xc8, 0x93, 0x6b, 0xb0, 0x34, 0x60, 0x3c, 0xf5, 0xe3, 0x14, 0x6a, 0x5a, 0xda, 0x28, 0xd1,
-	0xe3, 0xf4, 0x1b, 0xdc, 0xa9, 0x94, 0xe8, 0x31, 0x61, 0xb0, 0x91, 0x6c, 0xe8, 0x9d, 0xee, 0xc1,
-	0x81, 0x62, 0x01, 0x7b, 0x66, 0xae, 0xf9, 0x5d, 0x94, 0x2e, 0x72, 0x45, 0x7d, 0x4c, 0xe9, 0x72,
-	0x0a, 0xbc, 0x39, 0x40, 0xad, 0xff, 0x56, 0xe0, 0xed, 0xbd, 0x1b, 0xe3, 0x6f, 0x3e, 0x0f, 0x86,
-	0x28, 0x03, 0x3a, 0xea, 0x52, 0xe5, 0xf6, 0xc9, 0x33, 0x68, 0xf8, 0x52, 0xc4, 0xe1, 0x21, 0x4a,
-	0x7d, 0xe4, 0xec, 0x31, 0xee, 0xa5, 0x49, 0xfb, 0xc9, 0x29, 0x6a, 0x6f, 0x11, 0xa4, 0xfd, 0xd9,
-	0x14, 0x5e, 0xf6, 0x19, 0x65, 0xda, 0x0e, 0xb9, 0x0b, 0x35, 0x61, 0x8a, 0xf7, 0x1e, 0x8e, 0xd2,
-	0x94, 0xdb, 0xf3, 0x8c, 0x4e, 0x52, 0xdb, 0x39, 0x01, 0x20, 0x3f, 0x83, 0x95, 0x50, 0xdb, 0x37,
-	0x5f, 0x43, 0x35, 0x6b, 0x7e, 0xbc, 0x68, 0x00, 0xe6, 0x6f, 0xf6, 0x05, 0x25, 0xc5, 0x6c, 0x1e,
-	0x42, 0x63, 0x3a, 0x30, 0x7d, 0xb0, 0x3c, 0xcd, 0x12, 0x56, 0x73, 0xcc, 0x33, 0xb1, 0x60, 0x65,
-	0x98, 0x88, 0xa4, 0xc7, 0x4a, 0x36, 0xd4, 0x07, 0xb0, 0x49, 0x41, 0x5a, 0xe8, 0x93, 0x41, 0xf3,
-	0x4f, 0x25, 0x58, 0x4e, 0x96, 0x82, 0x40, 0x25, 0xa4, 0xaa, 0x9f, 0xa1, 0xe9, 0xe7, 0xe2, 0x43,
-	0x9b, 0x6c, 0x00, 0x84, 0x54, 0x46, 0x68, 0x36, 0x81, 0x81, 0xab, 0x3a, 0xb9, 0x19, 0xd2, 0xcd,
-	0x35, 0x0e, 0xeb, 0x5b, 0x3f, 0x5a, 0x34, 0x0f, 0x8f, 0x46, 0x21, 0x26, 0x6d, 0x47, 0xeb, 0x03,
-	0xa8, 0xe8, 0x11, 0x39, 0x0f, 0xf5, 0x98, 0x47, 0x21, 0xba, 0xec, 0x88, 0xa1, 0xd7, 0x78, 0x83,
-	0xd4, 0x61, 0x45, 0x62, 0x18, 0x50, 0x17, 0x1b, 0x25, 0x02, 0x70, 0x4e, 0xe2, 0x40, 0x0c, 0xb1,
-	0x51, 0x6e, 0x01, 0x54, 0x33, 0x9a, 0xb6, 0xd6, 0xa0, 0x9e, 0x3b, 0xd4, 0xbf, 0x2b, 0xa0, 0x9e,
-	0x94, 0xb4, 0x7d, 0x45, 0x95, 0xc1, 0x3c, 0x98, 0xc6, 0xdc, 0x91, 0x48, 0x15, 0x7a, 0x8d, 0x12,
-	0xb9, 0x00, 0xe7, 0x1d, 0x74, 0x05, 0x77, 0x59, 0x80, 0x77, 0x28, 0x0b, 0xd0, 0x6b, 0x94, 0xb5,
-	0x4a, 0x36, 0xc9, 0xb8, 0xdf, 0x58, 0x22, 0x6b, 0x50, 0x1b, 0xdf, 0x20, 0x1b, 0x15, 0x3d, 0x3c,
-	0xe0, 0x03, 0xca, 0xa9, 0x8f, 0x5e, 0x63, 0xb9, 0xb3, 0xf3, 0xe5, 0xf3, 0x8d, 0xd2, 0x3f, 0x9e,
-	0x6f, 0x94, 0xfe, 0xfd, 0x7c, 0xa3, 0xf4, 0xf8, 0x43, 0x9f, 0xa9, 0x7e, 0xdc, 0xb3, 0x5d, 0x31,
-	0x68, 0xf7, 0x28, 0x7f, 0x46, 0x99, 0x1b, 0x88, 0xd8, 0x6b, 0x9b, 0x2c, 0xbd, 0x9f, 0x65, 0xa9,
-	0x3d, 0xdc, 0x6a, 0xe7, 0x7f, 0x2d, 0xeb, 0x9d, 0x33, 0x04, 0xbc, 0xf6, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x02, 0xf3, 0x37, 0x44, 0x1b, 0x00, 0x00,
+	// 2268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x72, 0x23, 0xb7,
+	0xd5, 0x36, 0x2f, 0x92, 0xc8, 0x43, 0x5d, 0x68, 0xcc, 0xf8, 0x77, 0x9b, 0x9e, 0xd2, 0xa8, 0xf8,
+	0xa7, 0x5c, 0x8a, 0x1d, 0x93, 0x1e, 0x8d, 0x5d, 0x19, 0xdb, 0x89, 0x6d, 0x51, 0xf6, 0x4c, 0x64,
+	0xcd, 0x85, 0x6e, 0x5d, 0x16, 0x2e, 0x27, 0x0e, 0xd8, 0x7d, 0x44, 0x22, 0x6a, 0x02, 0x6d, 0x00,
+	0x4d, 0x8b, 0x5e, 0xa5, 0xb2, 0x48, 0x36, 0x59, 0xa7, 0xb2, 0x4c, 0x55, 0x5e, 0x20, 0x4f, 0x90,
+	0x2c, 0xe3, 0xaa, 0x54, 0xaa, 0x92, 0x17, 0x70, 0x52, 0xb3, 0xcc, 0x32, 0xcb, 0x64, 0x93, 0x02,
+	0xba, 0x9b, 0x6a, 0x92, 0x3d, 0x96, 0x46, 0x33, 0xde, 0x79, 0x23, 0x35, 0x80, 0x73, 0x3e, 0x9c,
+	0x0b, 0x3e, 0x1c, 0x00, 0x84, 0x17, 0x68, 0xc8, 0xda, 0xa3, 0x1b, 0x34, 0x08, 0x07, 0xf4, 0x46,
+	0xdb, 0x13, 0xc3, 0xa1, 0xe0, 0xad, 0x50, 0x0a, 0x2d, 0xc8, 0x3a, 0x53, 0x9a, 0x89, 0x4f, 0x45,
+	0x88, 0x92, 0x6a, 0x21, 0x5b, 0xa3, 0xad, 0x16, 0x0d, 0x59, 0x2b, 0x15, 0x6e, 0xac, 0xf7, 0x85,
+	0xe8, 0x07, 0xd8, 0xb6, 0xd2, 0xbd, 0xe8, 0xb8, 0xfd, 0xb9, 0xa4, 0x61, 0x88, 0x52, 0xc5, 0xfa,
+	0x8d, 0xeb, 0xb3, 0xe3, 0x9a, 0x0d, 0x51, 0x69, 0x3a, 0x0c, 0x13, 0x81, 0xab, 0x7d, 0xd1, 0x17,
+	0xf6, 0xb3, 0x6d, 0xbe, 0x66, 0xd4, 0x8c, 0x61, 0xc7, 0x0c, 0x03, 0xff, 0xd3, 0x1e, 0x0e, 0xe8,
+	0x88, 0x09, 0x99, 0x08, 0x34, 0x4f, 0x6e, 0xa9, 0x16, 0x13, 0x56, 0xc0, 0x13, 0x12, 0xdb, 0xa3,
+	0x1b, 0xed, 0x3e, 0x72, 0x63, 0x25, 0xfa, 0x39, 0x32, 0x34, 0x0c, 0x55, 0x9e, 0xcc, 0xeb, 0x67,
+	0x32, 0x43, 0xea, 0x0d, 0x18, 0x47, 0x39, 0x6e, 0x87, 0x27, 0x7d, 0xd3, 0xa1, 0xda, 0x43, 0xd4,
+	0x34, 0x4f, 0x6b, 0x63, 0xd6, 0x2b, 0x1f, 0x95, 0x27, 0x59, 0xa8, 0x27, 0xf6, 0xd9, 0x90, 0x8a,
+	0x50, 0x33, 0xc1, 0x55, 0xfa, 0x3f, 0x1e, 0x6a, 0xfe, 0xa9, 0x08, 0x2b, 0x7b, 0xb7, 0xd4, 0x83,
+	0xde, 0xcf, 0xd0, 0xd3, 0xf7, 0x50, 0x53, 0xf2, 0x11, 0x2c, 0x06, 0xb4, 0x87, 0x81, 0x72, 0x6a,
+	0x1b, 0xa5, 0xcd, 0xda, 0xd6, 0x9b, 0xad, 0xaf, 0x8f, 0x7a, 0x6b, 0x4a, 0xbd, 0x75, 0xd7, 0xea,
+	0x7e, 0xc0, 0xb5, 0x1c, 0xbb, 0x09, 0x10, 0xf9, 0x29, 0xd4, 0x28, 0xe7, 0x42, 0x53, 0x3b, 0xb3,
+	0xb3, 0x6c, 0x71, 0xdf, 0x79, 0x3c, 0xdc, 0xed, 0x33, 0x80, 0x18, 0x3c, 0x0b, 0xd9, 0x78, 0x13,
+	0x6a, 0x99, 0x89, 0x49, 0x1d, 0x4a, 0x27, 0x38, 0x76, 0x0a, 0x1b, 0x85, 0xcd, 0xaa, 0x6b, 0x3e,
+	0xc9, 0x55, 0x58, 0x18, 0xd1, 0x20, 0x42, 0xa7, 0x68, 0xfb, 0xe2, 0xc6, 0x5b, 0xc5, 0x5b, 0x85,
+	0xc6, 0x3b, 0x50, 0x9f, 0xc5, 0x7e, 0x1c, 0xfd, 0xe6, 0x9f, 0x0b, 0xf0, 0xe2, 0x8e, 0xe0, 0x9a,
+	0x9a, 0x74, 0xed, 0x0e, 0x69, 0x1f, 0x77, 0x04, 0x3f, 0x66, 0xfd, 0x48, 0x5a, 0x44, 0x83, 0x35,
+	0x88, 0x7a, 0x29, 0xd6, 0x20, 0xea, 0x99, 0x1e, 0x4d, 0xfb, 0x09, 0x92, 0xf9, 0x24, 0x9b, 0xb0,
+	0xc6, 0x8c, 0x66, 0x37, 0x0a, 0x82, 0xae, 0x08, 0x98, 0x37, 0x76, 0x4a, 0x76, 0x74, 0xb6, 0x9b,
+	0x7c, 0x0c, 0xf5, 0x49, 0xd7, 0x3e, 0x7a, 0x12, 0xb5, 0x72, 0xca, 0x36, 0x9e, 0x9b, 0xad, 0x78,
+	0xf5, 0xd8, 0x20, 0x9a, 0x55, 0xd8, 0x1a, 0xdd, 0x68, 0xdd, 0x15, 0x1e, 0x0d, 0xe2, 0x28, 0xba,
+	0x78, 0x8c, 0x12, 0xb9, 0x87, 0x9d, 0xf2, 0x97, 0x5f, 0x5d, 0x7f, 0xc6, 0x9d, 0xc3, 0x69, 0x7e,
+	0x55, 0x84, 0xef, 0x74, 0xa8, 0xc2, 0xbd, 0xa8, 0x87, 0x92, 0xa3, 0x46, 0x35, 0xf1, 0x6b, 0xda,
+	0xa5, 0xab, 0xb0, 0x60, 0x95, 0x13, 0xa7, 0xe2, 0x06, 0xd9, 0x82, 0x12, 0xf2, 0x91, 0x53, 0xb4,
+	0xd6, 0x34, 0xf2, 0xac, 0xf9, 0x80, 0x8f, 0x8e, 0xa8, 0x4c, 0xe6, 0x37, 0xc2, 0xc4, 0x85, 0xaa,
+	0x44, 0x25, 0x22, 0xe9, 0xa1, 0xb2, 0x2e, 0xd7, 0xb6, 0x5e, 0x3f, 0x6f, 0x5d, 0xb8, 0x89, 0x82,
+	0x8b, 0x9f, 0x45, 0x4c, 0xe2, 0x10, 0xb9, 0x56, 0xee, 0x19, 0x0c, 0xb9, 0x07, 0x6b, 0x0a, 0xbd,
+	0x48, 0x32, 0x3d, 0x36, 0xf6, 0xe3, 0xa9, 0x76, 0xca, 0x16, 0xf9, 0xff, 0xf3, 0x6c, 0xda, 0x9f,
+	0x16, 0x75, 0x67, 0x75, 0xc9, 0x2e, 0x2c, 0x8f, 0x44, 0x10, 0x0d, 0xf1, 0x9e, 0x88, 0xb8, 0x56,
+	0xce, 0x82, 0xf5, 0xef, 0x7a, 0x1e, 0xd6, 0xd1, 0x99, 0x5c, 0xe2, 0xe4, 0x94, 0x6a, 0xf3, 0x97,
+	0xcb, 0x70, 0x6d, 0x3a, 0xc0, 0xa9, 0x2f, 0x71, 0x7c, 0xc9, 0x2e, 0x54, 0x0c, 0xcb, 0x7d, 0xaa,
+	0xa9, 0x8d, 0x6d, 0x6d, 0xeb, 0xd5, 0xc7, 0x62, 0x89, 0x3b, 0x51, 0x3f, 0xcb, 0x51, 0x31, 0x27,
+	0x47, 0xa5, 0x4b, 0xe7, 0xa8, 0xfc, 0x74, 0x72, 0x24, 0x61, 0x99, 0x0b, 0x1f, 0xf7, 0x31, 0x40,
+	0x4f, 0x0b, 0x99, 0x04, 0xf5, 0xfe, 0x79, 0xb0, 0x5f, 0x17, 0xbc, 0xd6, 0xfd, 0x0c, 0x60, 0xbc,
+	0x45, 0x4c, 0xcd, 0x41, 0x6e, 0x41, 0x85, 0x1e, 0x1f, 0x33, 0xce, 0xf4, 0xd8, 0x59, 0xb4, 0x6e,
+	0x5c, 0xcb, 0x0b, 0xc0, 0x76, 0x22, 0xe3, 0x4e, 0xa4, 0xf3, 0x56, 0xd4, 0xd2, 0x13, 0xac, 0xa8,
+	0x1c, 0xb6, 0x57, 0x2e, 0xce, 0xf6, 0xea, 0xd3, 0x61, 0x3b, 0xf9, 0x1e, 0x3c, 0x1b, 0x4a, 0x26,
+	0xac, 0x61, 0x01, 0x55, 0xea, 0x3e, 0x1d, 0xa2, 0x03, 0xd6, 0x8e, 0xf9, 0x01, 0x72, 0x1b, 0x6a,
+	0x5a, 0x04, 0x28, 0x93, 0x2d, 0x3c, 0x2e, 0x0d, 0xeb, 0x79, 0x46, 0x1c, 0x4c, 0xc4, 0x92, 0xa9,
+	0xb3, 0x8a, 0xe4, 0x2d, 0x58, 0x8a, 0x29, 0x91, 0x96, 0x81, 0xc6, 0xa3, 0x89, 0x94, 0xe8, 0xa7,
+	0x0a, 0x73, 0x4c, 0x5c, 0xb9, 0x34, 0x13, 0xc9, 0xfb, 0x50, 0x91, 0x18, 0x06, 0xcc, 0xa3, 0xca,
+	0x59, 0xb5, 0xa9, 0xdc, 0x3c, 0x7f, 0x49, 0xc7, 0xf2, 0xee, 0x44, 0x93, 0x3c, 0x80, 0x5a, 0x28,
+	0xfc, 0x7b, 0x29, 0x63, 0xd7, 0x2e, 0xc3, 0xd8, 0x2c, 0x02, 0x41, 0xb8, 0x12, 0x0a, 0xff, 0x7d,
+	0xa6, 0x64, 0x64, 0xab, 0x74, 0x27, 0xf2, 0xfb, 0xa8, 0x9d, 0xba, 0x05, 0xbe, 0x79, 0x1e, 0x70,
+	0x77, 0x5e, 0xd5, 0xcd, 0xc3, 0x23, 0x3d, 0x20, 0x3e, 0x86, 0x81, 0x18, 0x1b, 0x5e, 0xee, 0x6b,
+	0x49, 0x35, 0xf6, 0xc7, 0xce, 0xb3, 0x76, 0x96, 0xad, 0xf3, 0x66, 0x79, 0x7f, 0x4e, 0xd3, 0xcd,
+	0x41, 0x23, 0x47, 0x40, 0x42, 0xe1, 0xcf, 0x70, 0xc1, 0x21, 0x76, 0x8e, 0x97, 0xf2, 0x52, 0xd6,
+	0x9d, 0x93, 0x76, 0x73, 0x10, 0xc8, 0xbb, 0xb0, 0x12, 0xb0, 0x11, 0x72, 0x54, 0xaa, 0x2b, 0x45,
+	0x0f, 0x9d, 0x2b, 0x16, 0xf2, 0x85, 0x5c, 0x48, 0x23, 0xe0, 0x4e, 0xcb, 0x93, 0x6d, 0x58, 0x95,
+	0x48, 0x7d, 0x76, 0x86, 0x70, 0xf5, 0x3c, 0x84, 0x19, 0x85, 0xc6, 0xbb, 0xf0, 0xec, 0xdc, 0x66,
+	0xf3, 0x58, 0x67, 0x86, 0x7f, 0x15, 0x81, 0xcc, 0xc7, 0x91, 0x10, 0x28, 0xeb, 0x71, 0x98, 0x96,
+	0x55, 0xfb, 0x4d, 0x42, 0x58, 0x91, 0x22, 0x08, 0x18, 0xef, 0x1f, 0x86, 0x3e, 0xd5, 0x31, 0x58,
+	0x6d, 0xeb, 0xc3, 0xc7, 0x4f, 0x53, 0xcb, 0xcd, 0xe2, 0x9c, 0x8d, 0xbb, 0xd3, 0x13, 0x34, 0xfe,
+	0x5a, 0x80, 0xe7, 0x1f, 0x21, 0x4a, 0x7e, 0x02, 0xab, 0x43, 0x7a, 0x7a, 0xc8, 0xe9, 0x88, 0xb2,
+	0x80, 0xf6, 0x02, 0x4c, 0xca, 0xd4, 0x2b, 0xe7, 0x99, 0xb3, 0xcb, 0xf5, 0x03, 0xb9, 0xaf, 0x25,
+	0xe3, 0xfd, 0x4e, 0xf5, 0x3f, 0xbf, 0xf8, 0x55, 0xa9, 0xac, 0x65, 0x84, 0xee, 0x0c, 0x1a, 0x71,
+	0xa1, 0x32, 0xa4, 0xa7, 0xfb, 0x91, 0xec, 0xa7, 0x8e, 0x5e, 0x16, 0x79, 0x82, 0xd3, 0xfc, 0x7b,
+	0x01, 0xae, 0xe4, 0x50, 0x83, 0x7c, 0x0c, 0xcb, 0x43, 0xc6, 0xb7, 0x9f, 0x92, 0x27, 0x53, 0x58,
+	0x39, 0x71, 0x2a, 0x3e, 0xcd, 0x38, 0x35, 0x7f, 0xbe, 0x04, 0x4b, 0xfb, 0x28, 0x47, 0xcc, 0xc3,
+	0xa9, 0x43, 0x43, 0xfd, 0xc9, 0x0e, 0x0d, 0x7b, 0xb0, 0x10, 0x0a, 0xa9, 0x95, 0x53, 0xd8, 0x28,
+	0x5d, 0xc4, 0xda, 0xc4, 0x84, 0xae, 0x90, 0xba, 0x53, 0x79, 0xb8, 0x5d, 0x28, 0xda, 0xad, 0x36,
+	0xc6, 0x20, 0x1f, 0x41, 0x45, 0xa5, 0xf5, 0x3d, 0x3e, 0x14, 0xbe, 0x71, 0x41, 0xbc, 0xd6, 0x74,
+	0x19, 0x9f, 0xc0, 0x90, 0x6b, 0x50, 0xf5, 0x82, 0x48, 0x69, 0x94, 0xbb, 0xdd, 0xe4, 0x84, 0x7c,
+	0xd6, 0x41, 0x9c, 0x84, 0x3e, 0xe6, 0x8c, 0x52, 0xed, 0x94, 0x8d, 0x3d, 0x09, 0x89, 0x36, 0xa0,
+	0x86, 0xa7, 0x1a, 0x25, 0xa7, 0xc1, 0x6e, 0x37, 0x3e, 0xc2, 0x55, 0xdd, 0x6c, 0x97, 0xa9, 0xc9,
+	0x0a, 0x95, 0x62, 0x82, 0xa7, 0xf5, 0xdf, 0x96, 0xf8, 0xaa, 0x3b, 0xdb, 0x4d, 0x5e, 0x82, 0xd5,
+	0x40, 0x50, 0xbf, 0x43, 0x03, 0xca, 0x3d, 0x6b, 0x48, 0x5c, 0xbc, 0x67, 0x7a, 0xc9, 0x5b, 0xe0,
+	0x64, 0x7b, 0xf6, 0xe3, 0xf3, 0x10, 0xe5, 0x7d, 0x8c, 0x6b, 0x78, 0xd5, 0x7d, 0xe4, 0x38, 0x69,
+	0xc2, 0x72, 0x6a, 0x5c, 0xa6, 0x2c, 0x4f, 0xf5, 0x91, 0xd7, 0xe1, 0xb9, 0xb4, 0x7d, 0x20, 0xcd,
+	0x51, 0xc5, 0x4b, 0xce, 0x12, 0x35, 0x2b, 0x9c, 0x3f, 0x48, 0x5e, 0x83, 0x2b, 0x03, 0xa4, 0x81,
+	0x1e, 0xec, 0x0c, 0xd0, 0x3b, 0x31, 0xbb, 0x98, 0x49, 0x9e, 0xb3, 0xbc, 0x51, 0xd8, 0x5c, 0x70,
+	0xf3, 0x86, 0xc8, 0x27, 0xe0, 0x84, 0x51, 0x2f, 0x60, 0x6a, 0x70, 0x5f, 0x68, 0x17, 0xa9, 0x3f,
+	0xde, 0xf6, 0x7d, 0x89, 0x4a, 0xa1, 0xa9, 0xc0, 0x05, 0x5b, 0xc2, 0xe3, 0x1b, 0x68, 0x2b, 0xbd,
+	0x81, 0xb6, 0x3a, 0x42, 0x04, 0x47, 0x66, 0xb7, 0xeb, 0x94, 0x7f, 0xf7, 0x8f, 0xeb, 0x05, 0xf7,
+	0x91, 0x08, 0xe4, 0x53, 0x78, 0x6e, 0x26, 0xc0, 0xf1, 0x69, 0x2e, 0xa9, 0xca, 0xdf, 0xcd, 0x3f,
+	0x60, 0xe5, 0x28, 0xb8, 0xf9, 0x38, 0xa4, 0x01, 0x15, 0x16, 0xde, 0xa6, 0x43, 0x16, 0x8c, 0x6d,
+	0x81, 0xae, 0xba, 0x93, 0x76, 0xe3, 0x6d, 0x58, 0xb9, 0xfc, 0x1e, 0xfe, 0xeb, 0x25, 0x20, 0x87,
+	0xdc, 0xb8, 0x8c, 0x9e, 0x46, 0xff, 0x1b, 0x60, 0xe3, 0x9d, 0x27, 0x60, 0x63, 0x39, 0xcb, 0xc4,
+	0x4f, 0xe6, 0x98, 0xf8, 0xde, 0x79, 0x58, 0xf3, 0x9e, 0x5d, 0x92, 0x94, 0x24, 0x4b, 0xca, 0x6f,
+	0xe9, 0xf8, 0x2d, 0x1d, 0xbf, 0x21, 0x3a, 0xfe, 0xa5, 0x00, 0xb5, 0x0c, 0x01, 0xcc, 0xba, 0xe3,
+	0x26, 0x57, 0xc9, 0x59, 0xca, 0x7c, 0x9b, 0xc9, 0x6d, 0x4c, 0x3c, 0x11, 0x24, 0x00, 0x93, 0xb6,
+	0x29, 0x1e, 0x86, 0x2c, 0x76, 0x01, 0x2f, 0xa4, 0xc5, 0xc3, 0xf4, 0x90, 0x23, 0x00, 0x4d, 0x65,
+	0x1f, 0xb5, 0x4d, 0x4d, 0xf9, 0x89, 0xea, 0x78, 0x06, 0xc9, 0x58, 0xc3, 0xd3, 0x84, 0x2f, 0xd8,
+	0x84, 0x4f, 0xda, 0xcd, 0x0e, 0xac, 0x9a, 0x55, 0xa5, 0x42, 0xea, 0xa1, 0x6f, 0xbe, 0x72, 0xfd,
+	0xb9, 0x06, 0x55, 0x9e, 0x4a, 0x25, 0x0e, 0x9d, 0x75, 0x34, 0xff, 0x50, 0x82, 0xab, 0x79, 0xf7,
+	0x70, 0xd2, 0x83, 0xc5, 0x80, 0x0d, 0xd9, 0x64, 0x63, 0x79, 0xef, 0x32, 0xb7, 0xf9, 0xd6, 0x5d,
+	0x0b, 0x61, 0x13, 0xd5, 0xa9, 0x18, 0x0f, 0x4b, 0x43, 0x1a, 0xba, 0x09, 0x32, 0x19, 0x98, 0x0b,
+	0xd6, 0x67, 0x11, 0x2a, 0xad, 0x92, 0x2d, 0xa7, 0x73, 0xa9, 0x59, 0xdc, 0x04, 0x64, 0x76, 0x9e,
+	0x09, 0x7a, 0xc3, 0x83, 0x5a, 0xc6, 0x94, 0x9c, 0x35, 0xf3, 0x4e, 0x76, 0xcd, 0x5c, 0xe0, 0xa2,
+	0xf7, 0x51, 0x44, 0xb9, 0x36, 0x0f, 0x00, 0x99, 0x47, 0x42, 0x84, 0x95, 0x29, 0x4b, 0xbe, 0x99,
+	0x69, 0x9a, 0xbf, 0x2d, 0x42, 0x25, 0xbd, 0x67, 0x92, 0xef, 0xc3, 0x82, 0x67, 0x6e, 0xab, 0xc9,
+	0xc1, 0xf4, 0xc5, 0x39, 0x5a, 0xef, 0x72, 0x7d, 0x73, 0x2b, 0xcb, 0xeb, 0x58, 0x9e, 0xdc, 0x84,
+	0xd2, 0x90, 0x71, 0xa7, 0x78, 0x51, 0x35, 0x23, 0x6d, 0x95, 0xe8, 0xa9, 0x53, 0xba, 0xb8, 0x12,
+	0x3d, 0x25, 0x0c, 0xd6, 0xe3, 0x05, 0xbd, 0xd3, 0x3d, 0x3c, 0xd4, 0x2c, 0x60, 0x5f, 0xd8, 0x6b,
+	0x7e, 0x17, 0xa5, 0x87, 0x5c, 0x9b, 0xd7, 0xa7, 0xf2, 0x45, 0xf1, 0xce, 0x01, 0x6a, 0xfe, 0xbb,
+	0x0c, 0xcf, 0xef, 0xdd, 0x9a, 0xbc, 0xf9, 0x3c, 0x18, 0xa1, 0x0c, 0xe8, 0xb8, 0x4b, 0xb5, 0x37,
+	0x20, 0x5f, 0x40, 0xbd, 0x2f, 0x45, 0x14, 0x1e, 0xa1, 0x34, 0x5b, 0xce, 0x1e, 0xe3, 0x7e, 0x12,
+	0xb4, 0x1f, 0x5d, 0xa0, 0xf6, 0xe6, 0x41, 0xb6, 0xee, 0xcc, 0xe0, 0xa5, 0xcf, 0x28, 0xb3, 0xf3,
+	0x90, 0xbb, 0x50, 0x15, 0xb6, 0x78, 0xef, 0xe1, 0x38, 0x09, 0x79, 0xeb, 0xbc, 0x49, 0xa7, 0xa9,
+	0xed, 0x9e, 0x01, 0x90, 0x1f, 0xc3, 0x52, 0x68, 0xe6, 0xb7, 0xaf, 0xa1, 0x86, 0x35, 0x3f, 0xbc,
+	0xac, 0x03, 0xf6, 0x6f, 0xfa, 0x82, 0x92, 0x60, 0x36, 0x8e, 0xa0, 0x3e, 0xeb, 0x98, 0xd9, 0x58,
+	0x4e, 0xd2, 0x80, 0x55, 0x5d, 0xfb, 0x4d, 0x1c, 0x58, 0x1a, 0xc5, 0x22, 0xc9, 0xb6, 0x92, 0x36,
+	0xcd, 0x06, 0x6c, 0x43, 0x90, 0x14, 0xfa, 0xb8, 0xd1, 0xf8, 0x7d, 0x01, 0x16, 0xe2, 0x54, 0x10,
+	0x28, 0x87, 0x54, 0x0f, 0x52, 0x34, 0xf3, 0x9d, 0xbf, 0x69, 0x93, 0x75, 0x80, 0x90, 0x4a, 0x85,
+	0x76, 0x11, 0x58, 0xb8, 0x8a, 0x9b, 0xe9, 0x21, 0xdd, 0xcc, 0xc1, 0x61, 0x75, 0xeb, 0x07, 0x97,
+	0x8d, 0xc3, 0xc1, 0x38, 0xc4, 0xf8, 0xd8, 0xd1, 0x7c, 0x0d, 0xca, 0xa6, 0x45, 0xd6, 0xa0, 0x16,
+	0x71, 0x15, 0xa2, 0xc7, 0x8e, 0x19, 0xfa, 0xf5, 0x67, 0x48, 0x0d, 0x96, 0x24, 0x86, 0x01, 0xf5,
+	0xb0, 0x5e, 0x20, 0x00, 0x8b, 0x12, 0x87, 0x62, 0x84, 0xf5, 0x62, 0xf3, 0x37, 0x45, 0xa8, 0xee,
+	0x08, 0xee, 0x33, 0xfb, 0xec, 0x9d, 0x77, 0x3d, 0xbf, 0x03, 0x8b, 0x4a, 0x53, 0x1d, 0x29, 0xeb,
+	0xdc, 0xea, 0x56, 0xfb, 0x3c, 0x3b, 0x27, 0x70, 0xfb, 0x56, 0xcd, 0x4d, 0xd4, 0x49, 0x0b, 0x88,
+	0xe8, 0x29, 0x94, 0x23, 0xf4, 0xef, 0xc4, 0x3f, 0xf0, 0x98, 0xe8, 0x9b, 0xb0, 0x94, 0xdc, 0x9c,
+	0x11, 0xf2, 0x21, 0x90, 0x80, 0x2a, 0x7d, 0x20, 0x29, 0x57, 0x16, 0xef, 0x80, 0x0d, 0x53, 0xba,
+	0xcd, 0x9f, 0x00, 0x0e, 0xd2, 0x1f, 0xba, 0xdc, 0x1c, 0x2d, 0xf2, 0x7f, 0xc6, 0x65, 0xaa, 0x04,
+	0xb7, 0x75, 0xa8, 0xea, 0x26, 0x2d, 0xb3, 0x0c, 0x86, 0xa8, 0x94, 0xe1, 0xf1, 0x62, 0xbc, 0x0c,
+	0x92, 0x66, 0xf3, 0x8f, 0x05, 0x58, 0xdb, 0x11, 0xc3, 0x50, 0x70, 0xfb, 0xc0, 0x60, 0x3d, 0xc8,
+	0xab, 0x50, 0x3b, 0x33, 0xe1, 0x79, 0xe5, 0x02, 0xe1, 0x39, 0x66, 0x7d, 0x83, 0x88, 0x93, 0xd0,
+	0xcc, 0xfc, 0x48, 0x50, 0xba, 0x08, 0xc5, 0xd2, 0xb5, 0x10, 0xdb, 0x96, 0xf0, 0xe0, 0x0c, 0xa6,
+	0xf9, 0xdf, 0x02, 0xac, 0x4e, 0xcb, 0x98, 0x05, 0x49, 0x43, 0x96, 0x50, 0x23, 0xf1, 0x22, 0xd3,
+	0x33, 0x21, 0x4a, 0x31, 0x43, 0x94, 0xa9, 0x0a, 0x5c, 0x9a, 0xa9, 0xc0, 0x93, 0x88, 0x94, 0x33,
+	0x11, 0x21, 0x50, 0x1e, 0x50, 0x35, 0x48, 0x22, 0x6d, 0xbf, 0xc9, 0x21, 0xac, 0x99, 0xac, 0x6c,
+	0x87, 0x61, 0x30, 0x76, 0x51, 0x45, 0x81, 0x76, 0x16, 0x2f, 0x16, 0xae, 0x8c, 0x8a, 0x3b, 0x8b,
+	0x91, 0x4d, 0xdf, 0xd2, 0x74, 0xfa, 0x00, 0x2a, 0x69, 0xf9, 0x69, 0xae, 0x40, 0x2d, 0x73, 0x58,
+	0x79, 0xf9, 0x26, 0xac, 0xcd, 0x2c, 0x51, 0x43, 0x8f, 0x43, 0x7e, 0xc2, 0xc5, 0xe7, 0xbc, 0xfe,
+	0x0c, 0xa9, 0x40, 0xf9, 0x40, 0x46, 0x86, 0x28, 0x55, 0x58, 0xb8, 0x4d, 0x03, 0x85, 0xf5, 0xe2,
+	0xcb, 0x6f, 0x43, 0x2d, 0x3b, 0xf1, 0x2a, 0xc0, 0x7d, 0x61, 0x4d, 0x99, 0xf0, 0x2b, 0x6d, 0x14,
+	0x0c, 0xfb, 0xac, 0xec, 0x6d, 0xca, 0x02, 0xf4, 0xeb, 0xc5, 0x97, 0x05, 0xd4, 0x32, 0x59, 0x37,
+	0xe3, 0x87, 0xb3, 0xec, 0xdc, 0x91, 0x48, 0xb5, 0xd5, 0xbe, 0x02, 0x6b, 0x2e, 0x7a, 0x82, 0x7b,
+	0x2c, 0xc0, 0x14, 0xc1, 0xa8, 0xa4, 0x9d, 0x8c, 0xf7, 0xeb, 0x25, 0xb2, 0x02, 0xd5, 0xc9, 0x5b,
+	0x4c, 0xbd, 0x6c, 0x9a, 0x87, 0x7c, 0x48, 0x39, 0xed, 0xa3, 0x5f, 0x5f, 0xe8, 0xec, 0x7c, 0xf9,
+	0x70, 0xbd, 0xf0, 0xb7, 0x87, 0xeb, 0x85, 0x7f, 0x3e, 0x5c, 0x2f, 0x7c, 0xfc, 0x46, 0x9f, 0xe9,
+	0x41, 0xd4, 0x6b, 0x79, 0x62, 0xd8, 0xee, 0x51, 0xfe, 0x05, 0x65, 0x5e, 0x20, 0x22, 0xbf, 0x6d,
+	0x23, 0xff, 0x6a, 0x1a, 0xf9, 0xf6, 0x68, 0xab, 0x9d, 0xfd, 0x61, 0xba, 0xb7, 0x68, 0xb9, 0x75,
+	0xf3, 0x7f, 0x03, 0x00, 0xd6, 0x35, 0x49, 0x17, 0xaf, 0x1e, 0x00, 0x00,
 }
 
 func (m *K8SObjectMeta) Marshal() (dAtA []byte, err error) {
@@ -3308,68 +3650,265 @@ func (m *K8SResourceOverlayPatch_Patch) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-
-
-
-
-
-
-func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommon(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Condition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *K8SObjectMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovCommon(uint64(len(k))) + 1 + len(v) + sovCommon(uint64(len(v)))
-			n += mapEntrySize + 1 + sovCommon(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovCommon(uint64(len(k))) + 1 + len(v) + sovCommon(uint64(len(v)))
-			n += mapEntrySize + 1 + sovCommon(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContainerImageConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hub)
-	if l > 0 {
-		n += 1 + l + sovCommon(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovCommon(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ImagePullPolicy)
-	if l > 0 {
-		n += 1 + l + sovCommon(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastApplyResult != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.LastApplyResult))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApiVersion) > 0 {
+		i -= len(m.ApiVersion)
+		copy(dAtA[i:], m.ApiVersion)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.ApiVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+
+
+
+
+
+
+func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *K8SObjectMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommon(uint64(len(k))) + 1 + len(v) + sovCommon(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommon(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommon(uint64(len(k))) + 1 + len(v) + sovCommon(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommon(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerImageConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hub)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.ImagePullPolicy)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
 	}
 	if len(m.ImagePullSecrets) > 0 {
 		for _, e := range m.ImagePullSecrets {
@@ -3937,41 +4476,139 @@ func (m *K8SResourceOverlayPatch_Patch) Size() (n int) {
 	return n
 }
 
-
-
-func sovCommon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCommon(x uint64) (n int) {
-	return sovCommon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCommon(uint64(m.Status))
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovCommon(uint64(m.ObservedGeneration))
+	}
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *K8SObjectMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommon
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: K8sObjectMeta: wiretype end group for non-group")
+
+func (m *ComponentStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCommon(uint64(m.Status))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: K8sObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApiVersion)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.LastApplyResult != 0 {
+		n += 1 + sovCommon(uint64(m.LastApplyResult))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+
+
+func sovCommon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommon(x uint64) (n int) {
+	return sovCommon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *K8SObjectMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: K8sObjectMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: K8sObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 11:
@@ -8376,6 +9013,625 @@ func (m *K8SResourceOverlayPatch_Patch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConditionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &types.Timestamp{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastApplyResult", wireType)
+			}
+			m.LastApplyResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastApplyResult |= ApplyResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommon(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -358,6 +358,69 @@ func (in *K8SResourceOverlayPatch_Patch) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using Condition within kubernetes types, where deepcopy-gen is used.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	p := proto.Clone(in).(*Condition)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition. Required by controller-gen.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new Condition. Required by controller-gen.
+func (in *Condition) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ComponentStatus within kubernetes types, where deepcopy-gen is used.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	p := proto.Clone(in).(*ComponentStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus. Required by controller-gen.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus. Required by controller-gen.
+func (in *ComponentStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ResourceStatus within kubernetes types, where deepcopy-gen is used.
+func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
+	p := proto.Clone(in).(*ResourceStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus. Required by controller-gen.
+func (in *ResourceStatus) DeepCopy() *ResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus. Required by controller-gen.
+func (in *ResourceStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Quantity within kubernetes types, where deepcopy-gen is used.
 func (in *Quantity) DeepCopyInto(out *Quantity) {
 	p := proto.Clone(in).(*Quantity)
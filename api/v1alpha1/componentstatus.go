@@ -0,0 +1,77 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnmanagedAnnotation stops the operator from reconciling the components of the annotated resource, its value is
+// either "true" for every component or a comma separated list of the names of the components to leave alone
+const UnmanagedAnnotation = "servicemesh.cisco.com/unmanaged"
+
+// SetComponentStatus adds the status of a component to the component statuses or replaces
+// the existing status of the component with the same name
+func SetComponentStatus(statuses *[]ComponentStatus, status ComponentStatus) {
+	for i := range *statuses {
+		if (*statuses)[i].Name == status.Name {
+			(*statuses)[i] = status
+
+			return
+		}
+	}
+
+	*statuses = append(*statuses, status)
+}
+
+// FindComponentStatus returns the status of the component with the given name or nil if there is no such status
+func FindComponentStatus(statuses []ComponentStatus, name string) *ComponentStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+
+	return nil
+}
+
+// IsUnmanaged reports whether none of the components of the object should be reconciled,
+// the annotation is ignored once the object is being deleted to not block its removal
+func IsUnmanaged(object metav1.Object) bool {
+	return object.GetDeletionTimestamp().IsZero() && strings.TrimSpace(object.GetAnnotations()[UnmanagedAnnotation]) == "true"
+}
+
+// IsComponentUnmanaged reports whether the component with the given name of the object should not be reconciled
+func IsComponentUnmanaged(object metav1.Object, name string) bool {
+	if IsUnmanaged(object) {
+		return true
+	}
+
+	if !object.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+
+	for _, component := range strings.Split(object.GetAnnotations()[UnmanagedAnnotation], ",") {
+		if strings.TrimSpace(component) == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	// ConditionTypeReady is true when the latest generation of the resource is fully reconciled
+	ConditionTypeReady = "Ready"
+
+	ConditionTypeBaseReady              = "BaseReady"
+	ConditionTypeDiscoveryReady         = "DiscoveryReady"
+	ConditionTypeCNIReady               = "CNIReady"
+	ConditionTypeMeshExpansionReady     = "MeshExpansionReady"
+	ConditionTypeSidecarInjectorReady   = "SidecarInjectorReady"
+	ConditionTypeNodeProxyReady         = "NodeProxyReady"
+	ConditionTypeResourceSyncRulesReady = "ResourceSyncRulesReady"
+	ConditionTypeGatewayAddressAssigned = "GatewayAddressAssigned"
+	// ConditionTypeCertificateReady is true when the TLS certificate of the mesh gateway is issued and valid
+	ConditionTypeCertificateReady = "CertificateReady"
+	// ConditionTypePlanApproved is false while the plan of the changes is waiting for approval in plan mode
+	ConditionTypePlanApproved = "PlanApproved"
+)
+
+const (
+	ConditionReasonReconciled         = "Reconciled"
+	ConditionReasonReconciling        = "Reconciling"
+	ConditionReasonReconcileFailed    = "ReconcileFailed"
+	ConditionReasonUnmanaged          = "Unmanaged"
+	ConditionReasonDisabled           = "Disabled"
+	ConditionReasonPending            = "Pending"
+	ConditionReasonAddressAssigned    = "AddressAssigned"
+	ConditionReasonAddressPending     = "AddressPending"
+	ConditionReasonPlanPending        = "PlanPending"
+	ConditionReasonPlanApproved       = "PlanApproved"
+	ConditionReasonCertificateIssued  = "CertificateIssued"
+	ConditionReasonCertificatePending = "CertificatePending"
+)
+
+// SetCondition adds the condition to the conditions or replaces the existing condition of the same type,
+// the last transition time is kept when the status of the condition does not change
+func SetCondition(conditions *[]Condition, condition Condition) {
+	if condition.LastTransitionTime == nil {
+		condition.LastTransitionTime, _ = types.TimestampProto(time.Now().Truncate(time.Second))
+	}
+
+	for i := range *conditions {
+		existing := &(*conditions)[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+
+		if existing.Status == condition.Status && existing.LastTransitionTime != nil {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		*existing = condition
+
+		return
+	}
+
+	*conditions = append(*conditions, condition)
+}
+
+// FindCondition returns the condition of the given type or nil if there is no such condition
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// RemoveCondition removes the condition of the given type from the conditions
+func RemoveCondition(conditions *[]Condition, conditionType string) {
+	filtered := make([]Condition, 0, len(*conditions))
+	for _, condition := range *conditions {
+		if condition.Type != conditionType {
+			filtered = append(filtered, condition)
+		}
+	}
+
+	*conditions = filtered
+}
@@ -7,8 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	io "io"
 	v1alpha1 "istio.io/api/mesh/v1alpha1"
 	_ "istio.io/gogo-genproto/googleapis/google/api"
@@ -155,6 +155,80 @@ func (JWTPolicyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6817de833805cb8b, []int{3}
 }
 
+type RootCARotationPhase int32
+
+const (
+	// The new root CA is added to the trust bundle of the cluster
+	RootCARotationPhase_AddingTrust RootCARotationPhase = 0
+	// The trust bundles of the peer control planes are waited for to contain the new root CA
+	RootCARotationPhase_WaitingForPeers RootCARotationPhase = 1
+	// istiod switches to an intermediate CA issued by the new root CA
+	RootCARotationPhase_SwitchingSigner RootCARotationPhase = 2
+	// The workloads are restarted in batches to get certificates signed by the new root CA
+	RootCARotationPhase_RestartingWorkloads RootCARotationPhase = 3
+	// The old root CA is removed from the trust bundle of the cluster
+	RootCARotationPhase_RemovingOldRoot RootCARotationPhase = 4
+	// The rotation is done, the new root CA can be moved to rootCASecret
+	RootCARotationPhase_RotationCompleted RootCARotationPhase = 5
+)
+
+var RootCARotationPhase_name = map[int32]string{
+	0: "AddingTrust",
+	1: "WaitingForPeers",
+	2: "SwitchingSigner",
+	3: "RestartingWorkloads",
+	4: "RemovingOldRoot",
+	5: "RotationCompleted",
+}
+
+var RootCARotationPhase_value = map[string]int32{
+	"AddingTrust":         0,
+	"WaitingForPeers":     1,
+	"SwitchingSigner":     2,
+	"RestartingWorkloads": 3,
+	"RemovingOldRoot":     4,
+	"RotationCompleted":   5,
+}
+
+func (x RootCARotationPhase) String() string {
+	return proto.EnumName(RootCARotationPhase_name, int32(x))
+}
+
+func (RootCARotationPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{4}
+}
+
+type RemoteClusterState int32
+
+const (
+	// The API server of the remote cluster is reachable from the operator
+	RemoteClusterState_Reachable RemoteClusterState = 0
+	// The API server of the remote cluster could not be reached from the operator
+	RemoteClusterState_Unreachable RemoteClusterState = 1
+	// The kubeconfig of the remote cluster could not be parsed
+	RemoteClusterState_InvalidKubeconfig RemoteClusterState = 2
+)
+
+var RemoteClusterState_name = map[int32]string{
+	0: "Reachable",
+	1: "Unreachable",
+	2: "InvalidKubeconfig",
+}
+
+var RemoteClusterState_value = map[string]int32{
+	"Reachable":         0,
+	"Unreachable":       1,
+	"InvalidKubeconfig": 2,
+}
+
+func (x RemoteClusterState) String() string {
+	return proto.EnumName(RemoteClusterState_name, int32(x))
+}
+
+func (RemoteClusterState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{5}
+}
+
 // IstioControlPlane defines an Istio control plane
 //
 // <!-- crd generation tags
@@ -247,10 +321,17 @@ type IstioControlPlaneSpec struct {
 	// +default=network1
 	NetworkName string `protobuf:"bytes,23,opt,name=networkName,proto3" json:"networkName,omitempty"`
 	// Standalone sidecar injector configuration.
-	SidecarInjector      *SidecarInjectorConfiguration `protobuf:"bytes,24,opt,name=sidecarInjector,proto3" json:"sidecarInjector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	SidecarInjector *SidecarInjectorConfiguration `protobuf:"bytes,24,opt,name=sidecarInjector,proto3" json:"sidecarInjector,omitempty"`
+	// Node proxy configuration for the sidecarless data plane.
+	NodeProxy *NodeProxyConfiguration `protobuf:"bytes,25,opt,name=nodeProxy,proto3" json:"nodeProxy,omitempty"`
+	// Plug-in CA configuration, the operator issues the intermediate CA of istiod from a shared root CA.
+	Ca *CAConfiguration `protobuf:"bytes,26,opt,name=ca,proto3" json:"ca,omitempty"`
+	// Mesh networks the east-west gateways of the cluster serve besides the mesh expansion gateway,
+	// the mesh networks of istiod are generated from the networks of the control plane and its peers.
+	Networks             []*NetworkConfiguration `protobuf:"bytes,27,rep,name=networks,proto3" json:"networks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *IstioControlPlaneSpec) Reset()         { *m = IstioControlPlaneSpec{} }
@@ -454,6 +535,27 @@ func (m *IstioControlPlaneSpec) GetSidecarInjector() *SidecarInjectorConfigurati
 	return nil
 }
 
+func (m *IstioControlPlaneSpec) GetNodeProxy() *NodeProxyConfiguration {
+	if m != nil {
+		return m.NodeProxy
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneSpec) GetCa() *CAConfiguration {
+	if m != nil {
+		return m.Ca
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneSpec) GetNetworks() []*NetworkConfiguration {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
 type SidecarInjectorConfiguration struct {
 	// Deployment spec
 	Deployment *BaseKubernetesResourceConfig `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
@@ -748,10 +850,13 @@ type MeshExpansionConfiguration_IstioMeshGatewayConfiguration struct {
 	// Whether to run the gateway in a privileged container
 	RunAsRoot *bool `protobuf:"bytes,4,opt,name=runAsRoot,proto3,wktptr" json:"runAsRoot,omitempty"`
 	// K8s resource overlay patches
-	K8SResourceOverlays  []*K8SResourceOverlayPatch `protobuf:"bytes,5,rep,name=k8sResourceOverlays,proto3" json:"k8sResourceOverlays,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	K8SResourceOverlays []*K8SResourceOverlayPatch `protobuf:"bytes,5,rep,name=k8sResourceOverlays,proto3" json:"k8sResourceOverlays,omitempty"`
+	// How the hostname of the load balancer of the gateway is reported in the gateway address
+	// +kubebuilder:validation:Enum=Hostname;IPv4;IPv6;DualStack
+	AddressResolution    AddressResolution `protobuf:"varint,6,opt,name=addressResolution,proto3,enum=istio_operator.v2.api.v1alpha1.AddressResolution" json:"addressResolution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Reset() {
@@ -826,6 +931,13 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetK8SResourc
 	return nil
 }
 
+func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) GetAddressResolution() AddressResolution {
+	if m != nil {
+		return m.AddressResolution
+	}
+	return AddressResolution_Hostname
+}
+
 // Comma-separated minimum per-scope logging level of messages to output, in the form of <scope>:<level>,<scope>:<level>
 // The control plane has different scopes depending on component, but can configure default log level across all components
 // If empty, default scope and level will be used as configured in code
@@ -1499,6 +1611,259 @@ func (m *CNIConfiguration_ResourceQuotas) GetPriorityClasses() []string {
 	return nil
 }
 
+// NodeProxyConfiguration defines config options for the per-node proxies of the sidecarless (ambient) data plane,
+// namespaces opt in to have their workloads captured by the node proxies with the istio.io/ambient-rev label
+type NodeProxyConfiguration struct {
+	Enabled *bool `protobuf:"bytes,1,opt,name=enabled,proto3,wktptr" json:"enabled,omitempty"`
+	// Log level of the node proxies. If left empty, "info" is used.
+	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARNING;ERROR;CRITICAL;OFF
+	LogLevel ProxyLogLevel `protobuf:"varint,2,opt,name=logLevel,proto3,enum=istio_operator.v2.api.v1alpha1.ProxyLogLevel" json:"logLevel,omitempty"`
+	// Namespaces whose workloads are never captured by the node proxies even if the namespace is labeled
+	ExcludeNamespaces []string `protobuf:"bytes,3,rep,name=excludeNamespaces,proto3" json:"excludeNamespaces,omitempty"`
+	// DaemonSet spec
+	Daemonset            *BaseKubernetesResourceConfig `protobuf:"bytes,4,opt,name=daemonset,proto3" json:"daemonset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *NodeProxyConfiguration) Reset()         { *m = NodeProxyConfiguration{} }
+func (m *NodeProxyConfiguration) String() string { return proto.CompactTextString(m) }
+func (*NodeProxyConfiguration) ProtoMessage()    {}
+func (*NodeProxyConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{8}
+}
+func (m *NodeProxyConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeProxyConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeProxyConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeProxyConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeProxyConfiguration.Merge(m, src)
+}
+func (m *NodeProxyConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeProxyConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeProxyConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeProxyConfiguration proto.InternalMessageInfo
+
+func (m *NodeProxyConfiguration) GetEnabled() *bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+func (m *NodeProxyConfiguration) GetLogLevel() ProxyLogLevel {
+	if m != nil {
+		return m.LogLevel
+	}
+	return ProxyLogLevel_UNSPECIFIED
+}
+
+func (m *NodeProxyConfiguration) GetExcludeNamespaces() []string {
+	if m != nil {
+		return m.ExcludeNamespaces
+	}
+	return nil
+}
+
+func (m *NodeProxyConfiguration) GetDaemonset() *BaseKubernetesResourceConfig {
+	if m != nil {
+		return m.Daemonset
+	}
+	return nil
+}
+
+// CAConfiguration defines the root CA the operator issues the intermediate CA of the cluster from,
+// the intermediate CA is stored in the cacerts secret istiod signs the workload certificates with
+type CAConfiguration struct {
+	// Secret which holds the PEM encoded certificate and private key of the root CA in its ca.crt and ca.key keys,
+	// the namespace of the control plane is used if the namespace is not set
+	RootCASecret *NamespacedName `protobuf:"bytes,1,opt,name=rootCASecret,proto3" json:"rootCASecret,omitempty"`
+	// Validity of the intermediate CA, 8760h (1 year) by default
+	IntermediateDuration string `protobuf:"bytes,2,opt,name=intermediateDuration,proto3" json:"intermediateDuration,omitempty"`
+	// Time before the expiry of the intermediate CA when it is renewed, 720h (30 days) by default
+	IntermediateRenewBefore string `protobuf:"bytes,3,opt,name=intermediateRenewBefore,proto3" json:"intermediateRenewBefore,omitempty"`
+	// Secret of the new root CA to rotate the intermediate CA to, in the same format as rootCASecret.
+	// The rotation goes through the phases of RootCARotationPhase and it is tracked in the status,
+	// once it is completed the secret can be moved to rootCASecret.
+	NextRootCASecret *NamespacedName `protobuf:"bytes,4,opt,name=nextRootCASecret,proto3" json:"nextRootCASecret,omitempty"`
+	// Number of namespaces whose workloads are restarted at once during a root CA rotation, defaults to 1
+	RestartBatchSize     *int32   `protobuf:"bytes,5,opt,name=restartBatchSize,proto3,wktptr" json:"restartBatchSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CAConfiguration) Reset()         { *m = CAConfiguration{} }
+func (m *CAConfiguration) String() string { return proto.CompactTextString(m) }
+func (*CAConfiguration) ProtoMessage()    {}
+func (*CAConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{9}
+}
+func (m *CAConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CAConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CAConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CAConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CAConfiguration.Merge(m, src)
+}
+func (m *CAConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CAConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CAConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CAConfiguration proto.InternalMessageInfo
+
+func (m *CAConfiguration) GetRootCASecret() *NamespacedName {
+	if m != nil {
+		return m.RootCASecret
+	}
+	return nil
+}
+
+func (m *CAConfiguration) GetIntermediateDuration() string {
+	if m != nil {
+		return m.IntermediateDuration
+	}
+	return ""
+}
+
+func (m *CAConfiguration) GetIntermediateRenewBefore() string {
+	if m != nil {
+		return m.IntermediateRenewBefore
+	}
+	return ""
+}
+
+func (m *CAConfiguration) GetNextRootCASecret() *NamespacedName {
+	if m != nil {
+		return m.NextRootCASecret
+	}
+	return nil
+}
+
+func (m *CAConfiguration) GetRestartBatchSize() *int32 {
+	if m != nil {
+		return m.RestartBatchSize
+	}
+	return nil
+}
+
+// NetworkConfiguration defines a mesh network and the Istio mesh gateways of the control plane which are its east-west gateways
+type NetworkConfiguration struct {
+	// Name of the network, the network of the control plane is served by the selected gateways as well
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Labels of the Istio mesh gateways of the control plane which are the east-west gateways of the network
+	GatewaySelector map[string]string `protobuf:"bytes,2,rep,name=gatewaySelector,proto3" json:"gatewaySelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Port of the gateways for cross-network traffic, 15443 by default
+	GatewayPort uint32 `protobuf:"varint,3,opt,name=gatewayPort,proto3" json:"gatewayPort,omitempty"`
+	// Addresses of the gateways advertised in the mesh networks instead of the addresses of the selected gateways,
+	// DNS names, e.g. of a load balancer in front of the gateways, are passed to Istio as is
+	GatewayAddresses []string `protobuf:"bytes,4,rep,name=gatewayAddresses,proto3" json:"gatewayAddresses,omitempty"`
+	// Labels of the control planes, the IstioControlPlane and its peers, whose clusters are part of the network
+	// instead of the network set in their networkName
+	ClusterSelector      map[string]string `protobuf:"bytes,5,rep,name=clusterSelector,proto3" json:"clusterSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NetworkConfiguration) Reset()         { *m = NetworkConfiguration{} }
+func (m *NetworkConfiguration) String() string { return proto.CompactTextString(m) }
+func (*NetworkConfiguration) ProtoMessage()    {}
+func (*NetworkConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{10}
+}
+func (m *NetworkConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkConfiguration.Merge(m, src)
+}
+func (m *NetworkConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkConfiguration proto.InternalMessageInfo
+
+func (m *NetworkConfiguration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkConfiguration) GetGatewaySelector() map[string]string {
+	if m != nil {
+		return m.GatewaySelector
+	}
+	return nil
+}
+
+func (m *NetworkConfiguration) GetGatewayPort() uint32 {
+	if m != nil {
+		return m.GatewayPort
+	}
+	return 0
+}
+
+func (m *NetworkConfiguration) GetGatewayAddresses() []string {
+	if m != nil {
+		return m.GatewayAddresses
+	}
+	return nil
+}
+
+func (m *NetworkConfiguration) GetClusterSelector() map[string]string {
+	if m != nil {
+		return m.ClusterSelector
+	}
+	return nil
+}
+
 // IstiodConfiguration defines config options for Istiod
 type IstiodConfiguration struct {
 	// Deployment spec
@@ -1531,7 +1896,7 @@ func (m *IstiodConfiguration) Reset()         { *m = IstiodConfiguration{} }
 func (m *IstiodConfiguration) String() string { return proto.CompactTextString(m) }
 func (*IstiodConfiguration) ProtoMessage()    {}
 func (*IstiodConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{8}
+	return fileDescriptor_6817de833805cb8b, []int{11}
 }
 func (m *IstiodConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2000,7 @@ func (m *ExternalIstiodConfiguration) Reset()         { *m = ExternalIstiodConfi
 func (m *ExternalIstiodConfiguration) String() string { return proto.CompactTextString(m) }
 func (*ExternalIstiodConfiguration) ProtoMessage()    {}
 func (*ExternalIstiodConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{9}
+	return fileDescriptor_6817de833805cb8b, []int{12}
 }
 func (m *ExternalIstiodConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1683,7 +2048,7 @@ func (m *SPIFFEConfiguration) Reset()         { *m = SPIFFEConfiguration{} }
 func (m *SPIFFEConfiguration) String() string { return proto.CompactTextString(m) }
 func (*SPIFFEConfiguration) ProtoMessage()    {}
 func (*SPIFFEConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{10}
+	return fileDescriptor_6817de833805cb8b, []int{13}
 }
 func (m *SPIFFEConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2096,7 @@ func (m *OperatorEndpointsConfiguration) Reset()         { *m = OperatorEndpoint
 func (m *OperatorEndpointsConfiguration) String() string { return proto.CompactTextString(m) }
 func (*OperatorEndpointsConfiguration) ProtoMessage()    {}
 func (*OperatorEndpointsConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{11}
+	return fileDescriptor_6817de833805cb8b, []int{14}
 }
 func (m *OperatorEndpointsConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,7 +2143,7 @@ func (m *TelemetryV2Configuration) Reset()         { *m = TelemetryV2Configurati
 func (m *TelemetryV2Configuration) String() string { return proto.CompactTextString(m) }
 func (*TelemetryV2Configuration) ProtoMessage()    {}
 func (*TelemetryV2Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{12}
+	return fileDescriptor_6817de833805cb8b, []int{15}
 }
 func (m *TelemetryV2Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1826,7 +2191,7 @@ func (m *ProxyWasmConfiguration) Reset()         { *m = ProxyWasmConfiguration{}
 func (m *ProxyWasmConfiguration) String() string { return proto.CompactTextString(m) }
 func (*ProxyWasmConfiguration) ProtoMessage()    {}
 func (*ProxyWasmConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{13}
+	return fileDescriptor_6817de833805cb8b, []int{16}
 }
 func (m *ProxyWasmConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,7 +2239,7 @@ func (m *PDBConfiguration) Reset()         { *m = PDBConfiguration{} }
 func (m *PDBConfiguration) String() string { return proto.CompactTextString(m) }
 func (*PDBConfiguration) ProtoMessage()    {}
 func (*PDBConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{14}
+	return fileDescriptor_6817de833805cb8b, []int{17}
 }
 func (m *PDBConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2288,7 @@ func (m *HTTPProxyEnvsConfiguration) Reset()         { *m = HTTPProxyEnvsConfigu
 func (m *HTTPProxyEnvsConfiguration) String() string { return proto.CompactTextString(m) }
 func (*HTTPProxyEnvsConfiguration) ProtoMessage()    {}
 func (*HTTPProxyEnvsConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{15}
+	return fileDescriptor_6817de833805cb8b, []int{18}
 }
 func (m *HTTPProxyEnvsConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,19 +2360,39 @@ type IstioControlPlaneStatus struct {
 	// Istio CA root certificate
 	CaRootCertificate string `protobuf:"bytes,7,opt,name=caRootCertificate,proto3" json:"caRootCertificate,omitempty"`
 	// Reconciliation error message if any
-	ErrorMessage         string               `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	MeshConfig           *v1alpha1.MeshConfig `protobuf:"bytes,9,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
-	Checksums            *StatusChecksums     `protobuf:"bytes,10,opt,name=checksums,proto3" json:"checksums,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ErrorMessage string               `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	MeshConfig   *v1alpha1.MeshConfig `protobuf:"bytes,9,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
+	Checksums    *StatusChecksums     `protobuf:"bytes,10,opt,name=checksums,proto3" json:"checksums,omitempty"`
+	// Istio minor version of the chart bundle which was used to render the control plane
+	ChartBundleVersion string `protobuf:"bytes,11,opt,name=chartBundleVersion,proto3" json:"chartBundleVersion,omitempty"`
+	// Latest available observations of the state of the Istio control plane
+	Conditions []Condition `protobuf:"bytes,12,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the Istio control plane which was last reconciled
+	ObservedGeneration int64 `protobuf:"varint,13,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	// Reconciliation state and inventory of the objects of the components of the control plane
+	Components []ComponentStatus `protobuf:"bytes,14,rep,name=components,proto3" json:"components"`
+	// Pending plan of the changes of the control plane when plan mode is enabled
+	Plan *PlanStatus `protobuf:"bytes,15,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Mode of the Istio control plane which was last reconciled
+	Mode ModeType `protobuf:"varint,16,opt,name=mode,proto3,enum=istio_operator.v2.api.v1alpha1.ModeType" json:"mode,omitempty"`
+	// State of the intermediate CA issued by the operator for istiod
+	Ca *CAStatus `protobuf:"bytes,17,opt,name=ca,proto3" json:"ca,omitempty"`
+	// Remote clusters configured for istiod through the multi-cluster secrets of its revision,
+	// along with whether their API server is reachable from the operator
+	ConfiguredRemoteClusters []RemoteClusterStatus `protobuf:"bytes,18,rep,name=configuredRemoteClusters,proto3" json:"configuredRemoteClusters"`
+	// East-west gateways of the mesh networks of the control plane,
+	// the mesh networks of the peers are generated from them
+	Networks             []NetworkStatus `protobuf:"bytes,19,rep,name=networks,proto3" json:"networks"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *IstioControlPlaneStatus) Reset()         { *m = IstioControlPlaneStatus{} }
 func (m *IstioControlPlaneStatus) String() string { return proto.CompactTextString(m) }
 func (*IstioControlPlaneStatus) ProtoMessage()    {}
 func (*IstioControlPlaneStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{16}
+	return fileDescriptor_6817de833805cb8b, []int{19}
 }
 func (m *IstioControlPlaneStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2106,607 +2491,908 @@ func (m *IstioControlPlaneStatus) GetChecksums() *StatusChecksums {
 	return nil
 }
 
-// <!-- go code generation tags
-// +genclient
-// +k8s:deepcopy-gen=true
-// -->
-type StatusChecksums struct {
-	MeshConfig           string   `protobuf:"bytes,1,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
-	SidecarInjector      string   `protobuf:"bytes,2,opt,name=sidecarInjector,proto3" json:"sidecarInjector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *IstioControlPlaneStatus) GetChartBundleVersion() string {
+	if m != nil {
+		return m.ChartBundleVersion
+	}
+	return ""
 }
 
-func (m *StatusChecksums) Reset()         { *m = StatusChecksums{} }
-func (m *StatusChecksums) String() string { return proto.CompactTextString(m) }
-func (*StatusChecksums) ProtoMessage()    {}
-func (*StatusChecksums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6817de833805cb8b, []int{17}
+func (m *IstioControlPlaneStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
 }
-func (m *StatusChecksums) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *IstioControlPlaneStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
 }
-func (m *StatusChecksums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_StatusChecksums.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *IstioControlPlaneStatus) GetComponents() []ComponentStatus {
+	if m != nil {
+		return m.Components
 	}
+	return nil
 }
-func (m *StatusChecksums) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusChecksums.Merge(m, src)
+
+func (m *IstioControlPlaneStatus) GetPlan() *PlanStatus {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
 }
-func (m *StatusChecksums) XXX_Size() int {
+
+func (m *IstioControlPlaneStatus) GetMode() ModeType {
+	if m != nil {
+		return m.Mode
+	}
+	return ModeType_UNSPECIFIED
+}
+
+func (m *IstioControlPlaneStatus) GetCa() *CAStatus {
+	if m != nil {
+		return m.Ca
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneStatus) GetConfiguredRemoteClusters() []RemoteClusterStatus {
+	if m != nil {
+		return m.ConfiguredRemoteClusters
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneStatus) GetNetworks() []NetworkStatus {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+// CAStatus describes the intermediate CA of the cluster issued from the root CA of the mesh
+type CAStatus struct {
+	// Root CA secret the intermediate CA is issued from
+	RootCASecret string `protobuf:"bytes,1,opt,name=rootCASecret,proto3" json:"rootCASecret,omitempty"`
+	// PEM encoded certificate chain of the intermediate CA up to the root CA
+	CertificateChain string `protobuf:"bytes,2,opt,name=certificateChain,proto3" json:"certificateChain,omitempty"`
+	// Time when the current intermediate CA was issued
+	NotBefore *types.Timestamp `protobuf:"bytes,3,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	// Expiry of the current intermediate CA
+	NotAfter *types.Timestamp `protobuf:"bytes,4,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	// Time when the intermediate CA is going to be renewed
+	RenewalTime *types.Timestamp `protobuf:"bytes,5,opt,name=renewalTime,proto3" json:"renewalTime,omitempty"`
+	// State of the root CA rotation in progress if any
+	Rotation             *RootCARotationStatus `protobuf:"bytes,6,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CAStatus) Reset()         { *m = CAStatus{} }
+func (m *CAStatus) String() string { return proto.CompactTextString(m) }
+func (*CAStatus) ProtoMessage()    {}
+func (*CAStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{20}
+}
+func (m *CAStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CAStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CAStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CAStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CAStatus.Merge(m, src)
+}
+func (m *CAStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusChecksums) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusChecksums.DiscardUnknown(m)
+func (m *CAStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CAStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusChecksums proto.InternalMessageInfo
+var xxx_messageInfo_CAStatus proto.InternalMessageInfo
 
-func (m *StatusChecksums) GetMeshConfig() string {
+func (m *CAStatus) GetRootCASecret() string {
 	if m != nil {
-		return m.MeshConfig
+		return m.RootCASecret
 	}
 	return ""
 }
 
-func (m *StatusChecksums) GetSidecarInjector() string {
+func (m *CAStatus) GetCertificateChain() string {
 	if m != nil {
-		return m.SidecarInjector
+		return m.CertificateChain
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ModeType", ModeType_name, ModeType_value)
-	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ProxyLogLevel", ProxyLogLevel_name, ProxyLogLevel_value)
-	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.PilotCertProviderType", PilotCertProviderType_name, PilotCertProviderType_value)
-	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.JWTPolicyType", JWTPolicyType_name, JWTPolicyType_value)
-	proto.RegisterType((*IstioControlPlaneSpec)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec")
-	proto.RegisterType((*SidecarInjectorConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration")
-	proto.RegisterType((*MeshExpansionConfiguration)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration")
-	proto.RegisterType((*MeshExpansionConfiguration_Istiod)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod")
-	proto.RegisterType((*MeshExpansionConfiguration_Webhook)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook")
-	proto.RegisterType((*MeshExpansionConfiguration_ClusterServices)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices")
-	proto.RegisterType((*MeshExpansionConfiguration_IstioMeshGatewayConfiguration)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration")
-	proto.RegisterType((*LoggingConfiguration)(nil), "istio_operator.v2.api.v1alpha1.LoggingConfiguration")
-	proto.RegisterType((*SDSConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SDSConfiguration")
-	proto.RegisterType((*ProxyConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyConfiguration")
-	proto.RegisterType((*ProxyInitConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyInitConfiguration")
-	proto.RegisterType((*CNIConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration")
-	proto.RegisterType((*CNIConfiguration_RepairConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration")
-	proto.RegisterType((*CNIConfiguration_TaintConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration")
-	proto.RegisterType((*CNIConfiguration_ResourceQuotas)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas")
-	proto.RegisterType((*IstiodConfiguration)(nil), "istio_operator.v2.api.v1alpha1.IstiodConfiguration")
-	proto.RegisterType((*ExternalIstiodConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration")
-	proto.RegisterType((*SPIFFEConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SPIFFEConfiguration")
-	proto.RegisterType((*OperatorEndpointsConfiguration)(nil), "istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration")
-	proto.RegisterType((*TelemetryV2Configuration)(nil), "istio_operator.v2.api.v1alpha1.TelemetryV2Configuration")
-	proto.RegisterType((*ProxyWasmConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration")
-	proto.RegisterType((*PDBConfiguration)(nil), "istio_operator.v2.api.v1alpha1.PDBConfiguration")
-	proto.RegisterType((*HTTPProxyEnvsConfiguration)(nil), "istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration")
-	proto.RegisterType((*IstioControlPlaneStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus")
-	proto.RegisterType((*StatusChecksums)(nil), "istio_operator.v2.api.v1alpha1.StatusChecksums")
+func (m *CAStatus) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("api/v1alpha1/istiocontrolplane.proto", fileDescriptor_6817de833805cb8b)
+func (m *CAStatus) GetNotAfter() *types.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
 }
 
-var fileDescriptor_6817de833805cb8b = []byte{
-	// 2433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xf1, 0xff, 0x53, 0x0f, 0x52, 0x2c, 0xea, 0x41, 0xb7, 0xec, 0xdd, 0xf9, 0x73, 0xd7, 0xb2, 0xc0,
-	0x2c, 0x12, 0xc1, 0x59, 0x53, 0x6b, 0xad, 0x37, 0x31, 0xbc, 0x81, 0x37, 0x7c, 0xc9, 0xa6, 0xf5,
-	0x62, 0x86, 0xb4, 0x1d, 0x3b, 0x06, 0x9c, 0xe1, 0x4c, 0x93, 0x6a, 0x6b, 0xd8, 0x3d, 0x99, 0x69,
-	0x52, 0x56, 0x80, 0x9c, 0x72, 0x0b, 0x72, 0x0d, 0x90, 0x63, 0x4e, 0xb9, 0x04, 0xc8, 0x29, 0xf7,
-	0x20, 0x97, 0x20, 0xc7, 0x7c, 0x83, 0x24, 0xfe, 0x24, 0x41, 0x3f, 0x86, 0xe4, 0x0c, 0x29, 0x91,
-	0x5e, 0x3a, 0x37, 0x4d, 0x75, 0xfd, 0x7e, 0x5d, 0x5d, 0x5d, 0xd5, 0x5d, 0xd5, 0x14, 0x7c, 0x66,
-	0x79, 0x64, 0xb7, 0x7f, 0xd7, 0x72, 0xbd, 0x53, 0xeb, 0xee, 0x2e, 0x09, 0x38, 0x61, 0x36, 0xa3,
-	0xdc, 0x67, 0xae, 0xe7, 0x5a, 0x14, 0x17, 0x3c, 0x9f, 0x71, 0x86, 0xb6, 0xe4, 0xc0, 0x6b, 0xe6,
-	0x61, 0xdf, 0xe2, 0xcc, 0x2f, 0xf4, 0xf7, 0x0a, 0x96, 0x47, 0x0a, 0x21, 0x2e, 0xf7, 0xff, 0x11,
-	0x16, 0x9b, 0x75, 0xbb, 0x8c, 0x2a, 0x68, 0xee, 0x3b, 0xe3, 0x13, 0x74, 0x71, 0x70, 0xda, 0xb1,
-	0x38, 0x3e, 0xb7, 0x2e, 0xb4, 0x52, 0xfe, 0xec, 0x7e, 0x50, 0x20, 0x6c, 0x57, 0xe8, 0xda, 0xcc,
-	0xc7, 0xbb, 0xfd, 0xbb, 0xbb, 0x1d, 0x4c, 0xc5, 0x6c, 0xd8, 0xd1, 0x3a, 0x39, 0x01, 0x1b, 0x9d,
-	0x84, 0xb6, 0x49, 0x47, 0x8f, 0x5d, 0xef, 0xb0, 0x0e, 0x93, 0x7f, 0xee, 0x8a, 0xbf, 0xb4, 0xf4,
-	0x56, 0x87, 0xb1, 0x8e, 0x8b, 0x25, 0x6b, 0x9b, 0x60, 0xd7, 0x79, 0xdd, 0xc2, 0xa7, 0x56, 0x9f,
-	0x30, 0x5f, 0x2b, 0x6c, 0x69, 0x05, 0xf9, 0xd5, 0xea, 0xb5, 0x77, 0xcf, 0x7d, 0xcb, 0xf3, 0xb0,
-	0x1f, 0xa8, 0xf1, 0xfc, 0x1f, 0xd7, 0xe0, 0x46, 0x4d, 0x58, 0x5c, 0x56, 0x2e, 0xa9, 0x0b, 0x97,
-	0x34, 0x3c, 0x6c, 0xa3, 0x2d, 0x48, 0xf5, 0xb1, 0x1f, 0x10, 0x46, 0x8d, 0xc4, 0x76, 0x62, 0x27,
-	0x5d, 0x5a, 0x7a, 0x57, 0x4c, 0x2c, 0x98, 0xa1, 0x10, 0x95, 0x60, 0xa9, 0xcb, 0x1c, 0x6c, 0x2c,
-	0x6c, 0x27, 0x76, 0xd6, 0xf7, 0x76, 0x0a, 0x57, 0xfb, 0xaf, 0x70, 0xc4, 0x1c, 0xdc, 0xbc, 0xf0,
-	0xb0, 0xa6, 0x91, 0x58, 0x74, 0x0c, 0x29, 0x97, 0x75, 0x3a, 0x84, 0x76, 0x8c, 0xc5, 0xed, 0xc4,
-	0x4e, 0x66, 0xef, 0xde, 0x34, 0x9a, 0x43, 0xa5, 0x5e, 0x96, 0xae, 0xe9, 0xf9, 0x16, 0x27, 0x8c,
-	0x9a, 0x21, 0x09, 0x7a, 0x0c, 0xeb, 0x5d, 0xd6, 0xa3, 0xfc, 0x88, 0xbb, 0x41, 0x19, 0xfb, 0x3c,
-	0x30, 0x96, 0x24, 0x6d, 0xae, 0xa0, 0xdc, 0x50, 0x08, 0xdd, 0x50, 0x28, 0x31, 0xe6, 0x3e, 0xb3,
-	0xdc, 0x1e, 0x2e, 0x2d, 0xfd, 0xe1, 0x5f, 0xb7, 0x12, 0x66, 0x0c, 0x87, 0x0e, 0x20, 0x29, 0x2d,
-	0x71, 0x8c, 0x65, 0xc9, 0xf0, 0xe5, 0x34, 0xc3, 0xa4, 0x13, 0x9d, 0xa8, 0x5d, 0x9a, 0x02, 0x3d,
-	0x86, 0x65, 0xcf, 0x67, 0x6f, 0x2f, 0x8c, 0xa4, 0xe4, 0xda, 0x9b, 0xc6, 0x55, 0x17, 0xca, 0x51,
-	0x2a, 0x45, 0x80, 0x9a, 0x90, 0x96, 0x7f, 0xd4, 0x28, 0xe1, 0x46, 0x4a, 0xb2, 0xfd, 0x60, 0x26,
-	0x36, 0x01, 0x88, 0x32, 0x0e, 0x89, 0xd0, 0x4b, 0xc8, 0x70, 0xec, 0xe2, 0x2e, 0xe6, 0xfe, 0xc5,
-	0xb3, 0x3d, 0x63, 0x45, 0xf2, 0xde, 0x9f, 0xc6, 0xdb, 0x1c, 0x42, 0xa2, 0xcc, 0xa3, 0x64, 0xa8,
-	0x04, 0x8b, 0x81, 0x13, 0x18, 0x69, 0xc9, 0xf9, 0xc5, 0x34, 0xce, 0x46, 0xa5, 0x11, 0xe5, 0x12,
-	0xe0, 0xc1, 0xaa, 0x9f, 0x5b, 0x41, 0xd7, 0x80, 0xf7, 0x58, 0xb5, 0x00, 0x4c, 0x5a, 0xb5, 0x90,
-	0xa3, 0x63, 0xb8, 0x76, 0x6e, 0x71, 0xfb, 0xf4, 0x84, 0xe2, 0x63, 0xab, 0x8b, 0x03, 0xcf, 0xb2,
-	0xb1, 0x91, 0x99, 0x31, 0x5e, 0xc6, 0xa1, 0xe8, 0x00, 0xd2, 0x6f, 0xce, 0x79, 0x9d, 0xb9, 0xc4,
-	0xbe, 0x30, 0x56, 0x65, 0x56, 0xdc, 0x99, 0x66, 0xe5, 0x93, 0xe7, 0x4d, 0x05, 0x10, 0xa9, 0x61,
-	0x0e, 0xf1, 0xe8, 0x53, 0x48, 0xdb, 0x56, 0xd1, 0x71, 0x7c, 0x1c, 0x04, 0xc6, 0x9a, 0xc8, 0x3f,
-	0x73, 0x28, 0x40, 0x5b, 0x00, 0xb6, 0x55, 0xf7, 0x59, 0x9f, 0x38, 0xd8, 0x37, 0xd6, 0xe5, 0xf0,
-	0x88, 0x04, 0xe5, 0x61, 0xd5, 0x21, 0x01, 0xf7, 0x49, 0xab, 0x27, 0x56, 0x6d, 0x6c, 0x48, 0x8d,
-	0x88, 0x0c, 0xfd, 0x1c, 0xd6, 0x4e, 0x39, 0xf7, 0xa4, 0x9f, 0xaa, 0xb4, 0x1f, 0x18, 0x59, 0xb9,
-	0xf4, 0x07, 0xd3, 0x4c, 0x7e, 0xdc, 0x6c, 0xd6, 0x07, 0xa0, 0xa8, 0x73, 0xa3, 0x84, 0xe8, 0x1b,
-	0x00, 0x71, 0xa0, 0x29, 0x1d, 0xe3, 0x9a, 0xa4, 0xbf, 0xa5, 0xe8, 0x0b, 0x62, 0x60, 0xe4, 0x70,
-	0x18, 0xa8, 0x99, 0x23, 0x10, 0x44, 0x60, 0xf3, 0xec, 0x7e, 0x60, 0xe2, 0x80, 0xf5, 0x7c, 0x1b,
-	0x9f, 0xf4, 0xb1, 0xef, 0x5a, 0x17, 0x81, 0x81, 0xb6, 0x17, 0x77, 0x32, 0x7b, 0x3f, 0x9c, 0x66,
-	0xe8, 0xc1, 0x18, 0xb4, 0x2e, 0xf6, 0xcc, 0x9c, 0xc4, 0x89, 0x3e, 0x82, 0xa4, 0x98, 0xb8, 0x56,
-	0x31, 0x36, 0xa5, 0xaf, 0xf4, 0x17, 0xfa, 0x15, 0x7c, 0x22, 0x2e, 0x0b, 0x8b, 0x50, 0xec, 0xd7,
-	0xba, 0x56, 0x07, 0x47, 0x56, 0x6c, 0x5c, 0x97, 0x8b, 0xfa, 0x7a, 0x9a, 0x29, 0xe5, 0xcb, 0x29,
-	0xcc, 0xab, 0xf8, 0xc5, 0x26, 0x09, 0x43, 0xaa, 0x6f, 0x3d, 0x8b, 0xca, 0xa3, 0xf8, 0xc6, 0x6c,
-	0x9b, 0x74, 0x34, 0x0a, 0x8a, 0x6d, 0x52, 0x84, 0x50, 0x06, 0x9a, 0xdb, 0x0b, 0x38, 0xf6, 0x6b,
-	0x15, 0xe3, 0x23, 0x1d, 0x68, 0xa1, 0x00, 0x6d, 0x43, 0x86, 0x62, 0x7e, 0xce, 0xfc, 0x33, 0x11,
-	0xe7, 0xc6, 0xc7, 0x72, 0x7c, 0x54, 0x84, 0xda, 0xb0, 0x11, 0x10, 0x07, 0xdb, 0x96, 0x5f, 0xa3,
-	0x6f, 0xb0, 0xcd, 0x99, 0x6f, 0x18, 0xd2, 0xc6, 0x1f, 0x4d, 0xcd, 0xf5, 0x28, 0x2c, 0x6a, 0x65,
-	0x9c, 0x34, 0xff, 0xd7, 0x04, 0x7c, 0x7a, 0x15, 0x02, 0xbd, 0x02, 0x70, 0xb0, 0xe7, 0xb2, 0x8b,
-	0x2e, 0xa6, 0x5c, 0x5e, 0x59, 0x33, 0xd8, 0x50, 0xb2, 0x02, 0x7c, 0xd0, 0x6b, 0x61, 0x9f, 0x62,
-	0x8e, 0x07, 0x51, 0x11, 0x86, 0xe2, 0x90, 0x0f, 0x15, 0x21, 0x15, 0x60, 0xbf, 0x4f, 0x6c, 0x75,
-	0xe1, 0x65, 0xf6, 0xbe, 0x37, 0x75, 0x79, 0x4a, 0xdd, 0x0c, 0x71, 0xf9, 0xdf, 0xa5, 0x21, 0x77,
-	0xf9, 0xbe, 0xa0, 0x07, 0x90, 0xc2, 0xd4, 0x6a, 0xb9, 0xd8, 0xd1, 0xc6, 0x4f, 0x3f, 0x84, 0x42,
-	0x00, 0xf2, 0x21, 0xa5, 0xab, 0x0d, 0x6d, 0xdd, 0x4f, 0xbf, 0x7d, 0x80, 0xa8, 0x9b, 0x4c, 0x8c,
-	0x3f, 0x52, 0x94, 0xb1, 0xbb, 0x56, 0x4f, 0x84, 0x5e, 0x0c, 0x6e, 0x48, 0x75, 0x75, 0x17, 0xe7,
-	0x9d, 0xd2, 0x19, 0xdc, 0x97, 0xaf, 0x20, 0x75, 0x8e, 0x5b, 0xa7, 0x8c, 0x9d, 0xe9, 0xfb, 0xbb,
-	0x34, 0x07, 0xf7, 0x73, 0xc5, 0x64, 0x86, 0x94, 0x88, 0xc3, 0x86, 0x0e, 0x70, 0xbd, 0x45, 0x81,
-	0xbe, 0xe3, 0x9f, 0xcc, 0x31, 0x4b, 0x39, 0xca, 0x68, 0xc6, 0xa7, 0xc8, 0x95, 0x20, 0xa9, 0x56,
-	0x89, 0xee, 0x43, 0x12, 0xbf, 0xf5, 0x58, 0x80, 0x67, 0xde, 0x67, 0xad, 0x9f, 0x2b, 0x43, 0x4a,
-	0xaf, 0x66, 0x0e, 0x92, 0x03, 0xd8, 0x88, 0x19, 0x3b, 0x07, 0xd9, 0xdf, 0x16, 0xe1, 0xe6, 0x95,
-	0xf1, 0x82, 0x6a, 0xb0, 0xd2, 0xc5, 0xdc, 0x72, 0x2c, 0x6e, 0x69, 0xf6, 0x3b, 0x33, 0x1c, 0xdc,
-	0x27, 0x2d, 0x91, 0xe2, 0x47, 0x98, 0x5b, 0xe6, 0x00, 0x1e, 0xcb, 0xf0, 0x85, 0x0f, 0x9c, 0xe1,
-	0x87, 0xc3, 0x0c, 0x5f, 0x9c, 0xad, 0x4c, 0x7b, 0x4a, 0x85, 0x7f, 0xb0, 0xcd, 0xb1, 0x13, 0x4f,
-	0x76, 0xf4, 0x10, 0xd2, 0x7e, 0x8f, 0x16, 0x03, 0x93, 0x31, 0x3e, 0x73, 0x11, 0x3a, 0x84, 0x5c,
-	0x76, 0xf5, 0x2d, 0x7f, 0xf8, 0xab, 0x2f, 0xff, 0x39, 0x5c, 0x9f, 0x54, 0x55, 0xa3, 0xeb, 0xb0,
-	0xec, 0xe2, 0x3e, 0x76, 0x55, 0xf9, 0x6f, 0xaa, 0x8f, 0xfc, 0x7d, 0xc8, 0xc6, 0x8b, 0x34, 0xf4,
-	0x19, 0xac, 0x71, 0x76, 0x86, 0x69, 0xb1, 0xe7, 0x10, 0x4c, 0x6d, 0xac, 0x11, 0x51, 0x61, 0xfe,
-	0xb7, 0x49, 0x40, 0xe3, 0x95, 0xad, 0x98, 0x86, 0x88, 0x8b, 0x2f, 0x9c, 0x46, 0x7e, 0xa0, 0x1f,
-	0x03, 0x78, 0x3e, 0xe9, 0x13, 0x17, 0x77, 0xb0, 0xa3, 0xf7, 0x7a, 0xba, 0x03, 0x47, 0x30, 0xa2,
-	0x17, 0x50, 0xc7, 0x63, 0x99, 0xf9, 0xb8, 0xd2, 0xeb, 0x7a, 0x7a, 0x5b, 0x67, 0xe8, 0x05, 0xa2,
-	0x38, 0x11, 0xc2, 0x2e, 0xeb, 0x1c, 0x4a, 0x5f, 0x2c, 0xcd, 0x56, 0xd7, 0xc9, 0x75, 0x1e, 0x6a,
-	0x90, 0x39, 0x80, 0xa3, 0xcf, 0xe1, 0x9a, 0xcd, 0xba, 0x1e, 0xa3, 0x98, 0xf2, 0x70, 0x58, 0x9e,
-	0x3e, 0x69, 0x73, 0x7c, 0x40, 0xf8, 0x55, 0x1f, 0x23, 0x15, 0xd6, 0xb5, 0x08, 0x95, 0xfd, 0x43,
-	0xda, 0x8c, 0x0a, 0xd1, 0x1b, 0xb8, 0x75, 0xca, 0x5c, 0xa7, 0xe8, 0x79, 0x2e, 0xb1, 0xa5, 0x4f,
-	0x9f, 0x52, 0x4e, 0x5c, 0x69, 0x42, 0x83, 0x5b, 0xa2, 0x0b, 0x4a, 0xcd, 0xb8, 0xf2, 0x69, 0x44,
-	0xe8, 0x6b, 0x48, 0xbb, 0xa4, 0x8d, 0xed, 0x0b, 0xdb, 0xc5, 0xba, 0x4f, 0xb8, 0x59, 0x50, 0x9d,
-	0xad, 0x74, 0x80, 0xe8, 0x6c, 0x0b, 0xfd, 0xbb, 0x85, 0xc3, 0x50, 0xc9, 0x1c, 0xea, 0x23, 0x13,
-	0xd2, 0xbe, 0x0e, 0xbe, 0xb0, 0x21, 0x98, 0xda, 0xef, 0x85, 0xd1, 0x6a, 0xe2, 0x5f, 0xf4, 0x88,
-	0x8f, 0x45, 0xa6, 0x06, 0xe6, 0x90, 0x06, 0xed, 0xc0, 0x06, 0xa1, 0xb6, 0xdb, 0x73, 0x70, 0xad,
-	0x6e, 0x5a, 0xb4, 0x83, 0x03, 0xd9, 0x20, 0xa4, 0xcd, 0xb8, 0x58, 0x68, 0xe2, 0xb7, 0x51, 0xcd,
-	0x8c, 0xd2, 0x8c, 0x89, 0xd1, 0x17, 0xb0, 0x19, 0x8a, 0x68, 0x8b, 0xf5, 0xa8, 0x53, 0x67, 0xc2,
-	0x89, 0xab, 0x52, 0x7b, 0xd2, 0x10, 0xda, 0x83, 0xeb, 0x5a, 0x7c, 0xd2, 0xe3, 0x23, 0x10, 0x55,
-	0xb8, 0x4f, 0x1c, 0xcb, 0xff, 0x3d, 0x01, 0x1f, 0x4d, 0x6e, 0xcd, 0x2e, 0x49, 0x89, 0x88, 0xfb,
-	0x16, 0x3e, 0x8c, 0xfb, 0x4a, 0xb0, 0x68, 0x53, 0xa2, 0x33, 0x63, 0x6a, 0x77, 0x56, 0x3e, 0xae,
-	0xc5, 0xba, 0x33, 0x9b, 0x92, 0xfc, 0x9f, 0x33, 0x90, 0x8d, 0x8f, 0xcc, 0x55, 0xcd, 0x3c, 0x80,
-	0x94, 0x7d, 0x2a, 0x2a, 0xe2, 0xd9, 0x13, 0x3f, 0x04, 0x88, 0x3a, 0xbe, 0x45, 0x68, 0x85, 0xf8,
-	0x32, 0x53, 0xd3, 0xa6, 0xfe, 0x42, 0x06, 0xa4, 0x6c, 0x46, 0xdb, 0x62, 0x40, 0xa5, 0x5b, 0xf8,
-	0x29, 0x52, 0x52, 0xef, 0xcf, 0xa0, 0x95, 0x0b, 0x8c, 0xe4, 0xf6, 0xa2, 0x48, 0xc9, 0xb1, 0x01,
-	0xa1, 0xad, 0x03, 0x6b, 0x44, 0x3b, 0xa5, 0xb4, 0xc7, 0x06, 0x50, 0x6e, 0xe4, 0xe4, 0x58, 0x91,
-	0xd3, 0x0e, 0x8f, 0x82, 0x3c, 0xac, 0x0a, 0x13, 0xf6, 0x89, 0x2b, 0x11, 0x32, 0x21, 0xd2, 0x66,
-	0x44, 0x86, 0x0a, 0x80, 0xbc, 0xc0, 0xd3, 0xd7, 0xb5, 0xc9, 0xb4, 0xa6, 0x0a, 0xf0, 0x09, 0x23,
-	0xe8, 0x15, 0x24, 0x7d, 0xec, 0x59, 0xc4, 0xd7, 0x7d, 0x6c, 0xe5, 0x7d, 0x77, 0xb4, 0x60, 0x4a,
-	0x78, 0xec, 0x19, 0x43, 0x71, 0xa2, 0x17, 0xb0, 0x2c, 0x1a, 0x15, 0x2e, 0x33, 0x21, 0xb3, 0x57,
-	0x7e, 0x6f, 0xf2, 0xa6, 0x40, 0xc7, 0xde, 0x35, 0x24, 0x23, 0xea, 0xc0, 0x7a, 0x18, 0x94, 0x3f,
-	0xe9, 0x31, 0x6e, 0xa9, 0xd4, 0xc9, 0xec, 0x7d, 0xf3, 0x2d, 0x16, 0x30, 0x4a, 0x63, 0xc6, 0x68,
-	0xd1, 0x4b, 0x48, 0x3b, 0x16, 0xee, 0x32, 0x1a, 0x60, 0x2e, 0x1b, 0xe7, 0x79, 0x4b, 0x88, 0x21,
-	0x5d, 0xee, 0x3f, 0x0b, 0xb0, 0x39, 0xc1, 0x7f, 0x73, 0xe5, 0xc2, 0x43, 0x48, 0xbb, 0x56, 0x0b,
-	0xbb, 0x75, 0xe6, 0x04, 0x33, 0x67, 0xc3, 0x10, 0x22, 0xee, 0x51, 0x07, 0xbb, 0x98, 0x63, 0x49,
-	0x30, 0xeb, 0x0d, 0x38, 0x82, 0x51, 0x11, 0x2f, 0x4f, 0x28, 0xd5, 0xa5, 0xca, 0x10, 0x54, 0xc9,
-	0x35, 0x3e, 0x20, 0xb4, 0x5b, 0xbe, 0xb8, 0xf6, 0xeb, 0xcc, 0x39, 0x14, 0x56, 0x1c, 0xe0, 0x8b,
-	0xf0, 0x82, 0x1b, 0x1b, 0x10, 0x27, 0x6d, 0x54, 0x28, 0x8d, 0xd0, 0xd7, 0xdc, 0xa4, 0xa1, 0xdc,
-	0x5f, 0x12, 0x80, 0xc6, 0xc3, 0x68, 0x2e, 0x17, 0xb7, 0x20, 0x3d, 0x68, 0xc1, 0xb5, 0x8b, 0x2b,
-	0xef, 0x17, 0x12, 0x03, 0x17, 0xc4, 0xde, 0x9a, 0x06, 0xb4, 0xb9, 0xdf, 0x24, 0x60, 0x3d, 0x1a,
-	0x99, 0x73, 0x99, 0x8c, 0x60, 0xc9, 0x0b, 0x03, 0x22, 0x6d, 0xca, 0xbf, 0xc5, 0xfd, 0xe6, 0xf9,
-	0x84, 0xf9, 0x84, 0x5f, 0x94, 0x5d, 0x2b, 0x08, 0xb0, 0xd8, 0x6e, 0x71, 0x2e, 0xc5, 0xc5, 0xf9,
-	0x3f, 0x25, 0x61, 0x73, 0xc2, 0x73, 0xe5, 0xff, 0xb8, 0x83, 0x1e, 0xd4, 0x63, 0x45, 0x6a, 0xb9,
-	0x17, 0x01, 0x99, 0x3d, 0x9c, 0x63, 0x38, 0x54, 0x81, 0x55, 0x25, 0x69, 0x70, 0x8b, 0xf7, 0x66,
-	0x8f, 0xea, 0x08, 0x0a, 0xd9, 0xb0, 0x8e, 0xdf, 0x72, 0xec, 0x53, 0xcb, 0x55, 0xce, 0xd0, 0x65,
-	0xfa, 0xd4, 0xc7, 0x9c, 0x6a, 0x04, 0x15, 0xdd, 0xf2, 0x18, 0x25, 0x7a, 0x04, 0x6b, 0xdc, 0xb7,
-	0x6c, 0xdc, 0xb0, 0xba, 0x9e, 0x4b, 0x68, 0x47, 0x77, 0x9a, 0x9f, 0x8c, 0xd9, 0xba, 0xef, 0x32,
-	0x8b, 0x8f, 0x1a, 0x1b, 0xc5, 0xa1, 0x53, 0xd8, 0x52, 0xd6, 0xd7, 0x05, 0xc2, 0x66, 0x6e, 0x83,
-	0x92, 0x76, 0x9b, 0xd0, 0x4e, 0x58, 0x54, 0xe8, 0xb7, 0xe5, 0xe9, 0x5e, 0x98, 0xc2, 0x83, 0xda,
-	0x70, 0x73, 0xb2, 0x86, 0xae, 0x78, 0x66, 0x2e, 0x26, 0xaf, 0xa6, 0x41, 0x2f, 0x60, 0xd5, 0xc6,
-	0x3e, 0x1f, 0xbc, 0x62, 0xae, 0xc8, 0xca, 0xfa, 0xab, 0xa9, 0x95, 0x35, 0x71, 0x19, 0x2f, 0x8f,
-	0x00, 0xe5, 0xcb, 0x69, 0x84, 0x0a, 0x1d, 0x40, 0x32, 0xf0, 0x48, 0xbb, 0x8d, 0x75, 0x95, 0x39,
-	0xf5, 0xf1, 0xbe, 0x51, 0xaf, 0xed, 0xef, 0x57, 0x63, 0xb7, 0x9e, 0xa2, 0xc8, 0xbf, 0x80, 0x4f,
-	0xae, 0xd8, 0xf1, 0x79, 0xd2, 0x38, 0xff, 0xeb, 0x04, 0x6c, 0x4e, 0x98, 0x1a, 0xb9, 0x70, 0x2d,
-	0x34, 0xb5, 0x4a, 0x1d, 0x8f, 0x11, 0xca, 0x03, 0xcd, 0xfe, 0x70, 0xda, 0x52, 0x4e, 0xe2, 0xc0,
-	0xe8, 0xaa, 0xc6, 0x89, 0xf3, 0xaf, 0x60, 0xeb, 0x6a, 0xd0, 0x5c, 0x6b, 0x7c, 0x06, 0xc6, 0x65,
-	0x3f, 0x14, 0xcc, 0xc5, 0xdb, 0xd4, 0xd5, 0xf3, 0xd8, 0x13, 0xff, 0x5c, 0xac, 0xc7, 0x90, 0xad,
-	0x57, 0x4a, 0x1f, 0x8e, 0x8f, 0x43, 0xee, 0xf2, 0xf7, 0x72, 0xf4, 0x29, 0xa4, 0x07, 0x2f, 0xe6,
-	0xba, 0xd6, 0x1f, 0x0a, 0xd0, 0x16, 0x80, 0xf8, 0x08, 0xd4, 0xb0, 0x3a, 0xea, 0x47, 0x24, 0xa2,
-	0xa4, 0xa5, 0x4c, 0x0d, 0x2e, 0xaa, 0x92, 0x56, 0x7f, 0xe6, 0x7f, 0xbf, 0x04, 0x1f, 0x8f, 0xff,
-	0xa8, 0xa7, 0x8e, 0xbd, 0x32, 0x24, 0x03, 0x75, 0x6c, 0x26, 0x64, 0xc2, 0x7d, 0x7f, 0x86, 0xb7,
-	0xeb, 0x36, 0xe9, 0x08, 0x34, 0x36, 0x35, 0x34, 0xfa, 0x68, 0xbc, 0x10, 0x7f, 0x34, 0xbe, 0x07,
-	0x37, 0x48, 0x7c, 0x76, 0x59, 0x35, 0x28, 0x33, 0x27, 0x0f, 0xa2, 0xef, 0xc2, 0xba, 0x7e, 0x5a,
-	0x0c, 0x7f, 0xf6, 0x58, 0x92, 0xd7, 0x57, 0x4c, 0x2a, 0x3b, 0x3e, 0x99, 0x87, 0x5a, 0x80, 0xd5,
-	0xab, 0x88, 0xe8, 0xf8, 0xa2, 0x62, 0x51, 0x5d, 0x10, 0xf9, 0x54, 0x4c, 0x18, 0x1d, 0xab, 0xed,
-	0x27, 0x0d, 0xc9, 0xf6, 0xdc, 0x32, 0x99, 0x3a, 0x60, 0x48, 0x5b, 0x74, 0xc1, 0x58, 0x9e, 0x77,
-	0xa2, 0x3d, 0x8f, 0x0f, 0x88, 0x0a, 0x1e, 0xfb, 0x3e, 0xf3, 0x8f, 0x70, 0x10, 0x88, 0x6e, 0x4d,
-	0x55, 0xf8, 0x11, 0x59, 0xec, 0x37, 0x90, 0xf4, 0xfb, 0xff, 0x06, 0x72, 0x04, 0x69, 0xfb, 0x14,
-	0xdb, 0x67, 0x41, 0xaf, 0x1b, 0xe8, 0xdf, 0xbe, 0x76, 0xa7, 0x1e, 0x67, 0x72, 0x97, 0xca, 0x21,
-	0xcc, 0x1c, 0x32, 0xe4, 0x7f, 0x06, 0x1b, 0xb1, 0x51, 0x11, 0x67, 0x23, 0x26, 0xaa, 0x30, 0x1c,
-	0xb5, 0x60, 0x67, 0xfc, 0x85, 0x5f, 0x6d, 0x79, 0x5c, 0x7c, 0xfb, 0x1e, 0xac, 0x84, 0x3f, 0xf3,
-	0xa2, 0x0d, 0xc8, 0x3c, 0x3d, 0x6e, 0xd4, 0xab, 0xe5, 0xda, 0x7e, 0xad, 0x5a, 0xc9, 0xfe, 0x1f,
-	0x02, 0x48, 0x16, 0xcb, 0xcd, 0xda, 0xb3, 0x6a, 0x36, 0x81, 0x32, 0x90, 0xaa, 0x17, 0x1b, 0x0d,
-	0xf1, 0xb1, 0x70, 0x9b, 0xc1, 0x5a, 0xe4, 0xb9, 0x64, 0x1c, 0x9a, 0x86, 0xe5, 0xa6, 0x59, 0x2c,
-	0x0b, 0x64, 0x1a, 0x96, 0x2b, 0xd5, 0xd2, 0xd3, 0x47, 0xd9, 0x05, 0xb4, 0x02, 0x4b, 0xb5, 0xe3,
-	0xfd, 0x93, 0xec, 0xa2, 0xa0, 0x7b, 0x5e, 0x34, 0x8f, 0x6b, 0xc7, 0x8f, 0xb2, 0x4b, 0x42, 0xa3,
-	0x6a, 0x9a, 0x27, 0x66, 0x76, 0x19, 0xad, 0xc2, 0x4a, 0xd9, 0xac, 0x35, 0x6b, 0xe5, 0xe2, 0x61,
-	0x36, 0x89, 0x52, 0xb0, 0x78, 0xb2, 0xbf, 0x9f, 0x4d, 0xdd, 0xae, 0xc0, 0x8d, 0x89, 0xb7, 0xc8,
-	0xf8, 0xc4, 0xeb, 0x00, 0x07, 0x4f, 0x4b, 0x55, 0xf3, 0xb8, 0xda, 0xac, 0x36, 0xb2, 0x09, 0xb1,
-	0x86, 0x5a, 0xa3, 0x59, 0x3b, 0xa9, 0x64, 0x17, 0x6e, 0x3f, 0x81, 0xb5, 0xc8, 0xaf, 0x77, 0xe3,
-	0xe8, 0x4d, 0xd8, 0x68, 0x3e, 0xae, 0x99, 0x95, 0xd7, 0xf5, 0xa2, 0xd9, 0x7c, 0xf1, 0xfa, 0xc9,
-	0xf3, 0x66, 0x36, 0x21, 0x84, 0xfb, 0x35, 0xb3, 0xd1, 0x1c, 0x11, 0x2e, 0x94, 0xca, 0xff, 0x78,
-	0xb7, 0x95, 0xf8, 0xe7, 0xbb, 0xad, 0xc4, 0xbf, 0xdf, 0x6d, 0x25, 0x5e, 0x7e, 0xd5, 0x21, 0xfc,
-	0xb4, 0xd7, 0x2a, 0xd8, 0xac, 0xbb, 0xdb, 0xb2, 0xe8, 0x2f, 0x2d, 0x62, 0xbb, 0xac, 0xe7, 0xa8,
-	0xff, 0x2c, 0xb8, 0x13, 0xee, 0xfa, 0x6e, 0x7f, 0x6f, 0x77, 0xf4, 0x1f, 0x0f, 0x5a, 0x49, 0x79,
-	0x1c, 0x7d, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x72, 0x96, 0x89, 0xea, 0xf0, 0x20, 0x00,
-	0x00,
+func (m *CAStatus) GetRenewalTime() *types.Timestamp {
+	if m != nil {
+		return m.RenewalTime
+	}
+	return nil
 }
 
-func (m *IstioControlPlaneSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CAStatus) GetRotation() *RootCARotationStatus {
+	if m != nil {
+		return m.Rotation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IstioControlPlaneSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+// RootCARotationStatus describes the progress of the rotation of the root CA of the plug-in CA
+type RootCARotationStatus struct {
+	// Current phase of the rotation
+	Phase RootCARotationPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=istio_operator.v2.api.v1alpha1.RootCARotationPhase" json:"phase,omitempty"`
+	// Secret of the new root CA
+	NextRootCASecret string `protobuf:"bytes,2,opt,name=nextRootCASecret,proto3" json:"nextRootCASecret,omitempty"`
+	// Cluster IDs of the peer control planes the current phase is waiting for
+	PendingPeers []string `protobuf:"bytes,3,rep,name=pendingPeers,proto3" json:"pendingPeers,omitempty"`
+	// Namespaces whose workloads are still to be restarted
+	PendingNamespaces []string `protobuf:"bytes,4,rep,name=pendingNamespaces,proto3" json:"pendingNamespaces,omitempty"`
+	// Namespaces whose workloads are being restarted, the next batch starts when their workloads are ready
+	CurrentBatch []string `protobuf:"bytes,5,rep,name=currentBatch,proto3" json:"currentBatch,omitempty"`
+	// Namespaces whose workloads are already restarted
+	RestartedNamespaces []string `protobuf:"bytes,6,rep,name=restartedNamespaces,proto3" json:"restartedNamespaces,omitempty"`
+	// Time when the rotation entered the current phase
+	LastTransitionTime   *types.Timestamp `protobuf:"bytes,7,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RootCARotationStatus) Reset()         { *m = RootCARotationStatus{} }
+func (m *RootCARotationStatus) String() string { return proto.CompactTextString(m) }
+func (*RootCARotationStatus) ProtoMessage()    {}
+func (*RootCARotationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{21}
+}
+func (m *RootCARotationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *IstioControlPlaneSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SidecarInjector != nil {
-		{
-			size, err := m.SidecarInjector.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+func (m *RootCARotationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RootCARotationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+		return b[:n], nil
 	}
-	if len(m.NetworkName) > 0 {
-		i -= len(m.NetworkName)
-		copy(dAtA[i:], m.NetworkName)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.NetworkName)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+}
+func (m *RootCARotationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootCARotationStatus.Merge(m, src)
+}
+func (m *RootCARotationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RootCARotationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootCARotationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootCARotationStatus proto.InternalMessageInfo
+
+func (m *RootCARotationStatus) GetPhase() RootCARotationPhase {
+	if m != nil {
+		return m.Phase
 	}
-	if len(m.ClusterID) > 0 {
-		i -= len(m.ClusterID)
-		copy(dAtA[i:], m.ClusterID)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ClusterID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+	return RootCARotationPhase_AddingTrust
+}
+
+func (m *RootCARotationStatus) GetNextRootCASecret() string {
+	if m != nil {
+		return m.NextRootCASecret
 	}
-	if m.MeshExpansion != nil {
-		{
-			size, err := m.MeshExpansion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return ""
+}
+
+func (m *RootCARotationStatus) GetPendingPeers() []string {
+	if m != nil {
+		return m.PendingPeers
 	}
-	if m.ContainerImageConfiguration != nil {
-		{
-			size, err := m.ContainerImageConfiguration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return nil
+}
+
+func (m *RootCARotationStatus) GetPendingNamespaces() []string {
+	if m != nil {
+		return m.PendingNamespaces
 	}
-	if len(m.MeshID) > 0 {
-		i -= len(m.MeshID)
-		copy(dAtA[i:], m.MeshID)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.MeshID)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	return nil
+}
+
+func (m *RootCARotationStatus) GetCurrentBatch() []string {
+	if m != nil {
+		return m.CurrentBatch
 	}
-	if len(m.K8SResourceOverlays) > 0 {
-		for iNdEx := len(m.K8SResourceOverlays) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.K8SResourceOverlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return nil
+}
+
+func (m *RootCARotationStatus) GetRestartedNamespaces() []string {
+	if m != nil {
+		return m.RestartedNamespaces
 	}
-	if m.MeshConfig != nil {
-		{
-			size, err := m.MeshConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return nil
+}
+
+func (m *RootCARotationStatus) GetLastTransitionTime() *types.Timestamp {
+	if m != nil {
+		return m.LastTransitionTime
 	}
-	if m.HttpProxyEnvs != nil {
-		{
-			size, err := m.HttpProxyEnvs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.Distribution) > 0 {
-		i -= len(m.Distribution)
-		copy(dAtA[i:], m.Distribution)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Distribution)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.CaProvider) > 0 {
-		i -= len(m.CaProvider)
-		copy(dAtA[i:], m.CaProvider)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaProvider)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.CaAddress) > 0 {
-		i -= len(m.CaAddress)
-		copy(dAtA[i:], m.CaAddress)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaAddress)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.JwtPolicy != 0 {
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.JwtPolicy))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.WatchOneNamespace != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.WatchOneNamespace, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.WatchOneNamespace):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.ProxyWasm != nil {
-		{
-			size, err := m.ProxyWasm.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Sds != nil {
-		{
-			size, err := m.Sds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.TelemetryV2 != nil {
-		{
-			size, err := m.TelemetryV2.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ProxyInit != nil {
-		{
-			size, err := m.ProxyInit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Proxy != nil {
-		{
-			size, err := m.Proxy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Istiod != nil {
-		{
-			size, err := m.Istiod.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.MountMtlsCerts != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.MountMtlsCerts, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.MountMtlsCerts):])
-		if err13 != nil {
-			return 0, err13
-		}
-		i -= n13
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Logging != nil {
-		{
-			size, err := m.Logging.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+	return nil
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+// RemoteClusterStatus describes a remote cluster configured for istiod through a multi-cluster secret
+type RemoteClusterStatus struct {
+	// ID of the remote cluster
+	ClusterID string `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	// Name of the multi-cluster secret which holds the kubeconfig of the remote cluster
+	SecretName string `protobuf:"bytes,2,opt,name=secretName,proto3" json:"secretName,omitempty"`
+	// Whether the API server of the remote cluster is reachable from the operator with the kubeconfig of the secret
+	State RemoteClusterState `protobuf:"varint,3,opt,name=state,proto3,enum=istio_operator.v2.api.v1alpha1.RemoteClusterState" json:"state,omitempty"`
+	// Error message if the remote cluster is not reachable
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoteClusterStatus) Reset()         { *m = RemoteClusterStatus{} }
+func (m *RemoteClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*RemoteClusterStatus) ProtoMessage()    {}
+func (*RemoteClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{22}
+}
+func (m *RemoteClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteClusterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Mode != 0 {
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Mode))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RemoteClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteClusterStatus.Merge(m, src)
+}
+func (m *RemoteClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteClusterStatus proto.InternalMessageInfo
+
+func (m *RemoteClusterStatus) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SidecarInjectorConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemoteClusterStatus) GetSecretName() string {
+	if m != nil {
+		return m.SecretName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SidecarInjectorConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemoteClusterStatus) GetState() RemoteClusterState {
+	if m != nil {
+		return m.State
+	}
+	return RemoteClusterState_Reachable
 }
 
-func (m *SidecarInjectorConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RemoteClusterStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+	return ""
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+// NetworkStatus describes the east-west gateways of a mesh network
+type NetworkStatus struct {
+	// Name of the network
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Gateways of the network for cross-network traffic
+	Gateways             []NetworkGatewayStatus `protobuf:"bytes,2,rep,name=gateways,proto3" json:"gateways"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *NetworkStatus) Reset()         { *m = NetworkStatus{} }
+func (m *NetworkStatus) String() string { return proto.CompactTextString(m) }
+func (*NetworkStatus) ProtoMessage()    {}
+func (*NetworkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{23}
+}
+func (m *NetworkStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Deployment != nil {
-		{
-			size, err := m.Deployment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NetworkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkStatus.Merge(m, src)
+}
+func (m *NetworkStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkStatus proto.InternalMessageInfo
+
+func (m *NetworkStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MeshExpansionConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NetworkStatus) GetGateways() []NetworkGatewayStatus {
+	if m != nil {
+		return m.Gateways
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MeshExpansionConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NetworkGatewayStatus describes an address of an east-west gateway
+type NetworkGatewayStatus struct {
+	// IP address or DNS name of the gateway
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Port of the gateway for cross-network traffic
+	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NetworkGatewayStatus) Reset()         { *m = NetworkGatewayStatus{} }
+func (m *NetworkGatewayStatus) String() string { return proto.CompactTextString(m) }
+func (*NetworkGatewayStatus) ProtoMessage()    {}
+func (*NetworkGatewayStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{24}
+}
+func (m *NetworkGatewayStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkGatewayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkGatewayStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkGatewayStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkGatewayStatus.Merge(m, src)
+}
+func (m *NetworkGatewayStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkGatewayStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkGatewayStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkGatewayStatus proto.InternalMessageInfo
+
+func (m *NetworkGatewayStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NetworkGatewayStatus) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type StatusChecksums struct {
+	MeshConfig           string   `protobuf:"bytes,1,opt,name=meshConfig,proto3" json:"meshConfig,omitempty"`
+	SidecarInjector      string   `protobuf:"bytes,2,opt,name=sidecarInjector,proto3" json:"sidecarInjector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusChecksums) Reset()         { *m = StatusChecksums{} }
+func (m *StatusChecksums) String() string { return proto.CompactTextString(m) }
+func (*StatusChecksums) ProtoMessage()    {}
+func (*StatusChecksums) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{25}
+}
+func (m *StatusChecksums) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusChecksums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusChecksums.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusChecksums) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusChecksums.Merge(m, src)
+}
+func (m *StatusChecksums) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusChecksums) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusChecksums.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusChecksums proto.InternalMessageInfo
+
+func (m *StatusChecksums) GetMeshConfig() string {
+	if m != nil {
+		return m.MeshConfig
+	}
+	return ""
+}
+
+func (m *StatusChecksums) GetSidecarInjector() string {
+	if m != nil {
+		return m.SidecarInjector
+	}
+	return ""
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+// PlanStatus summarizes the changes the operator would apply to the objects of the control plane
+type PlanStatus struct {
+	// Identifier of the plan, the plan is applied once the approved-plan annotation is set to it
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the configmap in the namespace of the control plane which contains the diff of the plan
+	ConfigMapName string `protobuf:"bytes,2,opt,name=configMapName,proto3" json:"configMapName,omitempty"`
+	// Number of objects the plan would create
+	Creations int32 `protobuf:"varint,3,opt,name=creations,proto3" json:"creations,omitempty"`
+	// Number of objects the plan would update
+	Updates int32 `protobuf:"varint,4,opt,name=updates,proto3" json:"updates,omitempty"`
+	// Number of objects the plan would delete
+	Deletions            int32    `protobuf:"varint,5,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanStatus) Reset()         { *m = PlanStatus{} }
+func (m *PlanStatus) String() string { return proto.CompactTextString(m) }
+func (*PlanStatus) ProtoMessage()    {}
+func (*PlanStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6817de833805cb8b, []int{26}
+}
+func (m *PlanStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanStatus.Merge(m, src)
+}
+func (m *PlanStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanStatus proto.InternalMessageInfo
+
+func (m *PlanStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PlanStatus) GetConfigMapName() string {
+	if m != nil {
+		return m.ConfigMapName
+	}
+	return ""
+}
+
+func (m *PlanStatus) GetCreations() int32 {
+	if m != nil {
+		return m.Creations
+	}
+	return 0
+}
+
+func (m *PlanStatus) GetUpdates() int32 {
+	if m != nil {
+		return m.Updates
+	}
+	return 0
+}
+
+func (m *PlanStatus) GetDeletions() int32 {
+	if m != nil {
+		return m.Deletions
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ModeType", ModeType_name, ModeType_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.ProxyLogLevel", ProxyLogLevel_name, ProxyLogLevel_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.PilotCertProviderType", PilotCertProviderType_name, PilotCertProviderType_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.JWTPolicyType", JWTPolicyType_name, JWTPolicyType_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.RootCARotationPhase", RootCARotationPhase_name, RootCARotationPhase_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.RemoteClusterState", RemoteClusterState_name, RemoteClusterState_value)
+	proto.RegisterType((*IstioControlPlaneSpec)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneSpec")
+	proto.RegisterType((*SidecarInjectorConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SidecarInjectorConfiguration")
+	proto.RegisterType((*MeshExpansionConfiguration)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration")
+	proto.RegisterType((*MeshExpansionConfiguration_Istiod)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Istiod")
+	proto.RegisterType((*MeshExpansionConfiguration_Webhook)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.Webhook")
+	proto.RegisterType((*MeshExpansionConfiguration_ClusterServices)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.ClusterServices")
+	proto.RegisterType((*MeshExpansionConfiguration_IstioMeshGatewayConfiguration)(nil), "istio_operator.v2.api.v1alpha1.MeshExpansionConfiguration.IstioMeshGatewayConfiguration")
+	proto.RegisterType((*LoggingConfiguration)(nil), "istio_operator.v2.api.v1alpha1.LoggingConfiguration")
+	proto.RegisterType((*SDSConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SDSConfiguration")
+	proto.RegisterType((*ProxyConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyConfiguration")
+	proto.RegisterType((*ProxyInitConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyInitConfiguration")
+	proto.RegisterType((*CNIConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration")
+	proto.RegisterType((*CNIConfiguration_RepairConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.RepairConfiguration")
+	proto.RegisterType((*CNIConfiguration_TaintConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.TaintConfiguration")
+	proto.RegisterType((*CNIConfiguration_ResourceQuotas)(nil), "istio_operator.v2.api.v1alpha1.CNIConfiguration.ResourceQuotas")
+	proto.RegisterType((*NodeProxyConfiguration)(nil), "istio_operator.v2.api.v1alpha1.NodeProxyConfiguration")
+	proto.RegisterType((*CAConfiguration)(nil), "istio_operator.v2.api.v1alpha1.CAConfiguration")
+	proto.RegisterType((*NetworkConfiguration)(nil), "istio_operator.v2.api.v1alpha1.NetworkConfiguration")
+	proto.RegisterMapType((map[string]string)(nil), "istio_operator.v2.api.v1alpha1.NetworkConfiguration.ClusterSelectorEntry")
+	proto.RegisterMapType((map[string]string)(nil), "istio_operator.v2.api.v1alpha1.NetworkConfiguration.GatewaySelectorEntry")
+	proto.RegisterType((*IstiodConfiguration)(nil), "istio_operator.v2.api.v1alpha1.IstiodConfiguration")
+	proto.RegisterType((*ExternalIstiodConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ExternalIstiodConfiguration")
+	proto.RegisterType((*SPIFFEConfiguration)(nil), "istio_operator.v2.api.v1alpha1.SPIFFEConfiguration")
+	proto.RegisterType((*OperatorEndpointsConfiguration)(nil), "istio_operator.v2.api.v1alpha1.OperatorEndpointsConfiguration")
+	proto.RegisterType((*TelemetryV2Configuration)(nil), "istio_operator.v2.api.v1alpha1.TelemetryV2Configuration")
+	proto.RegisterType((*ProxyWasmConfiguration)(nil), "istio_operator.v2.api.v1alpha1.ProxyWasmConfiguration")
+	proto.RegisterType((*PDBConfiguration)(nil), "istio_operator.v2.api.v1alpha1.PDBConfiguration")
+	proto.RegisterType((*HTTPProxyEnvsConfiguration)(nil), "istio_operator.v2.api.v1alpha1.HTTPProxyEnvsConfiguration")
+	proto.RegisterType((*IstioControlPlaneStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneStatus")
+	proto.RegisterType((*CAStatus)(nil), "istio_operator.v2.api.v1alpha1.CAStatus")
+	proto.RegisterType((*RootCARotationStatus)(nil), "istio_operator.v2.api.v1alpha1.RootCARotationStatus")
+	proto.RegisterType((*RemoteClusterStatus)(nil), "istio_operator.v2.api.v1alpha1.RemoteClusterStatus")
+	proto.RegisterType((*NetworkStatus)(nil), "istio_operator.v2.api.v1alpha1.NetworkStatus")
+	proto.RegisterType((*NetworkGatewayStatus)(nil), "istio_operator.v2.api.v1alpha1.NetworkGatewayStatus")
+	proto.RegisterType((*StatusChecksums)(nil), "istio_operator.v2.api.v1alpha1.StatusChecksums")
+	proto.RegisterType((*PlanStatus)(nil), "istio_operator.v2.api.v1alpha1.PlanStatus")
+}
+
+func init() {
+	proto.RegisterFile("api/v1alpha1/istiocontrolplane.proto", fileDescriptor_6817de833805cb8b)
+}
+
+var fileDescriptor_6817de833805cb8b = []byte{
+	// 3458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0x1b, 0x49,
+	0x7a, 0x1f, 0x3e, 0x24, 0x8a, 0x9f, 0x2c, 0x89, 0x2e, 0xc9, 0x33, 0xbd, 0x9a, 0x1d, 0xd9, 0x60,
+	0x16, 0x89, 0xa3, 0xec, 0x50, 0x6b, 0xcd, 0xec, 0x46, 0x98, 0x1d, 0xcc, 0x84, 0xa4, 0x24, 0x0f,
+	0xfd, 0x90, 0x98, 0x26, 0x6d, 0xaf, 0x27, 0x03, 0x38, 0xa5, 0xee, 0x12, 0x55, 0xe3, 0x66, 0x55,
+	0xa7, 0xba, 0x28, 0x5b, 0x1b, 0xe4, 0x10, 0x24, 0xa7, 0x20, 0x40, 0x4e, 0x01, 0x72, 0x5c, 0xe4,
+	0x16, 0x04, 0xc8, 0x29, 0x40, 0x8e, 0xb9, 0x05, 0x7b, 0xcc, 0x1f, 0x10, 0xe4, 0x31, 0x7f, 0x41,
+	0x4e, 0x39, 0x07, 0xf5, 0x68, 0xb2, 0xbb, 0x49, 0x99, 0xed, 0xa1, 0xf7, 0xd6, 0xfd, 0x55, 0x7d,
+	0xbf, 0xaa, 0xfa, 0xaa, 0xbe, 0x67, 0x15, 0xfc, 0x08, 0x87, 0x74, 0xef, 0xf2, 0x1e, 0x0e, 0xc2,
+	0x0b, 0x7c, 0x6f, 0x8f, 0x46, 0x92, 0x72, 0x8f, 0x33, 0x29, 0x78, 0x10, 0x06, 0x98, 0x91, 0x46,
+	0x28, 0xb8, 0xe4, 0x68, 0x47, 0x37, 0xbc, 0xe0, 0x21, 0x11, 0x58, 0x72, 0xd1, 0xb8, 0xdc, 0x6f,
+	0xe0, 0x90, 0x36, 0x62, 0xbe, 0xed, 0x1f, 0xa4, 0x50, 0x3c, 0x3e, 0x1c, 0x72, 0x66, 0x58, 0xb7,
+	0x7f, 0x6b, 0x7a, 0x80, 0x21, 0x89, 0x2e, 0x06, 0x58, 0x92, 0x57, 0xf8, 0xca, 0x76, 0xaa, 0xbf,
+	0x3c, 0x88, 0x1a, 0x94, 0xef, 0xa9, 0xbe, 0x1e, 0x17, 0x64, 0xef, 0xf2, 0xde, 0xde, 0x80, 0x30,
+	0x35, 0x1a, 0xf1, 0x6d, 0x9f, 0x6d, 0xc5, 0x96, 0x1c, 0x84, 0x9d, 0xd3, 0x81, 0x6d, 0xdb, 0x1a,
+	0xf0, 0x01, 0xd7, 0x9f, 0x7b, 0xea, 0xcb, 0x52, 0x6f, 0x0f, 0x38, 0x1f, 0x04, 0x44, 0xa3, 0x9e,
+	0x53, 0x12, 0xf8, 0x2f, 0xce, 0xc8, 0x05, 0xbe, 0xa4, 0x5c, 0xd8, 0x0e, 0x3b, 0xb6, 0x83, 0xfe,
+	0x3b, 0x1b, 0x9d, 0xef, 0xbd, 0x12, 0x38, 0x0c, 0x89, 0x88, 0x32, 0x00, 0xe3, 0x76, 0x49, 0x87,
+	0x24, 0x92, 0x78, 0x18, 0x9a, 0x0e, 0xf5, 0xbf, 0xd9, 0x80, 0x5b, 0x1d, 0xb5, 0xa4, 0xb6, 0x91,
+	0x59, 0x57, 0xc9, 0xac, 0x17, 0x12, 0x0f, 0xed, 0x40, 0xe5, 0x92, 0x88, 0x88, 0x72, 0xe6, 0x14,
+	0xee, 0x14, 0xee, 0x56, 0x5b, 0xe5, 0xef, 0x9a, 0x85, 0xa2, 0x1b, 0x13, 0x51, 0x0b, 0xca, 0x43,
+	0xee, 0x13, 0xa7, 0x78, 0xa7, 0x70, 0x77, 0x7d, 0xff, 0x6e, 0xe3, 0xcd, 0x02, 0x6e, 0x3c, 0xe6,
+	0x3e, 0xe9, 0x5f, 0x85, 0xc4, 0xc2, 0x68, 0x5e, 0x74, 0x02, 0x95, 0x80, 0x0f, 0x06, 0x94, 0x0d,
+	0x9c, 0xd2, 0x9d, 0xc2, 0xdd, 0xd5, 0xfd, 0x4f, 0xe7, 0xc1, 0x3c, 0x32, 0xdd, 0xdb, 0x5a, 0x76,
+	0x23, 0x81, 0x25, 0xe5, 0xcc, 0x8d, 0x41, 0xd0, 0x57, 0xb0, 0x3e, 0xe4, 0x23, 0x26, 0x1f, 0xcb,
+	0x20, 0x6a, 0x13, 0x21, 0x23, 0xa7, 0xac, 0x61, 0xb7, 0x1b, 0x46, 0x0e, 0x8d, 0x58, 0x0e, 0x8d,
+	0x16, 0xe7, 0xc1, 0x53, 0x1c, 0x8c, 0x48, 0xab, 0xfc, 0xab, 0xff, 0xba, 0x5d, 0x70, 0x33, 0x7c,
+	0xe8, 0x21, 0x2c, 0xeb, 0x99, 0xf8, 0xce, 0x92, 0x46, 0xf8, 0x64, 0xde, 0xc4, 0xb4, 0x10, 0xfd,
+	0xf4, 0xbc, 0x2c, 0x04, 0xfa, 0x0a, 0x96, 0x42, 0xc1, 0x5f, 0x5f, 0x39, 0xcb, 0x1a, 0x6b, 0x7f,
+	0x1e, 0x56, 0x57, 0x75, 0x4e, 0x43, 0x19, 0x00, 0xd4, 0x87, 0xaa, 0xfe, 0xe8, 0x30, 0x2a, 0x9d,
+	0x8a, 0x46, 0xfb, 0x59, 0x2e, 0x34, 0xc5, 0x90, 0x46, 0x9c, 0x00, 0xa1, 0xaf, 0x61, 0x55, 0x92,
+	0x80, 0x0c, 0x89, 0x14, 0x57, 0x4f, 0xf7, 0x9d, 0x15, 0x8d, 0x7b, 0x30, 0x0f, 0xb7, 0x3f, 0x61,
+	0x49, 0x23, 0x27, 0xc1, 0x50, 0x0b, 0x4a, 0x91, 0x1f, 0x39, 0x55, 0x8d, 0xf9, 0x93, 0x79, 0x98,
+	0xbd, 0xc3, 0x5e, 0x1a, 0x4b, 0x31, 0x8f, 0x57, 0xfd, 0x0c, 0x47, 0x43, 0x07, 0xde, 0x62, 0xd5,
+	0x8a, 0x61, 0xd6, 0xaa, 0x15, 0x1d, 0x9d, 0xc0, 0xcd, 0x57, 0x58, 0x7a, 0x17, 0xa7, 0x8c, 0x9c,
+	0xe0, 0x21, 0x89, 0x42, 0xec, 0x11, 0x67, 0x35, 0xe7, 0x79, 0x99, 0x66, 0x45, 0x0f, 0xa1, 0xfa,
+	0xed, 0x2b, 0xd9, 0xe5, 0x01, 0xf5, 0xae, 0x9c, 0x1b, 0x5a, 0x2b, 0x3e, 0x9e, 0x37, 0xcb, 0x07,
+	0xcf, 0xfa, 0x86, 0x41, 0xa9, 0x86, 0x3b, 0xe1, 0x47, 0x3f, 0x84, 0xaa, 0x87, 0x9b, 0xbe, 0x2f,
+	0x48, 0x14, 0x39, 0x6b, 0x4a, 0xff, 0xdc, 0x09, 0x01, 0xed, 0x00, 0x78, 0xb8, 0x2b, 0xf8, 0x25,
+	0xf5, 0x89, 0x70, 0xd6, 0x75, 0x73, 0x82, 0x82, 0xea, 0x70, 0xc3, 0xa7, 0x91, 0x14, 0xf4, 0x6c,
+	0xa4, 0x56, 0xed, 0x6c, 0xe8, 0x1e, 0x29, 0x1a, 0xfa, 0x63, 0x58, 0xbb, 0x90, 0x32, 0xd4, 0x72,
+	0x3a, 0x62, 0x97, 0x91, 0x53, 0xd3, 0x4b, 0xff, 0x6c, 0xde, 0x94, 0xbf, 0xea, 0xf7, 0xbb, 0x63,
+	0xa6, 0xb4, 0x70, 0xd3, 0x80, 0xe8, 0x4b, 0x00, 0x65, 0xf1, 0x4c, 0x1f, 0xe7, 0xa6, 0x86, 0xbf,
+	0x6d, 0xe0, 0x1b, 0xaa, 0x21, 0x61, 0x1c, 0xc6, 0xdd, 0xdc, 0x04, 0x0b, 0xa2, 0xb0, 0xf9, 0xf2,
+	0x20, 0x72, 0x49, 0xc4, 0x47, 0xc2, 0x23, 0xa7, 0x97, 0x44, 0x04, 0xf8, 0x2a, 0x72, 0xd0, 0x9d,
+	0xd2, 0xdd, 0xd5, 0xfd, 0xdf, 0x9f, 0x37, 0xd1, 0x87, 0x53, 0xac, 0x5d, 0xb5, 0x67, 0xee, 0x2c,
+	0x4c, 0xf4, 0x3e, 0x2c, 0xab, 0x81, 0x3b, 0x87, 0xce, 0xa6, 0x96, 0x95, 0xfd, 0x43, 0x7f, 0x06,
+	0x1f, 0x2a, 0x6f, 0x82, 0x29, 0x23, 0xa2, 0x33, 0xc4, 0x03, 0x92, 0x5a, 0xb1, 0xb3, 0xa5, 0x17,
+	0xf5, 0xf3, 0x79, 0x53, 0x69, 0x5f, 0x0f, 0xe1, 0xbe, 0x09, 0x5f, 0x6d, 0x92, 0x9a, 0xc8, 0xd1,
+	0xeb, 0x10, 0x33, 0x6d, 0x8a, 0x6f, 0xe5, 0xdb, 0xa4, 0xc7, 0x49, 0xa6, 0xcc, 0x26, 0xa5, 0x00,
+	0xf5, 0x41, 0x0b, 0x46, 0x91, 0x24, 0xa2, 0x73, 0xe8, 0xbc, 0x6f, 0x0f, 0x5a, 0x4c, 0x40, 0x77,
+	0x60, 0x95, 0x11, 0xf9, 0x8a, 0x8b, 0x97, 0xea, 0x9c, 0x3b, 0x1f, 0xe8, 0xf6, 0x24, 0x09, 0x9d,
+	0xc3, 0x46, 0x44, 0x7d, 0xe2, 0x61, 0xd1, 0x61, 0xdf, 0x12, 0x4f, 0x72, 0xe1, 0x38, 0x7a, 0x8e,
+	0x9f, 0xcf, 0xd5, 0xf5, 0x34, 0x5b, 0x7a, 0x96, 0x59, 0x50, 0x65, 0x03, 0x18, 0xf7, 0x89, 0x3e,
+	0x5d, 0xce, 0x0f, 0xf2, 0xd9, 0x80, 0x93, 0x98, 0x21, 0x63, 0x03, 0xc6, 0x40, 0xe8, 0x4b, 0x28,
+	0x7a, 0xd8, 0xd9, 0xd6, 0x70, 0x7b, 0x73, 0x77, 0xb1, 0x99, 0xc6, 0x29, 0x7a, 0x18, 0x75, 0x61,
+	0xc5, 0x4a, 0x23, 0x72, 0x3e, 0xbc, 0x53, 0xca, 0xe3, 0xc2, 0x4e, 0x4c, 0xff, 0x34, 0xd6, 0x18,
+	0xa5, 0xfe, 0xaf, 0x05, 0xf8, 0xe1, 0x9b, 0x44, 0x83, 0xbe, 0x01, 0xf0, 0x49, 0x18, 0xf0, 0xab,
+	0x21, 0x61, 0xd2, 0x29, 0xe4, 0x13, 0x76, 0x0b, 0x47, 0xe4, 0xe1, 0xe8, 0x8c, 0x08, 0x46, 0x24,
+	0x19, 0x1f, 0xff, 0x58, 0xe7, 0x26, 0x78, 0xa8, 0x09, 0x95, 0x88, 0x88, 0x4b, 0xea, 0x19, 0xcf,
+	0xbe, 0xba, 0xff, 0x3b, 0x73, 0xf7, 0xd1, 0x74, 0x77, 0x63, 0xbe, 0xfa, 0xff, 0x55, 0x61, 0xfb,
+	0xfa, 0x03, 0x88, 0x3e, 0x83, 0x0a, 0x61, 0xf8, 0x2c, 0x20, 0xbe, 0x53, 0xc8, 0x69, 0x6d, 0x63,
+	0x06, 0x24, 0xa0, 0x62, 0xe3, 0x2e, 0x3b, 0xbb, 0x5f, 0x7c, 0x7f, 0x4d, 0x30, 0x2e, 0x5b, 0xb5,
+	0xdf, 0x37, 0x90, 0x99, 0xa0, 0xc2, 0x0e, 0x84, 0x9e, 0x8f, 0x43, 0x01, 0x13, 0xa3, 0x34, 0x17,
+	0x1d, 0xd2, 0x1f, 0x07, 0x06, 0xdf, 0x40, 0xe5, 0x15, 0x39, 0xbb, 0xe0, 0xfc, 0xa5, 0x0d, 0x54,
+	0x5a, 0x0b, 0x60, 0x3f, 0x33, 0x48, 0x6e, 0x0c, 0x89, 0x24, 0x6c, 0x58, 0x4d, 0xb6, 0x5b, 0x14,
+	0xd9, 0x60, 0xe6, 0xc1, 0x02, 0xa3, 0xb4, 0xd3, 0x88, 0x6e, 0x76, 0x88, 0xed, 0x16, 0x2c, 0x9b,
+	0x55, 0xa2, 0x03, 0x58, 0x26, 0xaf, 0x43, 0x1e, 0x91, 0xdc, 0xfb, 0x6c, 0xfb, 0x6f, 0xb7, 0xa1,
+	0x62, 0x57, 0xb3, 0x00, 0xc8, 0x43, 0xd8, 0xc8, 0x4c, 0x76, 0x01, 0xb0, 0xbf, 0x2f, 0xc3, 0x47,
+	0x6f, 0x3c, 0x2f, 0xa8, 0x03, 0x2b, 0x43, 0x22, 0xb1, 0x8f, 0x25, 0xb6, 0xe8, 0x1f, 0xe7, 0xf0,
+	0x50, 0xa7, 0x67, 0x4a, 0xc5, 0x1f, 0x13, 0x89, 0xdd, 0x31, 0x7b, 0x46, 0xc3, 0x8b, 0xef, 0x58,
+	0xc3, 0x1f, 0x4d, 0x34, 0xbc, 0x94, 0x2f, 0x1e, 0x7d, 0xc2, 0x94, 0x7c, 0x88, 0x27, 0x89, 0x9f,
+	0x55, 0x76, 0xf4, 0x05, 0x54, 0xc5, 0x88, 0x35, 0x23, 0x97, 0x73, 0x99, 0x3b, 0xda, 0x9e, 0xb0,
+	0x5c, 0xe7, 0xe3, 0x97, 0x7e, 0x03, 0x3e, 0xfe, 0x05, 0xdc, 0xc4, 0x26, 0x80, 0x52, 0x4d, 0x81,
+	0x09, 0x8d, 0x96, 0x75, 0xa0, 0x76, 0x6f, 0xde, 0x40, 0xcd, 0x2c, 0xa3, 0x3b, 0x8d, 0x55, 0xff,
+	0x31, 0x6c, 0xcd, 0xca, 0x4f, 0xd0, 0x16, 0x2c, 0x05, 0xe4, 0x92, 0x04, 0x26, 0x91, 0x72, 0xcd,
+	0x4f, 0xfd, 0x00, 0x6a, 0xd9, 0x70, 0x17, 0xfd, 0x08, 0xd6, 0x24, 0x7f, 0x49, 0x58, 0x73, 0xe4,
+	0x53, 0xc2, 0x3c, 0x62, 0x39, 0xd2, 0xc4, 0xfa, 0x5f, 0x2f, 0x03, 0x9a, 0xf6, 0x6b, 0x6a, 0x18,
+	0xaa, 0x42, 0x88, 0x78, 0x18, 0xfd, 0x83, 0xfe, 0x00, 0x20, 0x14, 0xf4, 0x92, 0x06, 0x64, 0x40,
+	0x7c, 0xa7, 0x98, 0x73, 0x87, 0x12, 0x3c, 0x2a, 0xab, 0x32, 0xf6, 0xb7, 0xcd, 0x05, 0x39, 0x1c,
+	0x0d, 0x43, 0xa7, 0x94, 0x13, 0x25, 0xc3, 0xa7, 0x74, 0x24, 0xe0, 0x83, 0x47, 0x5a, 0x16, 0xe5,
+	0x7c, 0x11, 0xb2, 0x5e, 0xe7, 0x23, 0xcb, 0xe4, 0x8e, 0xd9, 0xd1, 0x8f, 0xe1, 0xa6, 0xc7, 0x87,
+	0x21, 0x67, 0x84, 0xc9, 0xb8, 0x59, 0x9b, 0xb7, 0xaa, 0x3b, 0xdd, 0xa0, 0xe4, 0x6a, 0xed, 0xd4,
+	0x21, 0x1f, 0x62, 0x6a, 0xb6, 0xbd, 0xea, 0xa6, 0x89, 0xe8, 0x5b, 0xb8, 0x7d, 0xc1, 0x03, 0xbf,
+	0x19, 0x86, 0x01, 0xf5, 0xb4, 0x4c, 0x9f, 0x30, 0x49, 0x03, 0x3d, 0x85, 0x9e, 0xc4, 0x2a, 0x9f,
+	0xac, 0xe4, 0x5c, 0xf9, 0x3c, 0x20, 0xf4, 0x73, 0xa8, 0x06, 0xf4, 0x9c, 0x78, 0x57, 0x5e, 0x40,
+	0x6c, 0xc6, 0xf5, 0x51, 0xc3, 0x14, 0x11, 0xb4, 0x00, 0x3c, 0x2e, 0x48, 0xe3, 0xf2, 0x5e, 0xe3,
+	0x51, 0xdc, 0xc9, 0x9d, 0xf4, 0x47, 0x2e, 0x54, 0x85, 0x3d, 0xdd, 0x71, 0x6a, 0x35, 0x37, 0xec,
+	0x88, 0xd5, 0xc1, 0x25, 0x7f, 0x32, 0xa2, 0x82, 0x28, 0x53, 0x10, 0xb9, 0x13, 0x18, 0x74, 0x17,
+	0x36, 0x28, 0xf3, 0x82, 0x91, 0x4f, 0x3a, 0x5d, 0x17, 0xb3, 0x01, 0x89, 0x74, 0xaa, 0x55, 0x75,
+	0xb3, 0x64, 0xd5, 0x93, 0xbc, 0x4e, 0xf7, 0x5c, 0x35, 0x3d, 0x33, 0x64, 0xf4, 0x13, 0xd8, 0x8c,
+	0x49, 0xec, 0x8c, 0x8f, 0x98, 0xdf, 0xe5, 0x4a, 0x88, 0x37, 0x74, 0xef, 0x59, 0x4d, 0x68, 0x1f,
+	0xb6, 0x2c, 0xf9, 0x74, 0x24, 0x13, 0x2c, 0x26, 0x05, 0x9a, 0xd9, 0x56, 0xff, 0xb7, 0x02, 0xbc,
+	0x3f, 0x3b, 0xc9, 0xbd, 0x46, 0x25, 0x52, 0xe2, 0x2b, 0xbe, 0x1b, 0xf1, 0xb5, 0xa0, 0xe4, 0x31,
+	0xea, 0x94, 0xf2, 0xe5, 0xb9, 0xed, 0x93, 0x4e, 0x26, 0xcf, 0xf5, 0x18, 0xad, 0xff, 0xd3, 0x2a,
+	0xd4, 0xb2, 0x2d, 0x0b, 0x85, 0x4b, 0x9f, 0x41, 0xc5, 0xbb, 0xc0, 0x94, 0xbd, 0x85, 0xe2, 0xc7,
+	0x0c, 0x2a, 0x23, 0x3a, 0xa3, 0xec, 0x90, 0x0a, 0xad, 0xa9, 0x55, 0xd7, 0xfe, 0x21, 0x07, 0x2a,
+	0xaa, 0x72, 0xa5, 0x1a, 0x8c, 0xba, 0xc5, 0xbf, 0x4a, 0x25, 0xed, 0xfe, 0x8c, 0x93, 0xe2, 0xc8,
+	0x59, 0xbe, 0x53, 0x52, 0x2a, 0x39, 0xd5, 0xa0, 0x7a, 0x53, 0x96, 0x21, 0x3a, 0x15, 0xd3, 0x7b,
+	0xaa, 0x01, 0x6d, 0x27, 0x2c, 0xc7, 0x8a, 0x1e, 0x76, 0xfc, 0xaf, 0xb2, 0x5d, 0x35, 0x85, 0x63,
+	0x1a, 0x68, 0x0e, 0xad, 0x10, 0x55, 0x37, 0x45, 0x43, 0x0d, 0x40, 0x61, 0x14, 0xda, 0x78, 0xc0,
+	0xe5, 0xb6, 0xa7, 0x39, 0xe0, 0x33, 0x5a, 0xd0, 0x37, 0xb0, 0x2c, 0x48, 0x88, 0xa9, 0xb0, 0x15,
+	0x81, 0xc3, 0xb7, 0xdd, 0xd1, 0x86, 0xab, 0xd9, 0x33, 0x05, 0x21, 0x83, 0x89, 0x9e, 0xc3, 0x92,
+	0xc4, 0x94, 0x49, 0xad, 0x09, 0xab, 0xfb, 0xed, 0xb7, 0x06, 0xef, 0x2b, 0xee, 0x4c, 0x85, 0x48,
+	0x23, 0xa2, 0x01, 0xac, 0xc7, 0x87, 0xf2, 0x0f, 0x47, 0x5c, 0x62, 0xa3, 0x3a, 0xab, 0xfb, 0x5f,
+	0x7e, 0x8f, 0x05, 0x24, 0x61, 0xdc, 0x0c, 0x2c, 0xfa, 0x1a, 0xaa, 0x3e, 0x26, 0x43, 0xce, 0x22,
+	0x22, 0x9d, 0xf5, 0x77, 0x10, 0xa3, 0x4c, 0xe0, 0xb6, 0xff, 0xa7, 0x08, 0x9b, 0x33, 0xe4, 0xb7,
+	0x90, 0x2e, 0x7c, 0x01, 0xd5, 0x00, 0x9f, 0x91, 0xa0, 0xcb, 0xfd, 0x28, 0xb7, 0x36, 0x4c, 0x58,
+	0x94, 0x1f, 0xf5, 0x49, 0x40, 0x24, 0xd1, 0x00, 0x79, 0x3d, 0x60, 0x82, 0xc7, 0x9c, 0x78, 0x6d,
+	0xa1, 0x4c, 0xbe, 0xaf, 0x8f, 0xa0, 0x51, 0xae, 0xe9, 0x06, 0xd5, 0xfb, 0x4c, 0x28, 0xb7, 0xdf,
+	0xe5, 0xfe, 0x23, 0x35, 0x8b, 0x87, 0xe4, 0x2a, 0x76, 0x70, 0x53, 0x0d, 0xca, 0xd2, 0xa6, 0x89,
+	0x7a, 0x12, 0xd6, 0xcd, 0xcd, 0x6a, 0xda, 0xfe, 0xe7, 0x02, 0xa0, 0xe9, 0x63, 0xb4, 0x90, 0x88,
+	0xcf, 0xa0, 0x3a, 0x2e, 0x66, 0x38, 0xc5, 0x7c, 0x7a, 0x93, 0x3e, 0x12, 0x63, 0x11, 0x64, 0x32,
+	0xf6, 0x31, 0xec, 0xf6, 0x5f, 0x15, 0x60, 0x3d, 0x7d, 0x32, 0x17, 0x9a, 0x32, 0x82, 0x72, 0x18,
+	0x1f, 0x88, 0xaa, 0xab, 0xbf, 0x95, 0x7f, 0x0b, 0x05, 0xe5, 0x82, 0xca, 0xab, 0x76, 0x80, 0xa3,
+	0x88, 0xa8, 0xed, 0x56, 0x76, 0x29, 0x4b, 0xae, 0xff, 0x43, 0x11, 0xde, 0x9f, 0x5d, 0x64, 0x58,
+	0x68, 0x52, 0xc9, 0x30, 0xa9, 0xb8, 0x70, 0x98, 0x34, 0x6d, 0x93, 0x4b, 0xd7, 0xd9, 0xe4, 0x94,
+	0x4e, 0x97, 0xdf, 0xa9, 0x4e, 0xd7, 0xff, 0xb2, 0x04, 0x1b, 0x99, 0x0a, 0x0a, 0xfa, 0x05, 0xdc,
+	0x10, 0x9c, 0xcb, 0x76, 0xb3, 0x47, 0x3c, 0x41, 0xe2, 0x62, 0x46, 0x63, 0x6e, 0x05, 0x25, 0x9e,
+	0xb1, 0xaf, 0xbe, 0xec, 0x8d, 0x42, 0x0a, 0x49, 0xc5, 0x11, 0x94, 0x49, 0x22, 0x86, 0xc4, 0xa7,
+	0x58, 0x92, 0x43, 0x3b, 0xa2, 0xdd, 0xe7, 0x99, 0x6d, 0xe8, 0x00, 0x3e, 0x48, 0xd2, 0x5d, 0xc2,
+	0xc8, 0xab, 0x16, 0x39, 0xe7, 0xc2, 0x24, 0x4a, 0x55, 0xf7, 0xba, 0x66, 0xf4, 0x35, 0xd4, 0x18,
+	0x79, 0x2d, 0xdd, 0xe4, 0x5a, 0xca, 0xdf, 0x67, 0x2d, 0xee, 0x14, 0x0e, 0x7a, 0x0c, 0x35, 0x41,
+	0x22, 0x89, 0x85, 0x6c, 0xa9, 0xd4, 0xa6, 0x47, 0x7f, 0x49, 0x6c, 0x1a, 0xff, 0xe1, 0xd4, 0x89,
+	0xea, 0x30, 0xf9, 0xc9, 0x7e, 0xf2, 0x48, 0x4d, 0xb1, 0xd6, 0xff, 0xb7, 0x04, 0x5b, 0xb3, 0x2a,
+	0x50, 0xc8, 0x81, 0x32, 0x53, 0x06, 0x29, 0x79, 0xd9, 0xa3, 0x29, 0x28, 0x82, 0x0d, 0x5b, 0x0b,
+	0xe9, 0x91, 0xc0, 0x94, 0xf8, 0x8a, 0x3a, 0x3d, 0xeb, 0x7c, 0x9f, 0x52, 0x57, 0xe3, 0x7e, 0x1a,
+	0xeb, 0x88, 0x49, 0x71, 0xe5, 0x66, 0x47, 0x50, 0x95, 0x47, 0x4b, 0x52, 0x41, 0x9e, 0xde, 0x80,
+	0x35, 0x37, 0x49, 0x42, 0xbb, 0x50, 0xb3, 0xbf, 0x36, 0x39, 0x23, 0xea, 0xba, 0x47, 0x9d, 0xec,
+	0x29, 0xba, 0x5a, 0xc2, 0xb8, 0x4e, 0x61, 0x97, 0xb0, 0xb4, 0xc0, 0x12, 0xda, 0x69, 0x2c, 0xbb,
+	0x84, 0xcc, 0x08, 0xdb, 0x2d, 0xd8, 0x9a, 0xb5, 0x56, 0x54, 0x83, 0xd2, 0x4b, 0x72, 0x65, 0x43,
+	0x52, 0xf5, 0xa9, 0xc2, 0xd4, 0x4b, 0x6d, 0xaf, 0xcd, 0xf1, 0x34, 0x3f, 0x9f, 0x15, 0x0f, 0x0a,
+	0x0a, 0x63, 0xd6, 0x60, 0x6f, 0x83, 0x51, 0xff, 0xc7, 0x65, 0xd8, 0x9c, 0x71, 0x3d, 0xf5, 0x1b,
+	0x2e, 0x24, 0x8e, 0xb3, 0xc6, 0x26, 0xc3, 0xc1, 0x55, 0x44, 0xf3, 0x3b, 0xdd, 0x0c, 0x1f, 0x3a,
+	0x84, 0x1b, 0x86, 0xd2, 0x93, 0x58, 0x8e, 0xf2, 0xfb, 0xde, 0x14, 0x17, 0xf2, 0x60, 0x9d, 0xbc,
+	0x96, 0x44, 0x30, 0x1c, 0x18, 0x61, 0x38, 0xe5, 0x7c, 0xc5, 0xfb, 0xa3, 0x14, 0x57, 0xda, 0x31,
+	0x65, 0x20, 0xd1, 0x7d, 0x58, 0x93, 0x02, 0x7b, 0xa4, 0x87, 0x87, 0x61, 0xa0, 0xae, 0x35, 0xaf,
+	0xd3, 0xd4, 0xe3, 0x80, 0x63, 0x99, 0x9c, 0x6c, 0x9a, 0x0f, 0x5d, 0xc0, 0x8e, 0x99, 0x7d, 0x57,
+	0x71, 0x78, 0x3c, 0xe8, 0x31, 0x7a, 0x7e, 0x4e, 0xd9, 0x20, 0x4e, 0x7d, 0x9c, 0xe5, 0x9c, 0x52,
+	0x98, 0x83, 0x83, 0xce, 0xe1, 0xa3, 0xd9, 0x3d, 0x6c, 0x5e, 0x96, 0x3b, 0xe5, 0x7d, 0x33, 0x0c,
+	0x7a, 0x0e, 0x37, 0x3c, 0x22, 0xe4, 0xf8, 0xd6, 0x6a, 0x45, 0x3b, 0xb6, 0x9f, 0xce, 0x75, 0x6c,
+	0x34, 0xe0, 0xb2, 0x9d, 0x60, 0xd4, 0x37, 0x65, 0x29, 0x28, 0x75, 0x59, 0x1b, 0x85, 0xf4, 0xfc,
+	0x9c, 0x38, 0xd5, 0x7c, 0x97, 0xb5, 0xbd, 0x6e, 0xe7, 0xf8, 0xf8, 0x28, 0x13, 0x9b, 0x1b, 0x88,
+	0xfa, 0x73, 0xf8, 0xf0, 0x0d, 0x3b, 0xbe, 0x88, 0x5f, 0xaf, 0xff, 0x45, 0x01, 0x36, 0x67, 0x0c,
+	0x8d, 0x02, 0xb8, 0x19, 0x4f, 0xf5, 0x88, 0xf9, 0x21, 0xa7, 0x4c, 0x46, 0x16, 0xfd, 0x8b, 0x79,
+	0x4b, 0x39, 0xcd, 0x32, 0xa6, 0x57, 0x35, 0x0d, 0x5c, 0xff, 0x06, 0x76, 0xde, 0xcc, 0xb4, 0xd0,
+	0x1a, 0x9f, 0x82, 0x73, 0xdd, 0xc5, 0xf0, 0x42, 0xb8, 0x7d, 0x9b, 0xe3, 0x4f, 0x5d, 0xe9, 0x2e,
+	0x84, 0x7a, 0x02, 0xb5, 0xee, 0x61, 0xeb, 0xdd, 0xe1, 0x49, 0xd8, 0xbe, 0xfe, 0x7e, 0x54, 0xdd,
+	0xb5, 0x8d, 0x6f, 0x48, 0xad, 0xe9, 0x9e, 0x10, 0xd4, 0xa5, 0xae, 0xfa, 0x89, 0x4c, 0xb3, 0xb1,
+	0xe2, 0x09, 0x8a, 0x4a, 0xbc, 0x19, 0x37, 0x8d, 0x26, 0x1c, 0x89, 0x7f, 0xeb, 0xbf, 0xaa, 0xc2,
+	0x07, 0xd3, 0x8f, 0x38, 0x8c, 0xd9, 0x6b, 0xc3, 0x72, 0xa4, 0xbf, 0xf4, 0x80, 0xeb, 0xfb, 0xbf,
+	0x97, 0xe3, 0xae, 0xf2, 0x9c, 0x0e, 0x14, 0x37, 0x71, 0x2d, 0x6b, 0xfa, 0x92, 0xb0, 0x98, 0xbd,
+	0x24, 0xfc, 0x14, 0x6e, 0xd1, 0xec, 0xe8, 0x3a, 0xb7, 0x31, 0xd3, 0x9c, 0xdd, 0x88, 0x7e, 0x1b,
+	0xd6, 0xd3, 0x6e, 0xda, 0x3a, 0xef, 0x0c, 0x55, 0xd7, 0xa5, 0xb4, 0x1e, 0x4e, 0xbc, 0xfc, 0x92,
+	0x89, 0xc6, 0x33, 0x64, 0x95, 0x03, 0x51, 0x7d, 0x63, 0x46, 0x39, 0x9b, 0xaa, 0x40, 0xcc, 0x6a,
+	0xd2, 0x45, 0x44, 0xac, 0xa3, 0x2d, 0x22, 0x24, 0x3d, 0xa7, 0x1e, 0x96, 0xc4, 0xa9, 0xd8, 0x22,
+	0x62, 0xb6, 0x41, 0xd5, 0x19, 0x88, 0x10, 0x5c, 0x3c, 0x26, 0x51, 0xa4, 0x6a, 0x4a, 0xa6, 0x0e,
+	0x91, 0xa2, 0x65, 0xee, 0xbc, 0xab, 0x6f, 0x7f, 0xe7, 0xfd, 0x18, 0xaa, 0xde, 0x05, 0xf1, 0x5e,
+	0x46, 0xa3, 0x61, 0xe4, 0x40, 0xbe, 0x8b, 0x49, 0xb3, 0xd5, 0xed, 0x98, 0xcd, 0x9d, 0x20, 0xa8,
+	0xba, 0x87, 0x77, 0xa1, 0x02, 0xc0, 0x11, 0xf3, 0x03, 0xf2, 0xd4, 0x3e, 0xe8, 0x31, 0xe5, 0xba,
+	0x19, 0x2d, 0xe8, 0x14, 0xc0, 0xe3, 0xcc, 0xa7, 0x4a, 0x50, 0xaa, 0x50, 0xa7, 0x62, 0xa4, 0xdf,
+	0xcd, 0x71, 0x64, 0x0c, 0x47, 0xab, 0xfc, 0xeb, 0xff, 0xbc, 0xfd, 0x9e, 0x9b, 0x80, 0x50, 0x13,
+	0xe0, 0x67, 0xea, 0xb2, 0x80, 0xf8, 0xf7, 0xcd, 0x7b, 0x28, 0x35, 0x01, 0x55, 0x93, 0x28, 0xb9,
+	0x33, 0x5a, 0xd0, 0x13, 0x80, 0x71, 0xf9, 0x36, 0x72, 0xd6, 0xef, 0x94, 0xf2, 0x08, 0xa0, 0x1d,
+	0x73, 0x18, 0x49, 0x4c, 0xa6, 0x11, 0x03, 0xa1, 0x2f, 0xa0, 0x1c, 0x06, 0xd8, 0xbc, 0x84, 0x58,
+	0xdd, 0xdf, 0x9d, 0xeb, 0x75, 0x02, 0xcc, 0x0c, 0x96, 0xab, 0xf9, 0xd0, 0xe7, 0xf6, 0xb5, 0x53,
+	0xed, 0xed, 0x5e, 0x3b, 0xd9, 0x77, 0x4e, 0x07, 0xfa, 0x9a, 0xd9, 0xbc, 0x80, 0xb8, 0x3b, 0xff,
+	0x9a, 0xd9, 0x8e, 0xac, 0xee, 0x97, 0x47, 0xe0, 0x78, 0xd6, 0x86, 0x10, 0xdf, 0x25, 0x43, 0x2e,
+	0x89, 0x8d, 0x07, 0xe3, 0x77, 0x10, 0x9f, 0xcc, 0xaf, 0x5c, 0x26, 0xb8, 0x52, 0x02, 0xba, 0x16,
+	0x1a, 0x9d, 0x26, 0xae, 0xb5, 0x37, 0xf5, 0x30, 0x1f, 0xe7, 0x0c, 0x94, 0x53, 0x03, 0x4c, 0x6e,
+	0xb5, 0xff, 0xa3, 0x08, 0x2b, 0xf1, 0xc2, 0x94, 0x22, 0x4d, 0xa5, 0x7d, 0xd5, 0x4c, 0x02, 0xb7,
+	0x0b, 0x35, 0x6f, 0xa2, 0x7b, 0x6d, 0x55, 0x94, 0xb4, 0x96, 0x67, 0x8a, 0x8e, 0x0e, 0xd4, 0xdb,
+	0x00, 0x99, 0x48, 0xd5, 0x66, 0xd9, 0xec, 0x7e, 0xfc, 0xf2, 0xcd, 0x9d, 0x74, 0x46, 0x3f, 0x83,
+	0x15, 0xc6, 0x65, 0xf3, 0x5c, 0x12, 0xe1, 0x94, 0xe7, 0x32, 0x8e, 0xfb, 0xa2, 0xcf, 0x61, 0x55,
+	0xa8, 0xfc, 0x0f, 0x07, 0xaa, 0xd5, 0x59, 0x9a, 0xcb, 0x9a, 0xec, 0xae, 0x1e, 0x0d, 0x08, 0x2e,
+	0xf1, 0xf8, 0xfe, 0x29, 0x4f, 0xf9, 0x59, 0xcb, 0xc6, 0xb5, 0x5c, 0xf6, 0x80, 0x8c, 0x51, 0xea,
+	0x7f, 0x5e, 0x82, 0xad, 0x59, 0x5d, 0x50, 0x07, 0x96, 0xc2, 0x0b, 0x6c, 0x2f, 0x3c, 0xd7, 0x73,
+	0x1c, 0x96, 0x14, 0x48, 0x57, 0xb1, 0xba, 0x06, 0x41, 0xed, 0xc8, 0x54, 0x92, 0x6b, 0x77, 0x24,
+	0x4b, 0x57, 0x3b, 0x1c, 0x12, 0xe6, 0x53, 0x36, 0xe8, 0x12, 0x22, 0xe2, 0x8a, 0x43, 0x8a, 0xa6,
+	0x8c, 0xaf, 0xfd, 0x4f, 0x18, 0x6b, 0xe3, 0x03, 0xa6, 0x1b, 0x14, 0xa2, 0x37, 0x12, 0x82, 0x30,
+	0x93, 0xcb, 0x5a, 0x1f, 0x90, 0xa2, 0x29, 0x07, 0x60, 0xf3, 0x5d, 0xe2, 0x4f, 0x58, 0x63, 0x07,
+	0x30, 0xa3, 0x09, 0x3d, 0x00, 0x14, 0xe0, 0x48, 0xf6, 0x05, 0x66, 0x91, 0x36, 0x58, 0x7a, 0x3b,
+	0x2b, 0x73, 0xb7, 0x73, 0x06, 0x57, 0xfd, 0x5f, 0x0a, 0xb0, 0x99, 0x52, 0xa3, 0xde, 0x0c, 0xe7,
+	0x59, 0xc8, 0x3a, 0xcf, 0x1d, 0x80, 0x48, 0xcb, 0x4c, 0x7b, 0x4c, 0xeb, 0xf5, 0x27, 0x14, 0xf5,
+	0x76, 0x50, 0x39, 0x61, 0x73, 0xae, 0xd7, 0xe7, 0xdf, 0xd5, 0x4e, 0xcd, 0x80, 0xb8, 0x06, 0x40,
+	0xc5, 0x0f, 0x43, 0xeb, 0xb9, 0x4c, 0xd1, 0x31, 0xfe, 0xad, 0xff, 0x29, 0xac, 0xa5, 0xb4, 0x57,
+	0x55, 0xc5, 0x26, 0xb5, 0x00, 0x5b, 0x05, 0x78, 0x0a, 0x2b, 0xd6, 0x33, 0x47, 0x36, 0xfd, 0xcf,
+	0xfb, 0xd2, 0x25, 0x4e, 0x82, 0x53, 0x96, 0x21, 0xc6, 0xaa, 0x1f, 0xc2, 0xd6, 0xac, 0x7e, 0x6a,
+	0xba, 0xf6, 0x86, 0xd5, 0x4e, 0x23, 0xfe, 0x35, 0x35, 0x3b, 0x61, 0x0e, 0xdf, 0x9a, 0xab, 0xbf,
+	0xeb, 0x7f, 0x04, 0x1b, 0x19, 0x2f, 0xa8, 0x24, 0x9b, 0x70, 0xc5, 0x06, 0x23, 0x41, 0x51, 0x81,
+	0x45, 0xf6, 0xe5, 0x92, 0x11, 0x7f, 0x96, 0x5c, 0xff, 0xbb, 0x02, 0xc0, 0xc4, 0x23, 0xa0, 0x75,
+	0x28, 0x52, 0xdf, 0x02, 0x16, 0xa9, 0xaf, 0x2f, 0x17, 0x35, 0xe4, 0x63, 0x1c, 0x26, 0x76, 0x31,
+	0x4d, 0xd4, 0xc7, 0x40, 0x10, 0x6c, 0x1c, 0xab, 0xda, 0xcc, 0x25, 0x77, 0x42, 0x50, 0xab, 0x1d,
+	0x85, 0x3e, 0x96, 0xc4, 0x3c, 0x59, 0x5d, 0x72, 0xe3, 0x5f, 0xc5, 0xa7, 0x6b, 0xc8, 0x9a, 0x6f,
+	0xc9, 0xf0, 0x8d, 0x09, 0xbb, 0x9f, 0xc2, 0x4a, 0xec, 0x6b, 0xd0, 0x06, 0xac, 0x3e, 0x39, 0xe9,
+	0x75, 0x8f, 0xda, 0x9d, 0xe3, 0xce, 0xd1, 0x61, 0xed, 0x3d, 0x04, 0xb0, 0xdc, 0x6c, 0xf7, 0x3b,
+	0x4f, 0x8f, 0x6a, 0x05, 0xb4, 0x0a, 0x95, 0x6e, 0xb3, 0xd7, 0x53, 0x3f, 0xc5, 0x5d, 0x0e, 0x6b,
+	0xa9, 0x82, 0xe1, 0x34, 0x6b, 0x15, 0x96, 0xfa, 0x6e, 0xb3, 0xad, 0x38, 0xab, 0xb0, 0x74, 0x78,
+	0xd4, 0x7a, 0x72, 0xbf, 0x56, 0x44, 0x2b, 0x50, 0xee, 0x9c, 0x1c, 0x9f, 0xd6, 0x4a, 0x0a, 0xee,
+	0x59, 0xd3, 0x3d, 0xe9, 0x9c, 0xdc, 0xaf, 0x95, 0x55, 0x8f, 0x23, 0xd7, 0x3d, 0x75, 0x6b, 0x4b,
+	0xe8, 0x06, 0xac, 0xb4, 0xdd, 0x4e, 0xbf, 0xd3, 0x6e, 0x3e, 0xaa, 0x2d, 0xa3, 0x0a, 0x94, 0x4e,
+	0x8f, 0x8f, 0x6b, 0x95, 0xdd, 0x43, 0xb8, 0x35, 0x33, 0x91, 0x9b, 0x1e, 0x78, 0x1d, 0xe0, 0xe1,
+	0x93, 0xd6, 0x91, 0x7b, 0x72, 0xd4, 0x3f, 0xea, 0xd5, 0x0a, 0x6a, 0x0d, 0x9d, 0x5e, 0xbf, 0x73,
+	0x7a, 0x58, 0x2b, 0xee, 0x3e, 0x80, 0xb5, 0xd4, 0x83, 0xc9, 0x69, 0xee, 0x4d, 0xd8, 0xe8, 0x7f,
+	0xd5, 0x71, 0x0f, 0x5f, 0x74, 0x9b, 0x6e, 0xff, 0xf9, 0x8b, 0x07, 0xcf, 0xfa, 0xb5, 0x82, 0x22,
+	0x1e, 0x77, 0xdc, 0x5e, 0x3f, 0x41, 0x2c, 0xee, 0xfe, 0xad, 0xd2, 0xd6, 0x69, 0x63, 0xa7, 0x20,
+	0x9b, 0xbe, 0xb2, 0x3d, 0x7d, 0x31, 0x8a, 0xa4, 0x81, 0x7c, 0x86, 0xa9, 0xa4, 0x6c, 0x70, 0xcc,
+	0x85, 0xb6, 0x5c, 0x06, 0xb2, 0xf7, 0x8a, 0x4a, 0xef, 0x82, 0xb2, 0x41, 0x8f, 0x0e, 0x18, 0x11,
+	0xb5, 0x22, 0xfa, 0x40, 0xe9, 0xbf, 0xb6, 0x31, 0x94, 0x0d, 0x9e, 0x71, 0xf1, 0x32, 0xe0, 0xd8,
+	0x8f, 0x6a, 0x25, 0xd5, 0x5b, 0xa9, 0xe5, 0xa5, 0x4a, 0xbb, 0x03, 0x5f, 0x8d, 0x5a, 0x2b, 0xa3,
+	0x5b, 0x70, 0x33, 0x1e, 0x59, 0x85, 0x2f, 0x01, 0x91, 0xc4, 0xaf, 0x2d, 0xed, 0x3e, 0x04, 0x34,
+	0xad, 0xc2, 0x68, 0x0d, 0xaa, 0x2e, 0xc1, 0xde, 0x85, 0xca, 0x32, 0x6a, 0xef, 0xe9, 0x75, 0x33,
+	0x31, 0x26, 0x14, 0x14, 0x58, 0x87, 0x5d, 0xe2, 0x80, 0xfa, 0xaa, 0x36, 0x63, 0x0e, 0x5e, 0xad,
+	0xd8, 0x6a, 0xff, 0xfa, 0xbb, 0x9d, 0xc2, 0xbf, 0x7f, 0xb7, 0x53, 0xf8, 0xef, 0xef, 0x76, 0x0a,
+	0x5f, 0xff, 0x74, 0x40, 0xe5, 0xc5, 0xe8, 0xac, 0xe1, 0xf1, 0xe1, 0xde, 0x19, 0x66, 0xbf, 0xc4,
+	0xd4, 0x0b, 0xf8, 0xc8, 0x37, 0x4f, 0xda, 0x3f, 0x8e, 0xb5, 0x78, 0xef, 0x72, 0x7f, 0x2f, 0xf9,
+	0xe2, 0xfd, 0x6c, 0x59, 0xdb, 0xbf, 0x4f, 0xfe, 0x7f, 0x00, 0x8c, 0xfb, 0x6d, 0xb0, 0x69, 0x2f,
+	0x00, 0x00,
+}
+
+func (m *IstioControlPlaneSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioControlPlaneSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioControlPlaneSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2715,9 +3401,25 @@ func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ClusterServices != nil {
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.Ca != nil {
 		{
-			size, err := m.ClusterServices.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Ca.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2725,11 +3427,13 @@ func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if m.Webhook != nil {
+	if m.NodeProxy != nil {
 		{
-			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.NodeProxy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2737,11 +3441,13 @@ func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if m.Istiod != nil {
+	if m.SidecarInjector != nil {
 		{
-			size, err := m.Istiod.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SidecarInjector.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2749,11 +3455,31 @@ func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.Gateway != nil {
+	if len(m.NetworkName) > 0 {
+		i -= len(m.NetworkName)
+		copy(dAtA[i:], m.NetworkName)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.NetworkName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.ClusterID) > 0 {
+		i -= len(m.ClusterID)
+		copy(dAtA[i:], m.ClusterID)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ClusterID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MeshExpansion != nil {
 		{
-			size, err := m.Gateway.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MeshExpansion.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2761,59 +3487,223 @@ func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.Enabled != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err21 != nil {
-			return 0, err21
+	if m.ContainerImageConfiguration != nil {
+		{
+			size, err := m.ContainerImageConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
-		i -= n21
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n21))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MeshExpansionConfiguration_Istiod) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MeshID) > 0 {
+		i -= len(m.MeshID)
+		copy(dAtA[i:], m.MeshID)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.MeshID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MeshExpansionConfiguration_Istiod) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MeshExpansionConfiguration_Istiod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.K8SResourceOverlays) > 0 {
+		for iNdEx := len(m.K8SResourceOverlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.K8SResourceOverlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.Expose != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
-		if err22 != nil {
-			return 0, err22
+	if m.MeshConfig != nil {
+		{
+			size, err := m.MeshConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.HttpProxyEnvs != nil {
+		{
+			size, err := m.HttpProxyEnvs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Distribution) > 0 {
+		i -= len(m.Distribution)
+		copy(dAtA[i:], m.Distribution)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Distribution)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CaProvider) > 0 {
+		i -= len(m.CaProvider)
+		copy(dAtA[i:], m.CaProvider)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaProvider)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.CaAddress) > 0 {
+		i -= len(m.CaAddress)
+		copy(dAtA[i:], m.CaAddress)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaAddress)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.JwtPolicy != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.JwtPolicy))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.WatchOneNamespace != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.WatchOneNamespace, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.WatchOneNamespace):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ProxyWasm != nil {
+		{
+			size, err := m.ProxyWasm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Sds != nil {
+		{
+			size, err := m.Sds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TelemetryV2 != nil {
+		{
+			size, err := m.TelemetryV2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProxyInit != nil {
+		{
+			size, err := m.ProxyInit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Proxy != nil {
+		{
+			size, err := m.Proxy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Istiod != nil {
+		{
+			size, err := m.Istiod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MountMtlsCerts != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.MountMtlsCerts, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.MountMtlsCerts):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Logging != nil {
+		{
+			size, err := m.Logging.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
-		i -= n22
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Mode != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Version)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MeshExpansionConfiguration_Webhook) Marshal() (dAtA []byte, err error) {
+func (m *SidecarInjectorConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2823,12 +3713,12 @@ func (m *MeshExpansionConfiguration_Webhook) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MeshExpansionConfiguration_Webhook) MarshalTo(dAtA []byte) (int, error) {
+func (m *SidecarInjectorConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MeshExpansionConfiguration_Webhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SidecarInjectorConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2837,20 +3727,34 @@ func (m *MeshExpansionConfiguration_Webhook) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Expose != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
-		if err23 != nil {
-			return 0, err23
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Deployment != nil {
+		{
+			size, err := m.Deployment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
-		i -= n23
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MeshExpansionConfiguration_ClusterServices) Marshal() (dAtA []byte, err error) {
+func (m *MeshExpansionConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2860,12 +3764,12 @@ func (m *MeshExpansionConfiguration_ClusterServices) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MeshExpansionConfiguration_ClusterServices) MarshalTo(dAtA []byte) (int, error) {
+func (m *MeshExpansionConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MeshExpansionConfiguration_ClusterServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MeshExpansionConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2874,16 +3778,175 @@ func (m *MeshExpansionConfiguration_ClusterServices) MarshalToSizedBuffer(dAtA [
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Expose != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
-		if err24 != nil {
-			return 0, err24
-		}
-		i -= n24
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n24))
-		i--
-		dAtA[i] = 0xa
-	}
+	if m.ClusterServices != nil {
+		{
+			size, err := m.ClusterServices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Istiod != nil {
+		{
+			size, err := m.Istiod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Gateway != nil {
+		{
+			size, err := m.Gateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MeshExpansionConfiguration_Istiod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshExpansionConfiguration_Istiod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshExpansionConfiguration_Istiod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expose != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MeshExpansionConfiguration_Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshExpansionConfiguration_Webhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshExpansionConfiguration_Webhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expose != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MeshExpansionConfiguration_ClusterServices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshExpansionConfiguration_ClusterServices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshExpansionConfiguration_ClusterServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expose != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Expose, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Expose):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2911,6 +3974,11 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) MarshalToSize
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AddressResolution != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.AddressResolution))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.K8SResourceOverlays) > 0 {
 		for iNdEx := len(m.K8SResourceOverlays) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2926,12 +3994,12 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) MarshalToSize
 		}
 	}
 	if m.RunAsRoot != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.RunAsRoot, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot):])
-		if err25 != nil {
-			return 0, err25
+		n27, err27 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.RunAsRoot, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n25
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n25))
+		i -= n27
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3119,12 +4187,12 @@ func (m *ProxyConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.HoldApplicationUntilProxyStarts != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.HoldApplicationUntilProxyStarts, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.HoldApplicationUntilProxyStarts):])
-		if err31 != nil {
-			return 0, err31
+		n33, err33 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.HoldApplicationUntilProxyStarts, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.HoldApplicationUntilProxyStarts):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n31
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n31))
+		i -= n33
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -3148,22 +4216,22 @@ func (m *ProxyConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.EnableCoreDump != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableCoreDump, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableCoreDump):])
-		if err32 != nil {
-			return 0, err32
+		n34, err34 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableCoreDump, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableCoreDump):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n32
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n32))
+		i -= n34
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.Privileged != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Privileged, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Privileged):])
-		if err33 != nil {
-			return 0, err33
+		n35, err35 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Privileged, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Privileged):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n33
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n33))
+		i -= n35
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3361,22 +4429,22 @@ func (m *CNIConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.Chained != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Chained, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Chained):])
-		if err40 != nil {
-			return 0, err40
+		n42, err42 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Chained, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Chained):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n40
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n40))
+		i -= n42
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x12
 	}
 	if m.Enabled != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err41 != nil {
-			return 0, err41
+		n43, err43 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n41
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n41))
+		i -= n43
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3429,32 +4497,32 @@ func (m *CNIConfiguration_RepairConfiguration) MarshalToSizedBuffer(dAtA []byte)
 		dAtA[i] = 0x22
 	}
 	if m.DeletePods != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.DeletePods, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.DeletePods):])
-		if err42 != nil {
-			return 0, err42
+		n44, err44 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.DeletePods, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.DeletePods):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n42
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n42))
+		i -= n44
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.LabelPods != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.LabelPods, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.LabelPods):])
-		if err43 != nil {
-			return 0, err43
+		n45, err45 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.LabelPods, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.LabelPods):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n43
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n43))
+		i -= n45
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x12
 	}
 	if m.Enabled != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err44 != nil {
-			return 0, err44
+		n46, err46 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n44
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n44))
+		i -= n46
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3498,12 +4566,12 @@ func (m *CNIConfiguration_TaintConfiguration) MarshalToSizedBuffer(dAtA []byte)
 		dAtA[i] = 0x12
 	}
 	if m.Enabled != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err46 != nil {
-			return 0, err46
+		n48, err48 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n46
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n46))
+		i -= n48
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3551,19 +4619,19 @@ func (m *CNIConfiguration_ResourceQuotas) MarshalToSizedBuffer(dAtA []byte) (int
 		dAtA[i] = 0x12
 	}
 	if m.Enabled != nil {
-		n47, err47 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err47 != nil {
-			return 0, err47
+		n49, err49 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n47
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n47))
+		i -= n49
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IstiodConfiguration) Marshal() (dAtA []byte, err error) {
+func (m *NodeProxyConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3573,12 +4641,12 @@ func (m *IstiodConfiguration) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IstiodConfiguration) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeProxyConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeProxyConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3587,9 +4655,9 @@ func (m *IstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Spiffe != nil {
+	if m.Daemonset != nil {
 		{
-			size, err := m.Spiffe.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Daemonset.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3597,47 +4665,271 @@ func (m *IstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.CertProvider != 0 {
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.CertProvider))
-		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x22
 	}
-	if m.EnableProtocolSniffingInbound != nil {
-		n49, err49 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableProtocolSniffingInbound, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableProtocolSniffingInbound):])
-		if err49 != nil {
-			return 0, err49
+	if len(m.ExcludeNamespaces) > 0 {
+		for iNdEx := len(m.ExcludeNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeNamespaces[iNdEx])
+			copy(dAtA[i:], m.ExcludeNamespaces[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ExcludeNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= n49
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n49))
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.EnableProtocolSniffingOutbound != nil {
-		n50, err50 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableProtocolSniffingOutbound, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableProtocolSniffingOutbound):])
-		if err50 != nil {
-			return 0, err50
-		}
-		i -= n50
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n50))
+	if m.LogLevel != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.LogLevel))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x10
 	}
-	if m.TraceSampling != nil {
-		n51, err51 := github_com_gogo_protobuf_types.StdFloatMarshalTo(*m.TraceSampling, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdFloat(*m.TraceSampling):])
+	if m.Enabled != nil {
+		n51, err51 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
 		if err51 != nil {
 			return 0, err51
 		}
 		i -= n51
 		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n51))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if m.ExternalIstiod != nil {
-		{
-			size, err := m.ExternalIstiod.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *CAConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CAConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CAConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RestartBatchSize != nil {
+		n52, err52 := github_com_gogo_protobuf_types.StdInt32MarshalTo(*m.RestartBatchSize, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdInt32(*m.RestartBatchSize):])
+		if err52 != nil {
+			return 0, err52
+		}
+		i -= n52
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n52))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextRootCASecret != nil {
+		{
+			size, err := m.NextRootCASecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IntermediateRenewBefore) > 0 {
+		i -= len(m.IntermediateRenewBefore)
+		copy(dAtA[i:], m.IntermediateRenewBefore)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.IntermediateRenewBefore)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IntermediateDuration) > 0 {
+		i -= len(m.IntermediateDuration)
+		copy(dAtA[i:], m.IntermediateDuration)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.IntermediateDuration)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RootCASecret != nil {
+		{
+			size, err := m.RootCASecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClusterSelector) > 0 {
+		for k := range m.ClusterSelector {
+			v := m.ClusterSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GatewayAddresses) > 0 {
+		for iNdEx := len(m.GatewayAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GatewayAddresses[iNdEx])
+			copy(dAtA[i:], m.GatewayAddresses[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.GatewayAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GatewayPort != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.GatewayPort))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GatewaySelector) > 0 {
+		for k := range m.GatewaySelector {
+			v := m.GatewaySelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IstiodConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstiodConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Spiffe != nil {
+		{
+			size, err := m.Spiffe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CertProvider != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.CertProvider))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EnableProtocolSniffingInbound != nil {
+		n56, err56 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableProtocolSniffingInbound, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableProtocolSniffingInbound):])
+		if err56 != nil {
+			return 0, err56
+		}
+		i -= n56
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n56))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EnableProtocolSniffingOutbound != nil {
+		n57, err57 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableProtocolSniffingOutbound, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableProtocolSniffingOutbound):])
+		if err57 != nil {
+			return 0, err57
+		}
+		i -= n57
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n57))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TraceSampling != nil {
+		n58, err58 := github_com_gogo_protobuf_types.StdFloatMarshalTo(*m.TraceSampling, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdFloat(*m.TraceSampling):])
+		if err58 != nil {
+			return 0, err58
+		}
+		i -= n58
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n58))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExternalIstiod != nil {
+		{
+			size, err := m.ExternalIstiod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -3647,22 +4939,22 @@ func (m *IstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.EnableStatus != nil {
-		n53, err53 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableStatus, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableStatus):])
-		if err53 != nil {
-			return 0, err53
+		n60, err60 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableStatus, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableStatus):])
+		if err60 != nil {
+			return 0, err60
 		}
-		i -= n53
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n53))
+		i -= n60
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n60))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.EnableAnalysis != nil {
-		n54, err54 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableAnalysis, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableAnalysis):])
-		if err54 != nil {
-			return 0, err54
+		n61, err61 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.EnableAnalysis, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableAnalysis):])
+		if err61 != nil {
+			return 0, err61
 		}
-		i -= n54
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n54))
+		i -= n61
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n61))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3706,12 +4998,12 @@ func (m *ExternalIstiodConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, er
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Enabled != nil {
-		n56, err56 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err56 != nil {
-			return 0, err56
+		n63, err63 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err63 != nil {
+			return 0, err63
 		}
-		i -= n56
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n56))
+		i -= n63
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n63))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3782,12 +5074,12 @@ func (m *OperatorEndpointsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int,
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Enabled != nil {
-		n58, err58 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err58 != nil {
-			return 0, err58
+		n65, err65 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err65 != nil {
+			return 0, err65
 		}
-		i -= n58
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n58))
+		i -= n65
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n65))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3819,12 +5111,12 @@ func (m *TelemetryV2Configuration) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Enabled != nil {
-		n59, err59 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err59 != nil {
-			return 0, err59
+		n66, err66 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err66 != nil {
+			return 0, err66
 		}
-		i -= n59
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n59))
+		i -= n66
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n66))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3856,12 +5148,12 @@ func (m *ProxyWasmConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Enabled != nil {
-		n60, err60 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err60 != nil {
-			return 0, err60
+		n67, err67 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err67 != nil {
+			return 0, err67
 		}
-		i -= n60
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n60))
+		i -= n67
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n67))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3893,12 +5185,12 @@ func (m *PDBConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Enabled != nil {
-		n61, err61 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
-		if err61 != nil {
-			return 0, err61
+		n68, err68 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.Enabled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled):])
+		if err68 != nil {
+			return 0, err68
 		}
-		i -= n61
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n61))
+		i -= n68
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(n68))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3977,9 +5269,41 @@ func (m *IstioControlPlaneStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Checksums != nil {
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ConfiguredRemoteClusters) > 0 {
+		for iNdEx := len(m.ConfiguredRemoteClusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfiguredRemoteClusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Ca != nil {
 		{
-			size, err := m.Checksums.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Ca.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3987,11 +5311,20 @@ func (m *IstioControlPlaneStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.MeshConfig != nil {
+	if m.Mode != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Plan != nil {
 		{
-			size, err := m.MeshConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3999,25 +5332,89 @@ func (m *IstioControlPlaneStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x7a
 	}
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x42
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if len(m.CaRootCertificate) > 0 {
-		i -= len(m.CaRootCertificate)
-		copy(dAtA[i:], m.CaRootCertificate)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaRootCertificate)))
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.ObservedGeneration))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x68
 	}
-	if len(m.InjectionNamespaces) > 0 {
-		for iNdEx := len(m.InjectionNamespaces) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.InjectionNamespaces[iNdEx])
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ChartBundleVersion) > 0 {
+		i -= len(m.ChartBundleVersion)
+		copy(dAtA[i:], m.ChartBundleVersion)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ChartBundleVersion)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Checksums != nil {
+		{
+			size, err := m.Checksums.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MeshConfig != nil {
+		{
+			size, err := m.MeshConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CaRootCertificate) > 0 {
+		i -= len(m.CaRootCertificate)
+		copy(dAtA[i:], m.CaRootCertificate)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CaRootCertificate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InjectionNamespaces) > 0 {
+		for iNdEx := len(m.InjectionNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InjectionNamespaces[iNdEx])
 			copy(dAtA[i:], m.InjectionNamespaces[iNdEx])
 			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.InjectionNamespaces[iNdEx])))
 			i--
@@ -4064,7 +5461,7 @@ func (m *IstioControlPlaneStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *StatusChecksums) Marshal() (dAtA []byte, err error) {
+func (m *CAStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4074,12 +5471,12 @@ func (m *StatusChecksums) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusChecksums) MarshalTo(dAtA []byte) (int, error) {
+func (m *CAStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatusChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CAStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4088,111 +5485,483 @@ func (m *StatusChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.SidecarInjector) > 0 {
-		i -= len(m.SidecarInjector)
-		copy(dAtA[i:], m.SidecarInjector)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.SidecarInjector)))
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RenewalTime != nil {
+		{
+			size, err := m.RenewalTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NotAfter != nil {
+		{
+			size, err := m.NotAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CertificateChain) > 0 {
+		i -= len(m.CertificateChain)
+		copy(dAtA[i:], m.CertificateChain)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CertificateChain)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MeshConfig) > 0 {
-		i -= len(m.MeshConfig)
-		copy(dAtA[i:], m.MeshConfig)
-		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.MeshConfig)))
+	if len(m.RootCASecret) > 0 {
+		i -= len(m.RootCASecret)
+		copy(dAtA[i:], m.RootCASecret)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.RootCASecret)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIstiocontrolplane(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIstiocontrolplane(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RootCARotationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IstioControlPlaneSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RootCARotationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RootCARotationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
-	}
-	if m.Mode != 0 {
-		n += 1 + sovIstiocontrolplane(uint64(m.Mode))
-	}
-	if m.Logging != nil {
-		l = m.Logging.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MountMtlsCerts != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.MountMtlsCerts)
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Istiod != nil {
-		l = m.Istiod.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.RestartedNamespaces) > 0 {
+		for iNdEx := len(m.RestartedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartedNamespaces[iNdEx])
+			copy(dAtA[i:], m.RestartedNamespaces[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.RestartedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Proxy != nil {
-		l = m.Proxy.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.CurrentBatch) > 0 {
+		for iNdEx := len(m.CurrentBatch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentBatch[iNdEx])
+			copy(dAtA[i:], m.CurrentBatch[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.CurrentBatch[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.ProxyInit != nil {
-		l = m.ProxyInit.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.PendingNamespaces) > 0 {
+		for iNdEx := len(m.PendingNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingNamespaces[iNdEx])
+			copy(dAtA[i:], m.PendingNamespaces[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.PendingNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.TelemetryV2 != nil {
-		l = m.TelemetryV2.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.PendingPeers) > 0 {
+		for iNdEx := len(m.PendingPeers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingPeers[iNdEx])
+			copy(dAtA[i:], m.PendingPeers[iNdEx])
+			i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.PendingPeers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Sds != nil {
-		l = m.Sds.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.NextRootCASecret) > 0 {
+		i -= len(m.NextRootCASecret)
+		copy(dAtA[i:], m.NextRootCASecret)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.NextRootCASecret)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ProxyWasm != nil {
-		l = m.ProxyWasm.Size()
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if m.Phase != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.WatchOneNamespace != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.WatchOneNamespace)
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.JwtPolicy != 0 {
-		n += 1 + sovIstiocontrolplane(uint64(m.JwtPolicy))
+	return dAtA[:n], nil
+}
+
+func (m *RemoteClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.CaAddress)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.CaProvider)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if m.State != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Distribution)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.SecretName) > 0 {
+		i -= len(m.SecretName)
+		copy(dAtA[i:], m.SecretName)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.SecretName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.HttpProxyEnvs != nil {
-		l = m.HttpProxyEnvs.Size()
-		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	if len(m.ClusterID) > 0 {
+		i -= len(m.ClusterID)
+		copy(dAtA[i:], m.ClusterID)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ClusterID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MeshConfig != nil {
-		l = m.MeshConfig.Size()
-		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.K8SResourceOverlays) > 0 {
-		for _, e := range m.K8SResourceOverlays {
-			l = e.Size()
-			n += 2 + l + sovIstiocontrolplane(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Gateways) > 0 {
+		for iNdEx := len(m.Gateways) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gateways[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplane(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkGatewayStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkGatewayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkGatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Port != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusChecksums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusChecksums) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SidecarInjector) > 0 {
+		i -= len(m.SidecarInjector)
+		copy(dAtA[i:], m.SidecarInjector)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.SidecarInjector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MeshConfig) > 0 {
+		i -= len(m.MeshConfig)
+		copy(dAtA[i:], m.MeshConfig)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.MeshConfig)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Deletions != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Deletions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Updates != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Updates))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Creations != 0 {
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(m.Creations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConfigMapName) > 0 {
+		i -= len(m.ConfigMapName)
+		copy(dAtA[i:], m.ConfigMapName)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.ConfigMapName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIstiocontrolplane(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIstiocontrolplane(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIstiocontrolplane(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IstioControlPlaneSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Mode))
+	}
+	if m.Logging != nil {
+		l = m.Logging.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.MountMtlsCerts != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.MountMtlsCerts)
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Istiod != nil {
+		l = m.Istiod.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Proxy != nil {
+		l = m.Proxy.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.ProxyInit != nil {
+		l = m.ProxyInit.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.TelemetryV2 != nil {
+		l = m.TelemetryV2.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Sds != nil {
+		l = m.Sds.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.ProxyWasm != nil {
+		l = m.ProxyWasm.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.WatchOneNamespace != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.WatchOneNamespace)
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.JwtPolicy != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.JwtPolicy))
+	}
+	l = len(m.CaAddress)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.CaProvider)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.Distribution)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.HttpProxyEnvs != nil {
+		l = m.HttpProxyEnvs.Size()
+		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.MeshConfig != nil {
+		l = m.MeshConfig.Size()
+		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.K8SResourceOverlays) > 0 {
+		for _, e := range m.K8SResourceOverlays {
+			l = e.Size()
+			n += 2 + l + sovIstiocontrolplane(uint64(l))
+		}
 	}
 	l = len(m.MeshID)
 	if l > 0 {
@@ -4218,6 +5987,20 @@ func (m *IstioControlPlaneSpec) Size() (n int) {
 		l = m.SidecarInjector.Size()
 		n += 2 + l + sovIstiocontrolplane(uint64(l))
 	}
+	if m.NodeProxy != nil {
+		l = m.NodeProxy.Size()
+		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Ca != nil {
+		l = m.Ca.Size()
+		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 2 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4352,6 +6135,9 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Size() (n int
 			n += 1 + l + sovIstiocontrolplane(uint64(l))
 		}
 	}
+	if m.AddressResolution != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.AddressResolution))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4627,22 +6413,124 @@ func (m *CNIConfiguration_ResourceQuotas) Size() (n int) {
 	return n
 }
 
-func (m *IstiodConfiguration) Size() (n int) {
+func (m *NodeProxyConfiguration) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Deployment != nil {
-		l = m.Deployment.Size()
+	if m.Enabled != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.Enabled)
 		n += 1 + l + sovIstiocontrolplane(uint64(l))
 	}
-	if m.EnableAnalysis != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableAnalysis)
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	if m.LogLevel != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.LogLevel))
 	}
-	if m.EnableStatus != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableStatus)
+	if len(m.ExcludeNamespaces) > 0 {
+		for _, s := range m.ExcludeNamespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.Daemonset != nil {
+		l = m.Daemonset.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CAConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RootCASecret != nil {
+		l = m.RootCASecret.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.IntermediateDuration)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.IntermediateRenewBefore)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.NextRootCASecret != nil {
+		l = m.NextRootCASecret.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.RestartBatchSize != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdInt32(*m.RestartBatchSize)
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.GatewaySelector) > 0 {
+		for k, v := range m.GatewaySelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIstiocontrolplane(uint64(len(k))) + 1 + len(v) + sovIstiocontrolplane(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIstiocontrolplane(uint64(mapEntrySize))
+		}
+	}
+	if m.GatewayPort != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.GatewayPort))
+	}
+	if len(m.GatewayAddresses) > 0 {
+		for _, s := range m.GatewayAddresses {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if len(m.ClusterSelector) > 0 {
+		for k, v := range m.ClusterSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIstiocontrolplane(uint64(len(k))) + 1 + len(v) + sovIstiocontrolplane(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIstiocontrolplane(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstiodConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.EnableAnalysis != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableAnalysis)
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.EnableStatus != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.EnableStatus)
 		n += 1 + l + sovIstiocontrolplane(uint64(l))
 	}
 	if m.ExternalIstiod != nil {
@@ -4845,39 +6733,1833 @@ func (m *IstioControlPlaneStatus) Size() (n int) {
 		l = m.Checksums.Size()
 		n += 1 + l + sovIstiocontrolplane(uint64(l))
 	}
+	l = len(m.ChartBundleVersion)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.ObservedGeneration))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 2 + sovIstiocontrolplane(uint64(m.Mode))
+	}
+	if m.Ca != nil {
+		l = m.Ca.Size()
+		n += 2 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.ConfiguredRemoteClusters) > 0 {
+		for _, e := range m.ConfiguredRemoteClusters {
+			l = e.Size()
+			n += 2 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 2 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CAStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootCASecret)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.CertificateChain)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = m.NotAfter.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.RenewalTime != nil {
+		l = m.RenewalTime.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RootCARotationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Phase))
+	}
+	l = len(m.NextRootCASecret)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, s := range m.PendingPeers {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if len(m.PendingNamespaces) > 0 {
+		for _, s := range m.PendingNamespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if len(m.CurrentBatch) > 0 {
+		for _, s := range m.CurrentBatch {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if len(m.RestartedNamespaces) > 0 {
+		for _, s := range m.RestartedNamespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoteClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.SecretName)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.State))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovIstiocontrolplane(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkGatewayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Port))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusChecksums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MeshConfig)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.SidecarInjector)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	l = len(m.ConfigMapName)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplane(uint64(l))
+	}
+	if m.Creations != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Creations))
+	}
+	if m.Updates != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Updates))
+	}
+	if m.Deletions != 0 {
+		n += 1 + sovIstiocontrolplane(uint64(m.Deletions))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func sovIstiocontrolplane(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIstiocontrolplane(x uint64) (n int) {
+	return sovIstiocontrolplane(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioControlPlaneSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioControlPlaneSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ModeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logging", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Logging == nil {
+				m.Logging = &LoggingConfiguration{}
+			}
+			if err := m.Logging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountMtlsCerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MountMtlsCerts == nil {
+				m.MountMtlsCerts = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.MountMtlsCerts, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Istiod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Istiod == nil {
+				m.Istiod = &IstiodConfiguration{}
+			}
+			if err := m.Istiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proxy == nil {
+				m.Proxy = &ProxyConfiguration{}
+			}
+			if err := m.Proxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyInit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyInit == nil {
+				m.ProxyInit = &ProxyInitConfiguration{}
+			}
+			if err := m.ProxyInit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TelemetryV2 == nil {
+				m.TelemetryV2 = &TelemetryV2Configuration{}
+			}
+			if err := m.TelemetryV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sds == nil {
+				m.Sds = &SDSConfiguration{}
+			}
+			if err := m.Sds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyWasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyWasm == nil {
+				m.ProxyWasm = &ProxyWasmConfiguration{}
+			}
+			if err := m.ProxyWasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchOneNamespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchOneNamespace == nil {
+				m.WatchOneNamespace = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.WatchOneNamespace, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JwtPolicy", wireType)
+			}
+			m.JwtPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JwtPolicy |= JWTPolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpProxyEnvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HttpProxyEnvs == nil {
+				m.HttpProxyEnvs = &HTTPProxyEnvsConfiguration{}
+			}
+			if err := m.HttpProxyEnvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeshConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MeshConfig == nil {
+				m.MeshConfig = &v1alpha1.MeshConfig{}
+			}
+			if err := m.MeshConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
+			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeshID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MeshID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerImageConfiguration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerImageConfiguration == nil {
+				m.ContainerImageConfiguration = &ContainerImageConfiguration{}
+			}
+			if err := m.ContainerImageConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeshExpansion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MeshExpansion == nil {
+				m.MeshExpansion = &MeshExpansionConfiguration{}
+			}
+			if err := m.MeshExpansion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarInjector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SidecarInjector == nil {
+				m.SidecarInjector = &SidecarInjectorConfiguration{}
+			}
+			if err := m.SidecarInjector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeProxy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeProxy == nil {
+				m.NodeProxy = &NodeProxyConfiguration{}
+			}
+			if err := m.NodeProxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ca", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ca == nil {
+				m.Ca = &CAConfiguration{}
+			}
+			if err := m.Ca.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, &NetworkConfiguration{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SidecarInjectorConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SidecarInjectorConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &BaseKubernetesResourceConfig{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MeshExpansionConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MeshExpansionConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gateway == nil {
+				m.Gateway = &MeshExpansionConfiguration_IstioMeshGatewayConfiguration{}
+			}
+			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Istiod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Istiod == nil {
+				m.Istiod = &MeshExpansionConfiguration_Istiod{}
+			}
+			if err := m.Istiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &MeshExpansionConfiguration_Webhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterServices == nil {
+				m.ClusterServices = &MeshExpansionConfiguration_ClusterServices{}
+			}
+			if err := m.ClusterServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeshExpansionConfiguration_Istiod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Istiod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Istiod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expose == nil {
+				m.Expose = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeshExpansionConfiguration_Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expose == nil {
+				m.Expose = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeshExpansionConfiguration_ClusterServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expose == nil {
+				m.Expose = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *StatusChecksums) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MeshConfig)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
-	}
-	l = len(m.SidecarInjector)
-	if l > 0 {
-		n += 1 + l + sovIstiocontrolplane(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIstiocontrolplane(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIstiocontrolplane(x uint64) (n int) {
-	return sovIstiocontrolplane(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
+func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4900,17 +8582,17 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioControlPlaneSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioMeshGatewayConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioControlPlaneSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioMeshGatewayConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -4920,29 +8602,33 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &K8SObjectMeta{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -4952,14 +8638,31 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= ModeType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &BaseKubernetesResourceConfig{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logging", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4986,16 +8689,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Logging == nil {
-				m.Logging = &LoggingConfiguration{}
+			if m.Service == nil {
+				m.Service = &UnprotectedService{}
 			}
-			if err := m.Logging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountMtlsCerts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsRoot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5022,16 +8725,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MountMtlsCerts == nil {
-				m.MountMtlsCerts = new(bool)
+			if m.RunAsRoot == nil {
+				m.RunAsRoot = new(bool)
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.MountMtlsCerts, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.RunAsRoot, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Istiod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5058,18 +8761,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Istiod == nil {
-				m.Istiod = &IstiodConfiguration{}
-			}
-			if err := m.Istiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
+			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressResolution", wireType)
 			}
-			var msglen int
+			m.AddressResolution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5079,33 +8780,67 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddressResolution |= AddressResolution(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proxy == nil {
-				m.Proxy = &ProxyConfiguration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
 			}
-			if err := m.Proxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5115,33 +8850,80 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyInit == nil {
-				m.ProxyInit = &ProxyInitConfiguration{}
-			}
-			if err := m.ProxyInit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SDSConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SDSConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SDSConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryV2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAudience", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5151,33 +8933,80 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAudience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TelemetryV2 == nil {
-				m.TelemetryV2 = &TelemetryV2Configuration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
 			}
-			if err := m.TelemetryV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5187,31 +9016,27 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sds == nil {
-				m.Sds = &SDSConfiguration{}
-			}
-			if err := m.Sds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyWasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5238,16 +9063,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProxyWasm == nil {
-				m.ProxyWasm = &ProxyWasmConfiguration{}
+			if m.Privileged == nil {
+				m.Privileged = new(bool)
 			}
-			if err := m.ProxyWasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Privileged, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchOneNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCoreDump", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5274,37 +9099,18 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WatchOneNamespace == nil {
-				m.WatchOneNamespace = new(bool)
+			if m.EnableCoreDump == nil {
+				m.EnableCoreDump = new(bool)
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.WatchOneNamespace, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableCoreDump, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JwtPolicy", wireType)
-			}
-			m.JwtPolicy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JwtPolicy |= JWTPolicyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
 			}
-			var stringLen uint64
+			m.LogLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5314,27 +9120,14 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LogLevel |= ProxyLogLevel(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CaAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentLogLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5362,11 +9155,11 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CaProvider = string(dAtA[iNdEx:postIndex])
+			m.ComponentLogLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDomain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5394,11 +9187,11 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Distribution = string(dAtA[iNdEx:postIndex])
+			m.ClusterDomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpProxyEnvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldApplicationUntilProxyStarts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5425,16 +9218,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HttpProxyEnvs == nil {
-				m.HttpProxyEnvs = &HTTPProxyEnvsConfiguration{}
+			if m.HoldApplicationUntilProxyStarts == nil {
+				m.HoldApplicationUntilProxyStarts = new(bool)
 			}
-			if err := m.HttpProxyEnvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.HoldApplicationUntilProxyStarts, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeshConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5461,16 +9254,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MeshConfig == nil {
-				m.MeshConfig = &v1alpha1.MeshConfig{}
+			if m.Lifecycle == nil {
+				m.Lifecycle = &v1.Lifecycle{}
 			}
-			if err := m.MeshConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5497,14 +9290,16 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
-			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resources == nil {
+				m.Resources = &ResourceRequirements{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeshID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeIPRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5532,83 +9327,11 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeshID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerImageConfiguration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ContainerImageConfiguration == nil {
-				m.ContainerImageConfiguration = &ContainerImageConfiguration{}
-			}
-			if err := m.ContainerImageConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeshExpansion", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MeshExpansion == nil {
-				m.MeshExpansion = &MeshExpansionConfiguration{}
-			}
-			if err := m.MeshExpansion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IncludeIPRanges = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIPRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5636,11 +9359,11 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			m.ExcludeIPRanges = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeInboundPorts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5668,13 +9391,13 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkName = string(dAtA[iNdEx:postIndex])
+			m.ExcludeInboundPorts = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarInjector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeOutboundPorts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5684,27 +9407,23 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarInjector == nil {
-				m.SidecarInjector = &SidecarInjectorConfiguration{}
-			}
-			if err := m.SidecarInjector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExcludeOutboundPorts = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5728,7 +9447,7 @@ func (m *IstioControlPlaneSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
+func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5751,15 +9470,47 @@ func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SidecarInjectorConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyInitConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SidecarInjectorConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyInitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5786,16 +9537,16 @@ func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &BaseKubernetesResourceConfig{}
+			if m.Resources == nil {
+				m.Resources = &ResourceRequirements{}
 			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cni", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5822,10 +9573,10 @@ func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Cni == nil {
+				m.Cni = &CNIConfiguration{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cni.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5851,7 +9602,7 @@ func (m *SidecarInjectorConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5874,10 +9625,10 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MeshExpansionConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: CNIConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MeshExpansionConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CNIConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5902,25 +9653,189 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chained", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chained == nil {
+				m.Chained = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Chained, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeNamespaces = append(m.ExcludeNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IncludeNamespaces = append(m.IncludeNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5930,33 +9845,29 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gateway == nil {
-				m.Gateway = &MeshExpansionConfiguration_IstioMeshGatewayConfiguration{}
-			}
-			if err := m.Gateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LogLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Istiod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfFileName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -5966,33 +9877,29 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Istiod == nil {
-				m.Istiod = &MeshExpansionConfiguration_Istiod{}
-			}
-			if err := m.Istiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfFileName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PspClusterRoleName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -6002,31 +9909,27 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &MeshExpansionConfiguration_Webhook{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PspClusterRoleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6053,67 +9956,16 @@ func (m *MeshExpansionConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterServices == nil {
-				m.ClusterServices = &MeshExpansionConfiguration_ClusterServices{}
+			if m.Repair == nil {
+				m.Repair = &CNIConfiguration_RepairConfiguration{}
 			}
-			if err := m.ClusterServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MeshExpansionConfiguration_Istiod) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Istiod: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Istiod: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6140,67 +9992,16 @@ func (m *MeshExpansionConfiguration_Istiod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expose == nil {
-				m.Expose = new(bool)
+			if m.Taint == nil {
+				m.Taint = &CNIConfiguration_TaintConfiguration{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Taint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MeshExpansionConfiguration_Webhook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuotas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6227,67 +10028,16 @@ func (m *MeshExpansionConfiguration_Webhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expose == nil {
-				m.Expose = new(bool)
+			if m.ResourceQuotas == nil {
+				m.ResourceQuotas = &CNIConfiguration_ResourceQuotas{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceQuotas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MeshExpansionConfiguration_ClusterServices) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterServices: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterServices: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemonset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6314,10 +10064,10 @@ func (m *MeshExpansionConfiguration_ClusterServices) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expose == nil {
-				m.Expose = new(bool)
+			if m.Daemonset == nil {
+				m.Daemonset = &BaseKubernetesResourceConfig{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Expose, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Daemonset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6343,7 +10093,7 @@ func (m *MeshExpansionConfiguration_ClusterServices) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6366,15 +10116,15 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioMeshGatewayConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepairConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioMeshGatewayConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepairConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6401,16 +10151,16 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &K8SObjectMeta{}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelPods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6437,16 +10187,16 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &BaseKubernetesResourceConfig{}
+			if m.LabelPods == nil {
+				m.LabelPods = new(bool)
 			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.LabelPods, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6473,18 +10223,18 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &UnprotectedService{}
+			if m.DeletePods == nil {
+				m.DeletePods = new(bool)
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.DeletePods, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -6494,33 +10244,29 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunAsRoot == nil {
-				m.RunAsRoot = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.RunAsRoot, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitContainerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenPodLabelKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -6530,80 +10276,27 @@ func (m *MeshExpansionConfiguration_IstioMeshGatewayConfiguration) Unmarshal(dAt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
-			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BrokenPodLabelKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoggingConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoggingConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggingConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenPodLabelValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6631,7 +10324,7 @@ func (m *LoggingConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.BrokenPodLabelValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6655,7 +10348,7 @@ func (m *LoggingConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SDSConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CNIConfiguration_TaintConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6678,17 +10371,17 @@ func (m *SDSConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SDSConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaintConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SDSConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaintConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAudience", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -6698,23 +10391,63 @@ func (m *SDSConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAudience = string(dAtA[iNdEx:postIndex])
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &BaseKubernetesContainerConfiguration{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6738,7 +10471,7 @@ func (m *SDSConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CNIConfiguration_ResourceQuotas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6761,15 +10494,51 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceQuotas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6797,13 +10566,13 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Pods = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClasses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -6813,31 +10582,78 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClasses = append(m.PriorityClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Privileged == nil {
-				m.Privileged = new(bool)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeProxyConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Privileged, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeProxyConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeProxyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCoreDump", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6864,14 +10680,14 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnableCoreDump == nil {
-				m.EnableCoreDump = new(bool)
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableCoreDump, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
 			}
@@ -6890,41 +10706,9 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentLogLevel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ComponentLogLevel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeNamespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6952,11 +10736,11 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterDomain = string(dAtA[iNdEx:postIndex])
+			m.ExcludeNamespaces = append(m.ExcludeNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldApplicationUntilProxyStarts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemonset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6983,52 +10767,67 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HoldApplicationUntilProxyStarts == nil {
-				m.HoldApplicationUntilProxyStarts = new(bool)
+			if m.Daemonset == nil {
+				m.Daemonset = &BaseKubernetesResourceConfig{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.HoldApplicationUntilProxyStarts, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Daemonset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lifecycle == nil {
-				m.Lifecycle = &v1.Lifecycle{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CAConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
 			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CAConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CAConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCASecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7055,16 +10854,16 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &ResourceRequirements{}
+			if m.RootCASecret == nil {
+				m.RootCASecret = &NamespacedName{}
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RootCASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeIPRanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7092,11 +10891,11 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncludeIPRanges = string(dAtA[iNdEx:postIndex])
+			m.IntermediateDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIPRanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateRenewBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7124,13 +10923,13 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludeIPRanges = string(dAtA[iNdEx:postIndex])
+			m.IntermediateRenewBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeInboundPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRootCASecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7140,29 +10939,33 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludeInboundPorts = string(dAtA[iNdEx:postIndex])
+			if m.NextRootCASecret == nil {
+				m.NextRootCASecret = &NamespacedName{}
+			}
+			if err := m.NextRootCASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeOutboundPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartBatchSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7172,23 +10975,27 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludeOutboundPorts = string(dAtA[iNdEx:postIndex])
+			if m.RestartBatchSize == nil {
+				m.RestartBatchSize = new(int32)
+			}
+			if err := github_com_gogo_protobuf_types.StdInt32Unmarshal(m.RestartBatchSize, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7212,7 +11019,7 @@ func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
+func (m *NetworkConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7235,17 +11042,49 @@ func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyInitConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyInitConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewaySelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7255,29 +11094,143 @@ func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.GatewaySelector == nil {
+				m.GatewaySelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIstiocontrolplane
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIstiocontrolplane
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIstiocontrolplane
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GatewaySelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayPort", wireType)
+			}
+			m.GatewayPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7287,31 +11240,27 @@ func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &ResourceRequirements{}
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GatewayAddresses = append(m.GatewayAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cni", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7338,12 +11287,103 @@ func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cni == nil {
-				m.Cni = &CNIConfiguration{}
-			}
-			if err := m.Cni.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.ClusterSelector == nil {
+				m.ClusterSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIstiocontrolplane
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIstiocontrolplane
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIstiocontrolplane
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIstiocontrolplane
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7367,7 +11407,7 @@ func (m *ProxyInitConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
+func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,15 +11430,15 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CNIConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstiodConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CNIConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstiodConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7425,16 +11465,16 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
+			if m.Deployment == nil {
+				m.Deployment = &BaseKubernetesResourceConfig{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chained", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAnalysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7461,18 +11501,18 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Chained == nil {
-				m.Chained = new(bool)
+			if m.EnableAnalysis == nil {
+				m.EnableAnalysis = new(bool)
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Chained, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableAnalysis, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7482,29 +11522,33 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinDir = string(dAtA[iNdEx:postIndex])
+			if m.EnableStatus == nil {
+				m.EnableStatus = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableStatus, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIstiod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7514,29 +11558,33 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfDir = string(dAtA[iNdEx:postIndex])
+			if m.ExternalIstiod == nil {
+				m.ExternalIstiod = &ExternalIstiodConfiguration{}
+			}
+			if err := m.ExternalIstiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeNamespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceSampling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7546,29 +11594,33 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludeNamespaces = append(m.ExcludeNamespaces, string(dAtA[iNdEx:postIndex]))
+			if m.TraceSampling == nil {
+				m.TraceSampling = new(float32)
+			}
+			if err := github_com_gogo_protobuf_types.StdFloatUnmarshal(m.TraceSampling, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeNamespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableProtocolSniffingOutbound", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7578,29 +11630,33 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncludeNamespaces = append(m.IncludeNamespaces, string(dAtA[iNdEx:postIndex]))
+			if m.EnableProtocolSniffingOutbound == nil {
+				m.EnableProtocolSniffingOutbound = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableProtocolSniffingOutbound, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableProtocolSniffingInbound", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7610,29 +11666,52 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogLevel = string(dAtA[iNdEx:postIndex])
+			if m.EnableProtocolSniffingInbound == nil {
+				m.EnableProtocolSniffingInbound = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableProtocolSniffingInbound, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertProvider", wireType)
+			}
+			m.CertProvider = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertProvider |= PilotCertProviderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfFileName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spiffe", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7642,29 +11721,84 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfFileName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			if m.Spiffe == nil {
+				m.Spiffe = &SPIFFEConfiguration{}
+			}
+			if err := m.Spiffe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalIstiodConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalIstiodConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalIstiodConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PspClusterRoleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -7674,27 +11808,82 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PspClusterRoleName = string(dAtA[iNdEx:postIndex])
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SPIFFEConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SPIFFEConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SPIFFEConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorEndpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7721,16 +11910,67 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repair == nil {
-				m.Repair = &CNIConfiguration_RepairConfiguration{}
+			if m.OperatorEndpoints == nil {
+				m.OperatorEndpoints = &OperatorEndpointsConfiguration{}
 			}
-			if err := m.Repair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OperatorEndpoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorEndpointsConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorEndpointsConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorEndpointsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Taint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7757,16 +11997,67 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Taint == nil {
-				m.Taint = &CNIConfiguration_TaintConfiguration{}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
 			}
-			if err := m.Taint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TelemetryV2Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TelemetryV2Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TelemetryV2Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuotas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7793,16 +12084,67 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceQuotas == nil {
-				m.ResourceQuotas = &CNIConfiguration_ResourceQuotas{}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
 			}
-			if err := m.ResourceQuotas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyWasmConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyWasmConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyWasmConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemonset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7829,10 +12171,10 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Daemonset == nil {
-				m.Daemonset = &BaseKubernetesResourceConfig{}
+			if m.Enabled == nil {
+				m.Enabled = new(bool)
 			}
-			if err := m.Daemonset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7858,7 +12200,7 @@ func (m *CNIConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
+func (m *PDBConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7881,10 +12223,10 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepairConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: PDBConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepairConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PDBConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7923,81 +12265,60 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelPods", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelPods == nil {
-				m.LabelPods = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.LabelPods, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletePods", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeletePods == nil {
-				m.DeletePods = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.DeletePods, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPProxyEnvsConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPProxyEnvsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpProxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8025,11 +12346,11 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerName = string(dAtA[iNdEx:postIndex])
+			m.HttpProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokenPodLabelKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpsProxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8057,11 +12378,11 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrokenPodLabelKey = string(dAtA[iNdEx:postIndex])
+			m.HttpsProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BrokenPodLabelValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8089,7 +12410,7 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BrokenPodLabelValue = string(dAtA[iNdEx:postIndex])
+			m.NoProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8113,7 +12434,7 @@ func (m *CNIConfiguration_RepairConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CNIConfiguration_TaintConfiguration) Unmarshal(dAtA []byte) error {
+func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8136,17 +12457,36 @@ func (m *CNIConfiguration_TaintConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaintConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioControlPlaneStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaintConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioControlPlaneStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8156,33 +12496,29 @@ func (m *CNIConfiguration_TaintConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlaneName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8192,84 +12528,61 @@ func (m *CNIConfiguration_TaintConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &BaseKubernetesContainerConfiguration{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IstioControlPlaneName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CNIConfiguration_ResourceQuotas) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceQuotas: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceQuotas: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = append(m.GatewayAddress, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IstiodAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8279,31 +12592,27 @@ func (m *CNIConfiguration_ResourceQuotas) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IstiodAddresses = append(m.IstiodAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectionNamespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8331,11 +12640,11 @@ func (m *CNIConfiguration_ResourceQuotas) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = string(dAtA[iNdEx:postIndex])
+			m.InjectionNamespaces = append(m.InjectionNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClasses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CaRootCertificate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8363,62 +12672,43 @@ func (m *CNIConfiguration_ResourceQuotas) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClasses = append(m.PriorityClasses, string(dAtA[iNdEx:postIndex]))
+			m.CaRootCertificate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IstiodConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstiodConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeshConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8445,16 +12735,16 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &BaseKubernetesResourceConfig{}
+			if m.MeshConfig == nil {
+				m.MeshConfig = &v1alpha1.MeshConfig{}
 			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MeshConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8481,18 +12771,18 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnableAnalysis == nil {
-				m.EnableAnalysis = new(bool)
+			if m.Checksums == nil {
+				m.Checksums = &StatusChecksums{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableAnalysis, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checksums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartBundleVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8502,31 +12792,27 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnableStatus == nil {
-				m.EnableStatus = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableStatus, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChartBundleVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIstiod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8553,16 +12839,33 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalIstiod == nil {
-				m.ExternalIstiod = &ExternalIstiodConfiguration{}
-			}
-			if err := m.ExternalIstiod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceSampling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8589,16 +12892,14 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceSampling == nil {
-				m.TraceSampling = new(float32)
-			}
-			if err := github_com_gogo_protobuf_types.StdFloatUnmarshal(m.TraceSampling, dAtA[iNdEx:postIndex]); err != nil {
+			m.Components = append(m.Components, ComponentStatus{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableProtocolSniffingOutbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8625,16 +12926,35 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnableProtocolSniffingOutbound == nil {
-				m.EnableProtocolSniffingOutbound = new(bool)
+			if m.Plan == nil {
+				m.Plan = &PlanStatus{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableProtocolSniffingOutbound, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ModeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableProtocolSniffingInbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ca", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8661,18 +12981,18 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnableProtocolSniffingInbound == nil {
-				m.EnableProtocolSniffingInbound = new(bool)
+			if m.Ca == nil {
+				m.Ca = &CAStatus{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.EnableProtocolSniffingInbound, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ca.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertProvider", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfiguredRemoteClusters", wireType)
 			}
-			m.CertProvider = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8682,14 +13002,29 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CertProvider |= PilotCertProviderType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfiguredRemoteClusters = append(m.ConfiguredRemoteClusters, RemoteClusterStatus{})
+			if err := m.ConfiguredRemoteClusters[len(m.ConfiguredRemoteClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spiffe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8716,10 +13051,8 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spiffe == nil {
-				m.Spiffe = &SPIFFEConfiguration{}
-			}
-			if err := m.Spiffe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Networks = append(m.Networks, NetworkStatus{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8745,7 +13078,7 @@ func (m *IstiodConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExternalIstiodConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CAStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8768,17 +13101,17 @@ func (m *ExternalIstiodConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExternalIstiodConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: CAStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalIstiodConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CAStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCASecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -8788,82 +13121,59 @@ func (m *ExternalIstiodConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RootCASecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateChain", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SPIFFEConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SPIFFEConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SPIFFEConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CertificateChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorEndpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8890,67 +13200,16 @@ func (m *SPIFFEConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperatorEndpoints == nil {
-				m.OperatorEndpoints = &OperatorEndpointsConfiguration{}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
 			}
-			if err := m.OperatorEndpoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperatorEndpointsConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorEndpointsConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorEndpointsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8977,67 +13236,16 @@ func (m *OperatorEndpointsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
+			if m.NotAfter == nil {
+				m.NotAfter = &types.Timestamp{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TelemetryV2Configuration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TelemetryV2Configuration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TelemetryV2Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9061,70 +13269,19 @@ func (m *TelemetryV2Configuration) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProxyWasmConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.RenewalTime == nil {
+				m.RenewalTime = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyWasmConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyWasmConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.RenewalTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9151,10 +13308,10 @@ func (m *ProxyWasmConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
+			if m.Rotation == nil {
+				m.Rotation = &RootCARotationStatus{}
 			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9180,7 +13337,7 @@ func (m *ProxyWasmConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PDBConfiguration) Unmarshal(dAtA []byte) error {
+func (m *RootCARotationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9203,17 +13360,36 @@ func (m *PDBConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PDBConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: RootCARotationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PDBConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RootCARotationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= RootCARotationPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRootCASecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9223,82 +13399,59 @@ func (m *PDBConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Enabled == nil {
-				m.Enabled = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.Enabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NextRootCASecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiocontrolplane
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProxyEnvsConfiguration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProxyEnvsConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PendingPeers = append(m.PendingPeers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNamespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9326,11 +13479,11 @@ func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HttpProxy = string(dAtA[iNdEx:postIndex])
+			m.PendingNamespaces = append(m.PendingNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpsProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9358,11 +13511,11 @@ func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HttpsProxy = string(dAtA[iNdEx:postIndex])
+			m.CurrentBatch = append(m.CurrentBatch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedNamespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9390,7 +13543,43 @@ func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoProxy = string(dAtA[iNdEx:postIndex])
+			m.RestartedNamespaces = append(m.RestartedNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &types.Timestamp{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9414,7 +13603,7 @@ func (m *HTTPProxyEnvsConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
+func (m *RemoteClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9437,17 +13626,17 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioControlPlaneStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoteClusterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioControlPlaneStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoteClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9457,14 +13646,27 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ConfigState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9492,11 +13694,30 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= RemoteClusterState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlaneName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9524,11 +13745,62 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IstioControlPlaneName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplane
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9556,13 +13828,13 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GatewayAddress = append(m.GatewayAddress, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstiodAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9572,59 +13844,80 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IstiodAddresses = append(m.IstiodAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InjectionNamespaces", wireType)
+			m.Gateways = append(m.Gateways, NetworkGatewayStatus{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIstiocontrolplane
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkGatewayStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InjectionNamespaces = append(m.InjectionNamespaces, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkGatewayStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkGatewayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaRootCertificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9652,13 +13945,13 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CaRootCertificate = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var stringLen uint64
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9668,29 +13961,67 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Port |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIstiocontrolplane
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusChecksums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplane
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusChecksums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusChecksums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MeshConfig", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9700,33 +14031,29 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MeshConfig == nil {
-				m.MeshConfig = &v1alpha1.MeshConfig{}
-			}
-			if err := m.MeshConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MeshConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarInjector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiocontrolplane
@@ -9736,27 +14063,23 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiocontrolplane
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Checksums == nil {
-				m.Checksums = &StatusChecksums{}
-			}
-			if err := m.Checksums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SidecarInjector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9780,7 +14103,7 @@ func (m *IstioControlPlaneStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusChecksums) Unmarshal(dAtA []byte) error {
+func (m *PlanStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9803,15 +14126,15 @@ func (m *StatusChecksums) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusChecksums: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusChecksums: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeshConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9839,11 +14162,11 @@ func (m *StatusChecksums) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeshConfig = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarInjector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9871,8 +14194,65 @@ func (m *StatusChecksums) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SidecarInjector = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creations", wireType)
+			}
+			m.Creations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			m.Updates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deletions", wireType)
+			}
+			m.Deletions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deletions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIstiocontrolplane(dAtA[iNdEx:])
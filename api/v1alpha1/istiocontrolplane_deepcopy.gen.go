@@ -334,6 +334,69 @@ func (in *CNIConfiguration_ResourceQuotas) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using NodeProxyConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *NodeProxyConfiguration) DeepCopyInto(out *NodeProxyConfiguration) {
+	p := proto.Clone(in).(*NodeProxyConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeProxyConfiguration. Required by controller-gen.
+func (in *NodeProxyConfiguration) DeepCopy() *NodeProxyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeProxyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NodeProxyConfiguration. Required by controller-gen.
+func (in *NodeProxyConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CAConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *CAConfiguration) DeepCopyInto(out *CAConfiguration) {
+	p := proto.Clone(in).(*CAConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAConfiguration. Required by controller-gen.
+func (in *CAConfiguration) DeepCopy() *CAConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CAConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CAConfiguration. Required by controller-gen.
+func (in *CAConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NetworkConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
+	p := proto.Clone(in).(*NetworkConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration. Required by controller-gen.
+func (in *NetworkConfiguration) DeepCopy() *NetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfiguration. Required by controller-gen.
+func (in *NetworkConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using IstiodConfiguration within kubernetes types, where deepcopy-gen is used.
 func (in *IstiodConfiguration) DeepCopyInto(out *IstiodConfiguration) {
 	p := proto.Clone(in).(*IstiodConfiguration)
@@ -523,6 +586,111 @@ func (in *IstioControlPlaneStatus) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using CAStatus within kubernetes types, where deepcopy-gen is used.
+func (in *CAStatus) DeepCopyInto(out *CAStatus) {
+	p := proto.Clone(in).(*CAStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAStatus. Required by controller-gen.
+func (in *CAStatus) DeepCopy() *CAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CAStatus. Required by controller-gen.
+func (in *CAStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using RootCARotationStatus within kubernetes types, where deepcopy-gen is used.
+func (in *RootCARotationStatus) DeepCopyInto(out *RootCARotationStatus) {
+	p := proto.Clone(in).(*RootCARotationStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootCARotationStatus. Required by controller-gen.
+func (in *RootCARotationStatus) DeepCopy() *RootCARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RootCARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RootCARotationStatus. Required by controller-gen.
+func (in *RootCARotationStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using RemoteClusterStatus within kubernetes types, where deepcopy-gen is used.
+func (in *RemoteClusterStatus) DeepCopyInto(out *RemoteClusterStatus) {
+	p := proto.Clone(in).(*RemoteClusterStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterStatus. Required by controller-gen.
+func (in *RemoteClusterStatus) DeepCopy() *RemoteClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterStatus. Required by controller-gen.
+func (in *RemoteClusterStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NetworkStatus within kubernetes types, where deepcopy-gen is used.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	p := proto.Clone(in).(*NetworkStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus. Required by controller-gen.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus. Required by controller-gen.
+func (in *NetworkStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using NetworkGatewayStatus within kubernetes types, where deepcopy-gen is used.
+func (in *NetworkGatewayStatus) DeepCopyInto(out *NetworkGatewayStatus) {
+	p := proto.Clone(in).(*NetworkGatewayStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkGatewayStatus. Required by controller-gen.
+func (in *NetworkGatewayStatus) DeepCopy() *NetworkGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new NetworkGatewayStatus. Required by controller-gen.
+func (in *NetworkGatewayStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using StatusChecksums within kubernetes types, where deepcopy-gen is used.
 func (in *StatusChecksums) DeepCopyInto(out *StatusChecksums) {
 	p := proto.Clone(in).(*StatusChecksums)
@@ -543,3 +711,24 @@ func (in *StatusChecksums) DeepCopy() *StatusChecksums {
 func (in *StatusChecksums) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using PlanStatus within kubernetes types, where deepcopy-gen is used.
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
+	p := proto.Clone(in).(*PlanStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus. Required by controller-gen.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus. Required by controller-gen.
+func (in *PlanStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
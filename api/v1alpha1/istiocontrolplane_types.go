@@ -28,8 +28,16 @@ import (
 
 const (
 	RevisionedAutoInjectionLabel       = "istio.io/rev"
+	RevisionTagLabel                   = "istio.io/tag"
 	DeprecatedAutoInjectionLabel       = "istio-injection"
 	NamespaceInjectionSourceAnnotation = "controlplane.istio.servicemesh.cisco.com/namespace-injection-source"
+	// RevisionedAmbientLabel opts a namespace in to have its workloads captured by the node proxies
+	// of the control plane whose namespaced revision is the value of the label
+	RevisionedAmbientLabel = "istio.io/ambient-rev"
+	// PlanModeAnnotation makes the operator publish the changes of the control plane as a plan instead of applying them
+	PlanModeAnnotation = "controlplane.istio.servicemesh.cisco.com/plan"
+	// ApprovedPlanAnnotation holds the ID of the plan which is approved to be applied
+	ApprovedPlanAnnotation = "controlplane.istio.servicemesh.cisco.com/approved-plan"
 )
 
 type SortableIstioControlPlaneItems []IstioControlPlane
@@ -67,6 +75,41 @@ func (icp *IstioControlPlane) GetStatus() IstioControlPlaneStatus {
 	return icp.Status
 }
 
+func (icp *IstioControlPlane) SetCondition(condition Condition) {
+	SetCondition(&icp.Status.Conditions, condition)
+}
+
+func (icp *IstioControlPlane) GetCondition(conditionType string) *Condition {
+	return FindCondition(icp.Status.Conditions, conditionType)
+}
+
+func (icp *IstioControlPlane) SetObservedGeneration(generation int64) {
+	icp.Status.ObservedGeneration = generation
+}
+
+func (icp *IstioControlPlane) SetComponentStatus(status ComponentStatus) {
+	SetComponentStatus(&icp.Status.Components, status)
+}
+
+func (icp *IstioControlPlane) GetComponentStatus(name string) *ComponentStatus {
+	return FindComponentStatus(icp.Status.Components, name)
+}
+
+// ComponentUnmanaged reports whether the component with the given name should be left alone by the operator
+func (icp *IstioControlPlane) ComponentUnmanaged(name string) bool {
+	return IsComponentUnmanaged(icp, name)
+}
+
+// PlanModeEnabled reports whether the changes of the control plane need to be approved before they get applied
+func (icp *IstioControlPlane) PlanModeEnabled() bool {
+	return icp.GetAnnotations()[PlanModeAnnotation] == "true"
+}
+
+// ApprovedPlan returns the ID of the plan which is approved to be applied
+func (icp *IstioControlPlane) ApprovedPlan() string {
+	return icp.GetAnnotations()[ApprovedPlanAnnotation]
+}
+
 func (icp *IstioControlPlane) GetSpec() *IstioControlPlaneSpec {
 	if icp.Spec != nil {
 		return icp.Spec
@@ -127,6 +170,16 @@ func NamespacedNameFromRevision(revision string) types.NamespacedName {
 	return nn
 }
 
+// RestartBatchSizeOrDefault returns the number of namespaces whose workloads are restarted at once
+// during a root CA rotation, which is at least one
+func (c *CAConfiguration) RestartBatchSizeOrDefault() int {
+	if c.GetRestartBatchSize() == nil || *c.GetRestartBatchSize() < 1 {
+		return 1
+	}
+
+	return int(*c.GetRestartBatchSize())
+}
+
 // +kubebuilder:object:generate=false
 type IstioControlPlaneWithProperties struct {
 	*IstioControlPlane
@@ -138,6 +191,7 @@ type IstioControlPlaneProperties struct {
 	Mesh                         *IstioMesh
 	MeshNetworks                 *v1alpha1.MeshNetworks
 	TrustedRootCACertificatePEMs []string
+	CACertificatesChecksum       string
 }
 
 func (p IstioControlPlaneProperties) GetMesh() *IstioMesh {
@@ -0,0 +1,1387 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiocontrolplaneupgrade.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type IstioControlPlaneUpgradePhase int32
+
+const (
+	IstioControlPlaneUpgradePhase_Pending     IstioControlPlaneUpgradePhase = 0
+	IstioControlPlaneUpgradePhase_Progressing IstioControlPlaneUpgradePhase = 1
+	IstioControlPlaneUpgradePhase_Paused      IstioControlPlaneUpgradePhase = 2
+	IstioControlPlaneUpgradePhase_Completed   IstioControlPlaneUpgradePhase = 3
+	IstioControlPlaneUpgradePhase_RollingBack IstioControlPlaneUpgradePhase = 4
+	IstioControlPlaneUpgradePhase_RolledBack  IstioControlPlaneUpgradePhase = 5
+)
+
+var IstioControlPlaneUpgradePhase_name = map[int32]string{
+	0: "Pending",
+	1: "Progressing",
+	2: "Paused",
+	3: "Completed",
+	4: "RollingBack",
+	5: "RolledBack",
+}
+
+var IstioControlPlaneUpgradePhase_value = map[string]int32{
+	"Pending":     0,
+	"Progressing": 1,
+	"Paused":      2,
+	"Completed":   3,
+	"RollingBack": 4,
+	"RolledBack":  5,
+}
+
+func (x IstioControlPlaneUpgradePhase) String() string {
+	return proto.EnumName(IstioControlPlaneUpgradePhase_name, int32(x))
+}
+
+func (IstioControlPlaneUpgradePhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba0edd040ce25a57, []int{0}
+}
+
+// IstioControlPlaneUpgrade moves the workloads of namespaces from a source Istio control plane to a target
+// Istio control plane in batches
+//
+// <!-- crd generation tags
+// +cue-gen:IstioControlPlaneUpgrade:groupName:servicemesh.cisco.com
+// +cue-gen:IstioControlPlaneUpgrade:version:v1alpha1
+// +cue-gen:IstioControlPlaneUpgrade:storageVersion
+// +cue-gen:IstioControlPlaneUpgrade:annotations:helm.sh/resource-policy=keep
+// +cue-gen:IstioControlPlaneUpgrade:subresource:status
+// +cue-gen:IstioControlPlaneUpgrade:scope:Namespaced
+// +cue-gen:IstioControlPlaneUpgrade:resource:shortNames=icpu,istiocpupgrade
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Source",type="string",JSONPath=".status.sourceRevision",description="Revision of the source control plane"
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Target",type="string",JSONPath=".status.targetRevision",description="Revision of the target control plane"
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Phase",type="string",JSONPath=".status.phase",description="Phase of the upgrade"
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Status",type="string",JSONPath=".status.status",description="Status of the resource"
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Error",type="string",JSONPath=".status.errorMessage",description="Error message"
+// +cue-gen:IstioControlPlaneUpgrade:printerColumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +cue-gen:IstioControlPlaneUpgrade:preserveUnknownFields:false
+// +cue-gen:IstioControlPlaneUpgrade:specIsRequired
+// -->
+//
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioControlPlaneUpgradeSpec struct {
+	// Istio control plane the namespaces are moved from
+	Source *NamespacedName `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Istio control plane the namespaces are moved to
+	Target *NamespacedName `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Namespaces to move to the target control plane,
+	// all namespaces labeled for the source control plane are moved when empty
+	Namespaces []string `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Number of namespaces moved at once, defaults to 1
+	BatchSize *int32 `protobuf:"bytes,4,opt,name=batchSize,proto3,wktptr" json:"batchSize,omitempty"`
+	// Whether to restart the workloads of the moved namespaces so that they get the proxy of the new control plane,
+	// defaults to true
+	RestartWorkloads *bool `protobuf:"bytes,5,opt,name=restartWorkloads,proto3,wktptr" json:"restartWorkloads,omitempty"`
+	// Stop moving namespaces after the batch in progress, the upgrade resumes when unset
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Move the already upgraded namespaces back to the source control plane
+	Rollback             bool     `protobuf:"varint,7,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IstioControlPlaneUpgradeSpec) Reset()         { *m = IstioControlPlaneUpgradeSpec{} }
+func (m *IstioControlPlaneUpgradeSpec) String() string { return proto.CompactTextString(m) }
+func (*IstioControlPlaneUpgradeSpec) ProtoMessage()    {}
+func (*IstioControlPlaneUpgradeSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba0edd040ce25a57, []int{0}
+}
+func (m *IstioControlPlaneUpgradeSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioControlPlaneUpgradeSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioControlPlaneUpgradeSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioControlPlaneUpgradeSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioControlPlaneUpgradeSpec.Merge(m, src)
+}
+func (m *IstioControlPlaneUpgradeSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioControlPlaneUpgradeSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioControlPlaneUpgradeSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioControlPlaneUpgradeSpec proto.InternalMessageInfo
+
+func (m *IstioControlPlaneUpgradeSpec) GetSource() *NamespacedName {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetTarget() *NamespacedName {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetBatchSize() *int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetRestartWorkloads() *bool {
+	if m != nil {
+		return m.RestartWorkloads
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *IstioControlPlaneUpgradeSpec) GetRollback() bool {
+	if m != nil {
+		return m.Rollback
+	}
+	return false
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioControlPlaneUpgradeStatus struct {
+	// Reconciliation status of the upgrade
+	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
+	// Reconciliation error message if any
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// Current phase of the upgrade
+	Phase IstioControlPlaneUpgradePhase `protobuf:"varint,3,opt,name=phase,proto3,enum=istio_operator.v2.api.v1alpha1.IstioControlPlaneUpgradePhase" json:"phase,omitempty"`
+	// Namespaced revision of the source control plane
+	SourceRevision string `protobuf:"bytes,4,opt,name=sourceRevision,proto3" json:"sourceRevision,omitempty"`
+	// Namespaced revision of the target control plane
+	TargetRevision string `protobuf:"bytes,5,opt,name=targetRevision,proto3" json:"targetRevision,omitempty"`
+	// Namespaces which are still on the source control plane
+	PendingNamespaces []string `protobuf:"bytes,6,rep,name=pendingNamespaces,proto3" json:"pendingNamespaces,omitempty"`
+	// Namespaces which are being moved, the next batch starts when their workloads are ready
+	CurrentBatch []string `protobuf:"bytes,7,rep,name=currentBatch,proto3" json:"currentBatch,omitempty"`
+	// Namespaces which are already on the target control plane
+	UpgradedNamespaces []string `protobuf:"bytes,8,rep,name=upgradedNamespaces,proto3" json:"upgradedNamespaces,omitempty"`
+	// Latest available observations of the state of the upgrade
+	Conditions []Condition `protobuf:"bytes,9,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the upgrade which was last reconciled
+	ObservedGeneration   int64    `protobuf:"varint,10,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IstioControlPlaneUpgradeStatus) Reset()         { *m = IstioControlPlaneUpgradeStatus{} }
+func (m *IstioControlPlaneUpgradeStatus) String() string { return proto.CompactTextString(m) }
+func (*IstioControlPlaneUpgradeStatus) ProtoMessage()    {}
+func (*IstioControlPlaneUpgradeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba0edd040ce25a57, []int{1}
+}
+func (m *IstioControlPlaneUpgradeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioControlPlaneUpgradeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioControlPlaneUpgradeStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioControlPlaneUpgradeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioControlPlaneUpgradeStatus.Merge(m, src)
+}
+func (m *IstioControlPlaneUpgradeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioControlPlaneUpgradeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioControlPlaneUpgradeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioControlPlaneUpgradeStatus proto.InternalMessageInfo
+
+func (m *IstioControlPlaneUpgradeStatus) GetStatus() ConfigState {
+	if m != nil {
+		return m.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetPhase() IstioControlPlaneUpgradePhase {
+	if m != nil {
+		return m.Phase
+	}
+	return IstioControlPlaneUpgradePhase_Pending
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetSourceRevision() string {
+	if m != nil {
+		return m.SourceRevision
+	}
+	return ""
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetPendingNamespaces() []string {
+	if m != nil {
+		return m.PendingNamespaces
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetCurrentBatch() []string {
+	if m != nil {
+		return m.CurrentBatch
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetUpgradedNamespaces() []string {
+	if m != nil {
+		return m.UpgradedNamespaces
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.IstioControlPlaneUpgradePhase", IstioControlPlaneUpgradePhase_name, IstioControlPlaneUpgradePhase_value)
+	proto.RegisterType((*IstioControlPlaneUpgradeSpec)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneUpgradeSpec")
+	proto.RegisterType((*IstioControlPlaneUpgradeStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioControlPlaneUpgradeStatus")
+}
+
+func init() {
+	proto.RegisterFile("api/v1alpha1/istiocontrolplaneupgrade.proto", fileDescriptor_ba0edd040ce25a57)
+}
+
+var fileDescriptor_ba0edd040ce25a57 = []byte{
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x8e, 0xe2, 0x9f, 0xc4, 0xf4, 0x96, 0x79, 0xc4, 0x30, 0x68, 0xde, 0xe6, 0x18, 0xb9, 0x18,
+	0xbc, 0x65, 0x95, 0x10, 0x07, 0xbd, 0x2c, 0x8a, 0xda, 0x17, 0x45, 0x80, 0x34, 0x35, 0x14, 0xb4,
+	0x05, 0x7a, 0x13, 0x50, 0xd2, 0x89, 0x4c, 0x44, 0xe6, 0x21, 0x48, 0xca, 0x45, 0xf3, 0x2e, 0x05,
+	0xfa, 0x0a, 0x7d, 0x8b, 0x5c, 0xf6, 0x09, 0xda, 0xc2, 0x4f, 0x52, 0x90, 0xb2, 0x13, 0xbb, 0xf9,
+	0x2b, 0xd0, 0x3b, 0xf2, 0x3b, 0xdf, 0xf7, 0x9d, 0xc3, 0x73, 0x8e, 0x44, 0x76, 0x99, 0xe4, 0xe1,
+	0x74, 0x8f, 0xe5, 0x72, 0xcc, 0xf6, 0x42, 0xae, 0x0d, 0xc7, 0x04, 0x85, 0x51, 0x98, 0xcb, 0x9c,
+	0x09, 0x28, 0x64, 0xa6, 0x58, 0x0a, 0x81, 0x54, 0x68, 0x90, 0x76, 0x5c, 0xfc, 0x04, 0x25, 0x28,
+	0x66, 0x50, 0x05, 0xd3, 0x7e, 0xc0, 0x24, 0x0f, 0x16, 0xf2, 0x76, 0x27, 0x43, 0xcc, 0x72, 0x08,
+	0x1d, 0x3b, 0x2e, 0x4e, 0xc3, 0x37, 0x8a, 0x49, 0x09, 0x4a, 0x97, 0xfa, 0xf6, 0x1f, 0x2b, 0xc9,
+	0x12, 0x9c, 0x4c, 0x50, 0xcc, 0x43, 0xbf, 0x65, 0x98, 0xa1, 0x3b, 0x86, 0xf6, 0x34, 0x47, 0xb7,
+	0xe7, 0x86, 0x56, 0x77, 0xca, 0x21, 0x4f, 0x4f, 0x62, 0x18, 0xb3, 0x29, 0x47, 0x55, 0x12, 0x76,
+	0xde, 0x55, 0xc8, 0x5f, 0x07, 0xb6, 0xa8, 0x61, 0x59, 0xf4, 0xc8, 0x16, 0xfd, 0xa2, 0x2c, 0xfa,
+	0x58, 0x42, 0x42, 0x0f, 0x49, 0x5d, 0x63, 0xa1, 0x12, 0xf0, 0xbd, 0xae, 0xd7, 0x6b, 0xf6, 0x83,
+	0xe0, 0xee, 0x37, 0x04, 0x47, 0x6c, 0x02, 0x5a, 0xb2, 0x04, 0x52, 0x7b, 0x1a, 0x54, 0x67, 0x4f,
+	0xbc, 0xf5, 0x68, 0xee, 0x61, 0xdd, 0x0c, 0x53, 0x19, 0x18, 0x7f, 0xfd, 0x47, 0xdc, 0x4a, 0x0f,
+	0xda, 0x21, 0x44, 0x2c, 0xe2, 0xda, 0xaf, 0x74, 0x2b, 0xbd, 0x46, 0xb4, 0x84, 0xd0, 0xc7, 0xa4,
+	0x11, 0x33, 0x93, 0x8c, 0x8f, 0xf9, 0x39, 0xf8, 0x55, 0x97, 0xf0, 0xcf, 0xa0, 0xec, 0x48, 0xb0,
+	0x68, 0x71, 0x70, 0x20, 0xcc, 0x7e, 0xff, 0x25, 0xcb, 0x0b, 0x18, 0x54, 0xdf, 0x7f, 0xde, 0xf6,
+	0xa2, 0x2b, 0x0d, 0x3d, 0x24, 0x2d, 0x05, 0xda, 0x30, 0x65, 0x5e, 0xa1, 0x3a, 0xcb, 0x91, 0xa5,
+	0xda, 0xaf, 0x39, 0x9f, 0xf6, 0x35, 0x9f, 0x01, 0x62, 0xbe, 0x6c, 0x73, 0x4d, 0x49, 0x7f, 0x27,
+	0x75, 0xc9, 0x0a, 0x0d, 0xa9, 0x5f, 0xef, 0x7a, 0xbd, 0xcd, 0x68, 0x7e, 0xa3, 0x6d, 0xb2, 0xa9,
+	0x30, 0xcf, 0x63, 0x96, 0x9c, 0xf9, 0x1b, 0x2e, 0x72, 0x79, 0xdf, 0xf9, 0x50, 0x25, 0x9d, 0x5b,
+	0xe7, 0x63, 0x98, 0x29, 0x34, 0x1d, 0x92, 0xba, 0x76, 0x27, 0x37, 0xa1, 0xad, 0xfe, 0xee, 0x7d,
+	0x3d, 0x1d, 0xa2, 0x38, 0xe5, 0x99, 0x55, 0x43, 0x34, 0x97, 0xd2, 0x1d, 0xf2, 0x13, 0x28, 0x85,
+	0xea, 0x19, 0x68, 0xcd, 0x32, 0x70, 0xe3, 0x69, 0x44, 0x2b, 0x18, 0x3d, 0x26, 0x35, 0x39, 0x66,
+	0x1a, 0xfc, 0x8a, 0xcb, 0xf3, 0xe8, 0xbe, 0x3c, 0xb7, 0xd5, 0x3d, 0xb2, 0x26, 0x51, 0xe9, 0x45,
+	0xff, 0x21, 0x5b, 0xe5, 0x6e, 0x44, 0x30, 0xe5, 0x9a, 0xa3, 0x70, 0x83, 0x6a, 0x44, 0xdf, 0xa0,
+	0x96, 0x57, 0x4e, 0xfd, 0x92, 0x57, 0x2b, 0x79, 0xab, 0x28, 0xfd, 0x9f, 0xfc, 0x2a, 0x41, 0xa4,
+	0x5c, 0x64, 0x47, 0x57, 0xab, 0x51, 0x77, 0xab, 0x71, 0x3d, 0x60, 0x9f, 0x9d, 0x14, 0x4a, 0x81,
+	0x30, 0x03, 0x3b, 0x74, 0x7f, 0xc3, 0x11, 0x57, 0x30, 0x1a, 0x10, 0x3a, 0xff, 0x8a, 0xd3, 0x25,
+	0xcb, 0x4d, 0xc7, 0xbc, 0x21, 0x42, 0x9f, 0x13, 0x92, 0xa0, 0x48, 0xb9, 0xe1, 0x28, 0xb4, 0xdf,
+	0xe8, 0x56, 0x7a, 0xcd, 0xfe, 0xbf, 0xdf, 0x31, 0x93, 0x52, 0x31, 0xa8, 0x5e, 0x7c, 0xda, 0x5e,
+	0x8b, 0x96, 0x2c, 0x6c, 0x01, 0x18, 0x6b, 0x50, 0x53, 0x48, 0x9f, 0x82, 0xb0, 0x06, 0xf6, 0xf9,
+	0xa4, 0xeb, 0xf5, 0x2a, 0xd1, 0x0d, 0x91, 0xff, 0xde, 0x92, 0xbf, 0xef, 0x6c, 0x3d, 0x6d, 0x92,
+	0x8d, 0x51, 0xd9, 0x8a, 0xd6, 0x1a, 0xfd, 0x85, 0x34, 0x47, 0x0a, 0x33, 0x05, 0x5a, 0x5b, 0xc0,
+	0xa3, 0x84, 0xd4, 0x47, 0x6e, 0x31, 0x5b, 0xeb, 0xf4, 0x67, 0xd2, 0x18, 0xe2, 0x44, 0xe6, 0x60,
+	0x20, 0x6d, 0x55, 0x2c, 0x37, 0xc2, 0x3c, 0xe7, 0x22, 0x1b, 0xb0, 0xe4, 0xac, 0x55, 0xa5, 0x5b,
+	0x84, 0x58, 0x00, 0x52, 0x77, 0xaf, 0x0d, 0x86, 0x17, 0xb3, 0x8e, 0xf7, 0x71, 0xd6, 0xf1, 0xbe,
+	0xcc, 0x3a, 0xde, 0xeb, 0x87, 0x19, 0x37, 0xe3, 0x22, 0x0e, 0x12, 0x9c, 0x84, 0x31, 0x13, 0xe7,
+	0x8c, 0x27, 0x39, 0x16, 0x69, 0xf9, 0x97, 0x7c, 0xb0, 0xe8, 0x45, 0x38, 0xed, 0x87, 0xcb, 0xff,
+	0xb5, 0xb8, 0xee, 0xbe, 0xa9, 0xfd, 0xaf, 0x03, 0x00, 0x88, 0xbd, 0x8b, 0xe9, 0x5b, 0x05, 0x00,
+	0x00,
+}
+
+func (m *IstioControlPlaneUpgradeSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioControlPlaneUpgradeSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioControlPlaneUpgradeSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rollback {
+		i--
+		if m.Rollback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RestartWorkloads != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.RestartWorkloads, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.RestartWorkloads):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BatchSize != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdInt32MarshalTo(*m.BatchSize, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdInt32(*m.BatchSize):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioControlPlaneUpgradeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioControlPlaneUpgradeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UpgradedNamespaces) > 0 {
+		for iNdEx := len(m.UpgradedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpgradedNamespaces[iNdEx])
+			copy(dAtA[i:], m.UpgradedNamespaces[iNdEx])
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.UpgradedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CurrentBatch) > 0 {
+		for iNdEx := len(m.CurrentBatch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentBatch[iNdEx])
+			copy(dAtA[i:], m.CurrentBatch[iNdEx])
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.CurrentBatch[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingNamespaces) > 0 {
+		for iNdEx := len(m.PendingNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingNamespaces[iNdEx])
+			copy(dAtA[i:], m.PendingNamespaces[iNdEx])
+			i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.PendingNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TargetRevision) > 0 {
+		i -= len(m.TargetRevision)
+		copy(dAtA[i:], m.TargetRevision)
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.TargetRevision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceRevision) > 0 {
+		i -= len(m.SourceRevision)
+		copy(dAtA[i:], m.SourceRevision)
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.SourceRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Phase != 0 {
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintIstiocontrolplaneupgrade(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIstiocontrolplaneupgrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIstiocontrolplaneupgrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IstioControlPlaneUpgradeSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+		}
+	}
+	if m.BatchSize != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdInt32(*m.BatchSize)
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if m.RestartWorkloads != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.RestartWorkloads)
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Rollback {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstioControlPlaneUpgradeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovIstiocontrolplaneupgrade(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovIstiocontrolplaneupgrade(uint64(m.Phase))
+	}
+	l = len(m.SourceRevision)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	if l > 0 {
+		n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+	}
+	if len(m.PendingNamespaces) > 0 {
+		for _, s := range m.PendingNamespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+		}
+	}
+	if len(m.CurrentBatch) > 0 {
+		for _, s := range m.CurrentBatch {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+		}
+	}
+	if len(m.UpgradedNamespaces) > 0 {
+		for _, s := range m.UpgradedNamespaces {
+			l = len(s)
+			n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovIstiocontrolplaneupgrade(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovIstiocontrolplaneupgrade(uint64(m.ObservedGeneration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovIstiocontrolplaneupgrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIstiocontrolplaneupgrade(x uint64) (n int) {
+	return sovIstiocontrolplaneupgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IstioControlPlaneUpgradeSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplaneupgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioControlPlaneUpgradeSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioControlPlaneUpgradeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &NamespacedName{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &NamespacedName{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchSize == nil {
+				m.BatchSize = new(int32)
+			}
+			if err := github_com_gogo_protobuf_types.StdInt32Unmarshal(m.BatchSize, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartWorkloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartWorkloads == nil {
+				m.RestartWorkloads = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.RestartWorkloads, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplaneupgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioControlPlaneUpgradeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiocontrolplaneupgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioControlPlaneUpgradeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioControlPlaneUpgradeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= IstioControlPlaneUpgradePhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNamespaces = append(m.PendingNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBatch = append(m.CurrentBatch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradedNamespaces = append(m.UpgradedNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiocontrolplaneupgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIstiocontrolplaneupgrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIstiocontrolplaneupgrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIstiocontrolplaneupgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIstiocontrolplaneupgrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIstiocontrolplaneupgrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIstiocontrolplaneupgrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIstiocontrolplaneupgrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIstiocontrolplaneupgrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIstiocontrolplaneupgrade = fmt.Errorf("proto: unexpected end of group")
+)
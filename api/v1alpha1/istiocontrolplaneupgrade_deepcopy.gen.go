@@ -0,0 +1,60 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiocontrolplaneupgrade.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// DeepCopyInto supports using IstioControlPlaneUpgradeSpec within kubernetes types, where deepcopy-gen is used.
+func (in *IstioControlPlaneUpgradeSpec) DeepCopyInto(out *IstioControlPlaneUpgradeSpec) {
+	p := proto.Clone(in).(*IstioControlPlaneUpgradeSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneUpgradeSpec. Required by controller-gen.
+func (in *IstioControlPlaneUpgradeSpec) DeepCopy() *IstioControlPlaneUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioControlPlaneUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneUpgradeSpec. Required by controller-gen.
+func (in *IstioControlPlaneUpgradeSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using IstioControlPlaneUpgradeStatus within kubernetes types, where deepcopy-gen is used.
+func (in *IstioControlPlaneUpgradeStatus) DeepCopyInto(out *IstioControlPlaneUpgradeStatus) {
+	p := proto.Clone(in).(*IstioControlPlaneUpgradeStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneUpgradeStatus. Required by controller-gen.
+func (in *IstioControlPlaneUpgradeStatus) DeepCopy() *IstioControlPlaneUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioControlPlaneUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneUpgradeStatus. Required by controller-gen.
+func (in *IstioControlPlaneUpgradeStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
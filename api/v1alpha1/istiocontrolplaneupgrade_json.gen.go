@@ -0,0 +1,47 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiocontrolplaneupgrade.proto
+
+package v1alpha1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// MarshalJSON is a custom marshaler for IstioControlPlaneUpgradeSpec
+func (this *IstioControlPlaneUpgradeSpec) MarshalJSON() ([]byte, error) {
+	str, err := IstiocontrolplaneupgradeMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioControlPlaneUpgradeSpec
+func (this *IstioControlPlaneUpgradeSpec) UnmarshalJSON(b []byte) error {
+	return IstiocontrolplaneupgradeUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for IstioControlPlaneUpgradeStatus
+func (this *IstioControlPlaneUpgradeStatus) MarshalJSON() ([]byte, error) {
+	str, err := IstiocontrolplaneupgradeMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioControlPlaneUpgradeStatus
+func (this *IstioControlPlaneUpgradeStatus) UnmarshalJSON(b []byte) error {
+	return IstiocontrolplaneupgradeUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+var (
+	IstiocontrolplaneupgradeMarshaler   = &github_com_gogo_protobuf_jsonpb.Marshaler{Int64Uint64asIntegers: true}
+	IstiocontrolplaneupgradeUnmarshaler = &github_com_gogo_protobuf_jsonpb.Unmarshaler{AllowUnknownFields: true}
+)
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// IstioControlPlaneUpgrade is the Schema for the istiocontrolplaneupgrades API
+// +kubebuilder:resource:path=istiocontrolplaneupgrades,shortName=icpu;istiocpupgrade
+type IstioControlPlaneUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   *IstioControlPlaneUpgradeSpec  `json:"spec,omitempty"`
+	Status IstioControlPlaneUpgradeStatus `json:"status,omitempty"`
+}
+
+func (u *IstioControlPlaneUpgrade) SetStatus(status ConfigState, errorMessage string) {
+	u.Status.Status = status
+	u.Status.ErrorMessage = errorMessage
+}
+
+func (u *IstioControlPlaneUpgrade) GetStatus() IstioControlPlaneUpgradeStatus {
+	return u.Status
+}
+
+func (u *IstioControlPlaneUpgrade) SetCondition(condition Condition) {
+	SetCondition(&u.Status.Conditions, condition)
+}
+
+func (u *IstioControlPlaneUpgrade) GetCondition(conditionType string) *Condition {
+	return FindCondition(u.Status.Conditions, conditionType)
+}
+
+func (u *IstioControlPlaneUpgrade) SetObservedGeneration(generation int64) {
+	u.Status.ObservedGeneration = generation
+}
+
+func (u *IstioControlPlaneUpgrade) GetSpec() *IstioControlPlaneUpgradeSpec {
+	if u.Spec != nil {
+		return u.Spec
+	}
+
+	return nil
+}
+
+// BatchSizeOrDefault returns the number of namespaces to move at once, which is at least one
+func (s *IstioControlPlaneUpgradeSpec) BatchSizeOrDefault() int {
+	if s.GetBatchSize() == nil || *s.GetBatchSize() < 1 {
+		return 1
+	}
+
+	return int(*s.GetBatchSize())
+}
+
+// ShouldRestartWorkloads returns whether the workloads of the moved namespaces need to be restarted
+func (s *IstioControlPlaneUpgradeSpec) ShouldRestartWorkloads() bool {
+	if s.GetRestartWorkloads() == nil {
+		return true
+	}
+
+	return *s.GetRestartWorkloads()
+}
+
+// +kubebuilder:object:root=true
+
+// IstioControlPlaneUpgradeList contains a list of IstioControlPlaneUpgrade
+type IstioControlPlaneUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IstioControlPlaneUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IstioControlPlaneUpgrade{}, &IstioControlPlaneUpgradeList{})
+}
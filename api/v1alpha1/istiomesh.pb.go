@@ -100,7 +100,11 @@ type IstioMeshStatus struct {
 	// Reconciliation status of the Istio mesh
 	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
 	// Reconciliation error message if any
-	ErrorMessage         string   `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// Latest available observations of the state of the Istio mesh
+	Conditions []Condition `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the Istio mesh which was last reconciled
+	ObservedGeneration   int64    `protobuf:"varint,4,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -153,6 +157,20 @@ func (m *IstioMeshStatus) GetErrorMessage() string {
 	return ""
 }
 
+func (m *IstioMeshStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *IstioMeshStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IstioMeshSpec)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshSpec")
 	proto.RegisterType((*IstioMeshStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshStatus")
@@ -161,28 +179,31 @@ func init() {
 func init() { proto.RegisterFile("api/v1alpha1/istiomesh.proto", fileDescriptor_3b190aa132b1cfc9) }
 
 var fileDescriptor_3b190aa132b1cfc9 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3d, 0x6b, 0xf3, 0x30,
-	0x10, 0xc7, 0xf1, 0x33, 0x04, 0x1e, 0xf5, 0x0d, 0x4c, 0x87, 0x34, 0x14, 0x27, 0x78, 0x0a, 0x94,
-	0x4a, 0xc4, 0xa5, 0xb4, 0x73, 0xb3, 0xb4, 0x43, 0x96, 0x74, 0xeb, 0x12, 0x64, 0xe7, 0x22, 0x8b,
-	0xda, 0x3e, 0x21, 0xc9, 0x2e, 0xe4, 0x13, 0x76, 0xec, 0x47, 0x28, 0xf9, 0x24, 0x45, 0xb2, 0x42,
-	0x93, 0xa5, 0xdb, 0x71, 0xf7, 0xbb, 0xff, 0xff, 0x5e, 0xc8, 0x35, 0x57, 0x92, 0x75, 0x33, 0x5e,
-	0xa9, 0x92, 0xcf, 0x98, 0x34, 0x56, 0x62, 0x0d, 0xa6, 0xa4, 0x4a, 0xa3, 0xc5, 0x38, 0xf1, 0x89,
-	0x15, 0x2a, 0xd0, 0xdc, 0xa2, 0xa6, 0x5d, 0x46, 0xb9, 0x92, 0x74, 0xcf, 0x8f, 0x12, 0x81, 0x28,
-	0x2a, 0x60, 0x9e, 0xce, 0xdb, 0x0d, 0xfb, 0xd0, 0x5c, 0x29, 0xd0, 0xa6, 0xef, 0x1f, 0x5d, 0x1d,
-	0xa9, 0x17, 0x58, 0xd7, 0xd8, 0x84, 0xd2, 0xc8, 0xd9, 0x1c, 0xd6, 0x9a, 0x8d, 0x14, 0xa1, 0x76,
-	0x29, 0x50, 0xa0, 0x0f, 0x99, 0x8b, 0x42, 0x76, 0x1c, 0xcc, 0x9c, 0xe6, 0x46, 0x42, 0xb5, 0x5e,
-	0xe5, 0x50, 0xf2, 0x4e, 0xa2, 0x0e, 0x40, 0xfa, 0xfe, 0x68, 0xa8, 0x44, 0x0f, 0x14, 0xa8, 0x81,
-	0x75, 0x33, 0x26, 0xa0, 0x71, 0xb3, 0xc3, 0xba, 0x67, 0xd2, 0x67, 0x72, 0xf6, 0xe2, 0x76, 0x5a,
-	0x80, 0x29, 0x5f, 0x15, 0x14, 0xf1, 0x03, 0x19, 0xf4, 0xde, 0xc3, 0x68, 0x12, 0x4d, 0x4f, 0xb2,
-	0x31, 0xf5, 0x3b, 0x53, 0x7f, 0x85, 0xfd, 0x78, 0xd4, 0xe1, 0x73, 0x8f, 0x2d, 0x03, 0x9e, 0x6e,
-	0xc9, 0xc5, 0xaf, 0x92, 0xe5, 0xb6, 0x35, 0xf1, 0x9c, 0x0c, 0x8c, 0x8f, 0xbc, 0xd6, 0x79, 0x76,
-	0x43, 0xff, 0xbe, 0x1f, 0xed, 0x25, 0x5d, 0x37, 0x2c, 0x43, 0x6b, 0x9c, 0x92, 0x53, 0xd0, 0x1a,
-	0xf5, 0x02, 0x8c, 0xe1, 0x02, 0x86, 0xff, 0x26, 0xd1, 0xf4, 0xff, 0xf2, 0x28, 0xf7, 0x34, 0xff,
-	0xdc, 0x25, 0xd1, 0xd7, 0x2e, 0x89, 0xbe, 0x77, 0x49, 0xf4, 0x76, 0x2f, 0xa4, 0x2d, 0xdb, 0x9c,
-	0x16, 0x58, 0xb3, 0x9c, 0x37, 0x5b, 0x2e, 0x8b, 0x0a, 0xdb, 0x75, 0xff, 0xcd, 0xdb, 0xbd, 0x39,
-	0xeb, 0x32, 0x76, 0xf8, 0x8e, 0x7c, 0xe0, 0x2f, 0x72, 0xf7, 0x13, 0x00, 0x00, 0xff, 0xff, 0x91,
-	0x00, 0xa0, 0x78, 0x03, 0x02, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xea, 0xd3, 0x40,
+	0x10, 0xc7, 0x5d, 0x5b, 0x0a, 0x6e, 0xfd, 0x03, 0xc1, 0x43, 0x2c, 0x92, 0x86, 0x9c, 0x22, 0xe2,
+	0x2e, 0x8d, 0x88, 0x9e, 0xdb, 0x83, 0x7a, 0x28, 0x42, 0xbc, 0x79, 0x29, 0x9b, 0x64, 0xba, 0x59,
+	0x4c, 0x32, 0xcb, 0x6e, 0x12, 0xc1, 0x27, 0xec, 0xd1, 0x27, 0x10, 0xe9, 0x6b, 0x78, 0x91, 0x6c,
+	0x52, 0x6c, 0x41, 0xe4, 0x77, 0x1b, 0x66, 0x3e, 0xf3, 0xfd, 0xce, 0xce, 0x0e, 0x7d, 0x2e, 0xb4,
+	0xe2, 0xfd, 0x46, 0x54, 0xba, 0x14, 0x1b, 0xae, 0x6c, 0xab, 0xb0, 0x06, 0x5b, 0x32, 0x6d, 0xb0,
+	0x45, 0x2f, 0x70, 0x89, 0x03, 0x6a, 0x30, 0xa2, 0x45, 0xc3, 0xfa, 0x84, 0x09, 0xad, 0xd8, 0x85,
+	0x5f, 0x05, 0x12, 0x51, 0x56, 0xc0, 0x1d, 0x9d, 0x75, 0x47, 0xfe, 0xcd, 0x08, 0xad, 0xc1, 0xd8,
+	0xb1, 0x7f, 0xf5, 0xec, 0x46, 0x3d, 0xc7, 0xba, 0xc6, 0x66, 0x2a, 0xad, 0x06, 0x9b, 0xeb, 0x5a,
+	0x73, 0x54, 0x72, 0xaa, 0x3d, 0x95, 0x28, 0xd1, 0x85, 0x7c, 0x88, 0xa6, 0xec, 0x7a, 0x32, 0x1b,
+	0x34, 0x8f, 0x0a, 0xaa, 0xe2, 0x90, 0x41, 0x29, 0x7a, 0x85, 0x66, 0x02, 0xa2, 0xaf, 0xef, 0x2c,
+	0x53, 0xe8, 0x80, 0x1c, 0x0d, 0xf0, 0x7e, 0xc3, 0x25, 0x34, 0xc3, 0xec, 0x50, 0x8c, 0x4c, 0xf4,
+	0x81, 0x3e, 0xfa, 0x38, 0xbc, 0x69, 0x0f, 0xb6, 0xfc, 0xac, 0x21, 0xf7, 0xde, 0xd2, 0xc5, 0xe8,
+	0xed, 0x93, 0x90, 0xc4, 0xcb, 0x64, 0xcd, 0xdc, 0x9b, 0x99, 0xdb, 0xc2, 0x65, 0x3c, 0x36, 0xe0,
+	0x3b, 0x87, 0xa5, 0x13, 0x1e, 0xfd, 0x26, 0xf4, 0xc9, 0x5f, 0xa9, 0x56, 0xb4, 0x9d, 0xf5, 0x76,
+	0x74, 0x61, 0x5d, 0xe4, 0xc4, 0x1e, 0x27, 0x2f, 0xd9, 0xff, 0x17, 0xc8, 0x46, 0xcd, 0xa1, 0x1b,
+	0xd2, 0xa9, 0xd5, 0x8b, 0xe8, 0x43, 0x30, 0x06, 0xcd, 0x1e, 0xac, 0x15, 0x12, 0xfc, 0xfb, 0x21,
+	0x89, 0x1f, 0xa4, 0x37, 0x39, 0xef, 0x13, 0xa5, 0x39, 0x36, 0x85, 0x6a, 0x15, 0x36, 0xd6, 0x9f,
+	0x85, 0xb3, 0x78, 0x99, 0xbc, 0xb8, 0x83, 0xd9, 0xd8, 0xb1, 0x9d, 0x9f, 0x7e, 0xae, 0xef, 0xa5,
+	0x57, 0x12, 0x1e, 0xa3, 0x1e, 0x66, 0x16, 0x4c, 0x0f, 0xc5, 0xfb, 0x71, 0x65, 0x0a, 0x1b, 0x7f,
+	0x1e, 0x92, 0x78, 0x96, 0xfe, 0xa3, 0xb2, 0xdd, 0x9d, 0xce, 0x01, 0xf9, 0x71, 0x0e, 0xc8, 0xaf,
+	0x73, 0x40, 0xbe, 0xbc, 0x91, 0xaa, 0x2d, 0xbb, 0x8c, 0xe5, 0x58, 0xf3, 0x4c, 0x34, 0xdf, 0x85,
+	0xca, 0x2b, 0xec, 0x8a, 0xf1, 0x9e, 0x5e, 0x5d, 0x06, 0xe2, 0x7d, 0xc2, 0xaf, 0x0f, 0x22, 0x5b,
+	0xb8, 0x3f, 0x79, 0xfd, 0x67, 0x00, 0x89, 0x63, 0xdb, 0x89, 0x85, 0x02, 0x00, 0x00,
 }
 
 func (m *IstioMeshSpec) Marshal() (dAtA []byte, err error) {
@@ -248,6 +269,25 @@ func (m *IstioMeshStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintIstiomesh(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiomesh(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ErrorMessage) > 0 {
 		i -= len(m.ErrorMessage)
 		copy(dAtA[i:], m.ErrorMessage)
@@ -303,6 +343,15 @@ func (m *IstioMeshStatus) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovIstiomesh(uint64(l))
 	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovIstiomesh(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovIstiomesh(uint64(m.ObservedGeneration))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -482,6 +531,59 @@ func (m *IstioMeshStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomesh
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomesh
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomesh
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomesh
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIstiomesh(dAtA[iNdEx:])
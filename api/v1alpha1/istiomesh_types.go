@@ -40,6 +40,18 @@ func (m *IstioMesh) GetStatus() IstioMeshStatus {
 	return m.Status
 }
 
+func (m *IstioMesh) SetCondition(condition Condition) {
+	SetCondition(&m.Status.Conditions, condition)
+}
+
+func (m *IstioMesh) GetCondition(conditionType string) *Condition {
+	return FindCondition(m.Status.Conditions, conditionType)
+}
+
+func (m *IstioMesh) SetObservedGeneration(generation int64) {
+	m.Status.ObservedGeneration = generation
+}
+
 func (m *IstioMesh) GetSpec() *IstioMeshSpec {
 	if m.Spec != nil {
 		return m.Spec
@@ -7,8 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	io "io"
 	_ "istio.io/gogo-genproto/googleapis/google/api"
 	_ "k8s.io/api/core/v1"
@@ -55,6 +55,41 @@ func (GatewayType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b6c92d5e9af32c16, []int{0}
 }
 
+type AddressResolution int32
+
+const (
+	// The hostname is reported as is
+	AddressResolution_Hostname AddressResolution = 0
+	// The hostname is resolved to its IPv4 addresses
+	AddressResolution_IPv4 AddressResolution = 1
+	// The hostname is resolved to its IPv6 addresses
+	AddressResolution_IPv6 AddressResolution = 2
+	// The hostname is resolved to both its IPv4 and IPv6 addresses
+	AddressResolution_DualStack AddressResolution = 3
+)
+
+var AddressResolution_name = map[int32]string{
+	0: "Hostname",
+	1: "IPv4",
+	2: "IPv6",
+	3: "DualStack",
+}
+
+var AddressResolution_value = map[string]int32{
+	"Hostname":  0,
+	"IPv4":      1,
+	"IPv6":      2,
+	"DualStack": 3,
+}
+
+func (x AddressResolution) String() string {
+	return proto.EnumName(AddressResolution_name, int32(x))
+}
+
+func (AddressResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{1}
+}
+
 // IstioMeshGateway defines an Istio ingress or egress gateway
 //
 // <!-- crd generation tags
@@ -93,10 +128,18 @@ type IstioMeshGatewaySpec struct {
 	// Istio CR to which this gateway belongs to
 	IstioControlPlane *NamespacedName `protobuf:"bytes,5,opt,name=istioControlPlane,proto3" json:"istioControlPlane,omitempty"`
 	// K8s resource overlay patches
-	K8SResourceOverlays  []*K8SResourceOverlayPatch `protobuf:"bytes,6,rep,name=k8sResourceOverlays,proto3" json:"k8sResourceOverlays,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	K8SResourceOverlays []*K8SResourceOverlayPatch `protobuf:"bytes,6,rep,name=k8sResourceOverlays,proto3" json:"k8sResourceOverlays,omitempty"`
+	// External hosts reachable through the gateway, only used by egress gateways
+	Egress *EgressConfiguration `protobuf:"bytes,7,opt,name=egress,proto3" json:"egress,omitempty"`
+	// TLS certificate of the gateway, requested from cert-manager or issued by the CA of the operator
+	Tls *IstioMeshGatewayTLS `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	// How the hostname of the load balancer of the gateway is reported in the gateway address, the hostname
+	// is kept as is by default since Istio resolves the DNS names of the gateways itself
+	// +kubebuilder:validation:Enum=Hostname;IPv4;IPv6;DualStack
+	AddressResolution    AddressResolution `protobuf:"varint,9,opt,name=addressResolution,proto3,enum=istio_operator.v2.api.v1alpha1.AddressResolution" json:"addressResolution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *IstioMeshGatewaySpec) Reset()         { *m = IstioMeshGatewaySpec{} }
@@ -174,6 +217,347 @@ func (m *IstioMeshGatewaySpec) GetK8SResourceOverlays() []*K8SResourceOverlayPat
 	return nil
 }
 
+func (m *IstioMeshGatewaySpec) GetEgress() *EgressConfiguration {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+func (m *IstioMeshGatewaySpec) GetTls() *IstioMeshGatewayTLS {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+func (m *IstioMeshGatewaySpec) GetAddressResolution() AddressResolution {
+	if m != nil {
+		return m.AddressResolution
+	}
+	return AddressResolution_Hostname
+}
+
+// EgressConfiguration defines the external hosts the traffic of the mesh is routed to through an egress gateway
+type EgressConfiguration struct {
+	// External hosts which are allowed through the gateway
+	Hosts                []*EgressHost `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EgressConfiguration) Reset()         { *m = EgressConfiguration{} }
+func (m *EgressConfiguration) String() string { return proto.CompactTextString(m) }
+func (*EgressConfiguration) ProtoMessage()    {}
+func (*EgressConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{1}
+}
+func (m *EgressConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EgressConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressConfiguration.Merge(m, src)
+}
+func (m *EgressConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressConfiguration proto.InternalMessageInfo
+
+func (m *EgressConfiguration) GetHosts() []*EgressHost {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+type EgressHost struct {
+	// DNS name of the external host
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Ports of the external host which are allowed through the gateway
+	// +kubebuilder:validation:MinItems=1
+	Ports                []*EgressPort `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EgressHost) Reset()         { *m = EgressHost{} }
+func (m *EgressHost) String() string { return proto.CompactTextString(m) }
+func (*EgressHost) ProtoMessage()    {}
+func (*EgressHost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{2}
+}
+func (m *EgressHost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressHost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressHost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EgressHost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressHost.Merge(m, src)
+}
+func (m *EgressHost) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressHost) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressHost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressHost proto.InternalMessageInfo
+
+func (m *EgressHost) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *EgressHost) GetPorts() []*EgressPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type EgressPort struct {
+	// Port number of the external host, the service of the gateway must expose the same port
+	Number uint32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Protocol of the port, HTTPS and TLS traffic is passed through the gateway without termination
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TLS;TCP
+	Protocol             string   `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EgressPort) Reset()         { *m = EgressPort{} }
+func (m *EgressPort) String() string { return proto.CompactTextString(m) }
+func (*EgressPort) ProtoMessage()    {}
+func (*EgressPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{3}
+}
+func (m *EgressPort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EgressPort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EgressPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressPort.Merge(m, src)
+}
+func (m *EgressPort) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressPort proto.InternalMessageInfo
+
+func (m *EgressPort) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *EgressPort) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+// IstioMeshGatewayTLS defines the certificate of the gateway, which is stored in a secret the gateway reads through SDS
+type IstioMeshGatewayTLS struct {
+	// Name of the secret in the namespace of the gateway the certificate is stored in,
+	// it can be used as the credentialName of the servers of Istio gateways
+	SecretName string `protobuf:"bytes,1,opt,name=secretName,proto3" json:"secretName,omitempty"`
+	// DNS names the certificate is issued for
+	// +kubebuilder:validation:MinItems=1
+	DnsNames []string `protobuf:"bytes,2,rep,name=dnsNames,proto3" json:"dnsNames,omitempty"`
+	// Validity of the certificate, 2160h (90 days) by default
+	Duration string `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Time before the expiry of the certificate when it is renewed, 720h (30 days) by default
+	RenewBefore string `protobuf:"bytes,4,opt,name=renewBefore,proto3" json:"renewBefore,omitempty"`
+	// Issuer of cert-manager the certificate is requested from,
+	// the certificate is issued by the self-signed CA of the operator when it is not set
+	CertManagerIssuer    *CertManagerIssuerReference `protobuf:"bytes,5,opt,name=certManagerIssuer,proto3" json:"certManagerIssuer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *IstioMeshGatewayTLS) Reset()         { *m = IstioMeshGatewayTLS{} }
+func (m *IstioMeshGatewayTLS) String() string { return proto.CompactTextString(m) }
+func (*IstioMeshGatewayTLS) ProtoMessage()    {}
+func (*IstioMeshGatewayTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{4}
+}
+func (m *IstioMeshGatewayTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioMeshGatewayTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioMeshGatewayTLS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioMeshGatewayTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioMeshGatewayTLS.Merge(m, src)
+}
+func (m *IstioMeshGatewayTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioMeshGatewayTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioMeshGatewayTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioMeshGatewayTLS proto.InternalMessageInfo
+
+func (m *IstioMeshGatewayTLS) GetSecretName() string {
+	if m != nil {
+		return m.SecretName
+	}
+	return ""
+}
+
+func (m *IstioMeshGatewayTLS) GetDnsNames() []string {
+	if m != nil {
+		return m.DnsNames
+	}
+	return nil
+}
+
+func (m *IstioMeshGatewayTLS) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *IstioMeshGatewayTLS) GetRenewBefore() string {
+	if m != nil {
+		return m.RenewBefore
+	}
+	return ""
+}
+
+func (m *IstioMeshGatewayTLS) GetCertManagerIssuer() *CertManagerIssuerReference {
+	if m != nil {
+		return m.CertManagerIssuer
+	}
+	return nil
+}
+
+type CertManagerIssuerReference struct {
+	// Name of the issuer
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Kind of the issuer, Issuer by default
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// API group of the issuer, cert-manager.io by default
+	Group                string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertManagerIssuerReference) Reset()         { *m = CertManagerIssuerReference{} }
+func (m *CertManagerIssuerReference) String() string { return proto.CompactTextString(m) }
+func (*CertManagerIssuerReference) ProtoMessage()    {}
+func (*CertManagerIssuerReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{5}
+}
+func (m *CertManagerIssuerReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertManagerIssuerReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertManagerIssuerReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CertManagerIssuerReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertManagerIssuerReference.Merge(m, src)
+}
+func (m *CertManagerIssuerReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertManagerIssuerReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertManagerIssuerReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertManagerIssuerReference proto.InternalMessageInfo
+
+func (m *CertManagerIssuerReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CertManagerIssuerReference) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *CertManagerIssuerReference) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 type Properties struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -185,7 +569,7 @@ func (m *Properties) Reset()         { *m = Properties{} }
 func (m *Properties) String() string { return proto.CompactTextString(m) }
 func (*Properties) ProtoMessage()    {}
 func (*Properties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6c92d5e9af32c16, []int{1}
+	return fileDescriptor_b6c92d5e9af32c16, []int{6}
 }
 func (m *Properties) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,17 +615,25 @@ type IstioMeshGatewayStatus struct {
 	// Current address for the gateway
 	GatewayAddress []string `protobuf:"bytes,2,rep,name=GatewayAddress,proto3" json:"GatewayAddress,omitempty"`
 	// Reconciliation error message if any
-	ErrorMessage         string   `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	// Latest available observations of the state of the Istio mesh gateway
+	Conditions []Condition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the Istio mesh gateway which was last reconciled
+	ObservedGeneration int64 `protobuf:"varint,5,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	// Reconciliation state and inventory of the objects of the components of the Istio mesh gateway
+	Components []ComponentStatus `protobuf:"bytes,6,rep,name=components,proto3" json:"components"`
+	// State of the TLS certificate of the gateway
+	Tls                  *IstioMeshGatewayTLSStatus `protobuf:"bytes,7,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *IstioMeshGatewayStatus) Reset()         { *m = IstioMeshGatewayStatus{} }
 func (m *IstioMeshGatewayStatus) String() string { return proto.CompactTextString(m) }
 func (*IstioMeshGatewayStatus) ProtoMessage()    {}
 func (*IstioMeshGatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6c92d5e9af32c16, []int{2}
+	return fileDescriptor_b6c92d5e9af32c16, []int{7}
 }
 func (m *IstioMeshGatewayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,85 +683,263 @@ func (m *IstioMeshGatewayStatus) GetErrorMessage() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.GatewayType", GatewayType_name, GatewayType_value)
-	proto.RegisterType((*IstioMeshGatewaySpec)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec")
-	proto.RegisterType((*Properties)(nil), "istio_operator.v2.api.v1alpha1.Properties")
-	proto.RegisterType((*IstioMeshGatewayStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus")
+func (m *IstioMeshGatewayStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("api/v1alpha1/istiomeshgateway.proto", fileDescriptor_b6c92d5e9af32c16)
+func (m *IstioMeshGatewayStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
 }
 
-var fileDescriptor_b6c92d5e9af32c16 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x51, 0x6b, 0xd4, 0x40,
-	0x10, 0x80, 0xcd, 0xf5, 0xbc, 0x72, 0x7b, 0x52, 0xeb, 0x5a, 0x24, 0xf6, 0xe1, 0x7a, 0x9c, 0xa0,
-	0x87, 0x62, 0x42, 0x4f, 0xa4, 0x7d, 0x10, 0xa1, 0x77, 0x94, 0x22, 0xa5, 0x5a, 0x52, 0xf1, 0x41,
-	0x84, 0xb2, 0x49, 0xe6, 0x72, 0x4b, 0x93, 0x9d, 0x65, 0x77, 0x93, 0x72, 0xfe, 0x0d, 0xff, 0x80,
-	0xaf, 0xfe, 0x13, 0x1f, 0xfd, 0x07, 0xca, 0xfd, 0x12, 0xc9, 0x26, 0xa7, 0xad, 0x27, 0x9e, 0x6f,
-	0xc3, 0x64, 0xbe, 0x6f, 0x67, 0x27, 0x93, 0x90, 0x07, 0x4c, 0x72, 0xbf, 0xd8, 0x65, 0xa9, 0x9c,
-	0xb2, 0x5d, 0x9f, 0x6b, 0xc3, 0x31, 0x03, 0x3d, 0x4d, 0x98, 0x81, 0x4b, 0x36, 0xf3, 0xa4, 0x42,
-	0x83, 0xb4, 0x6b, 0xf3, 0xe7, 0x28, 0x41, 0x31, 0x83, 0xca, 0x2b, 0x86, 0x1e, 0x93, 0xdc, 0x5b,
-	0x60, 0xdb, 0xdd, 0x04, 0x31, 0x49, 0xc1, 0xb7, 0xd5, 0x61, 0x3e, 0xf1, 0x2f, 0x15, 0x93, 0x12,
-	0x94, 0xae, 0xf8, 0xed, 0xfb, 0xd7, 0x0e, 0x89, 0x30, 0xcb, 0x50, 0xd4, 0x8f, 0xb6, 0x12, 0x4c,
-	0xd0, 0x86, 0x7e, 0x19, 0xd5, 0xd9, 0x9d, 0x5a, 0x58, 0x72, 0x13, 0x0e, 0x69, 0x7c, 0x1e, 0xc2,
-	0x94, 0x15, 0x1c, 0x55, 0x5d, 0xd0, 0xbf, 0xd8, 0xd7, 0x1e, 0x47, 0x5b, 0x10, 0xa1, 0x02, 0xbf,
-	0xd8, 0xf5, 0x13, 0x10, 0x65, 0x7f, 0x10, 0x57, 0x35, 0xfd, 0x4f, 0x4d, 0xb2, 0xf5, 0xaa, 0x6c,
-	0xfc, 0x04, 0xf4, 0xf4, 0xa8, 0xba, 0xd0, 0x99, 0x84, 0x88, 0x7e, 0x20, 0x24, 0x06, 0x99, 0xe2,
-	0x2c, 0x03, 0x61, 0x5c, 0xa7, 0xe7, 0x0c, 0x3a, 0xc3, 0x17, 0xde, 0xbf, 0xef, 0xe8, 0x8d, 0x98,
-	0x86, 0xe3, 0x3c, 0x04, 0x25, 0xc0, 0x80, 0x0e, 0x40, 0x63, 0xae, 0x22, 0x18, 0xa3, 0x98, 0xf0,
-	0x24, 0xb8, 0xe2, 0xa3, 0x47, 0x64, 0x5d, 0x83, 0x2a, 0x78, 0x04, 0x6e, 0xc3, 0xaa, 0x1f, 0xad,
-	0x52, 0x9f, 0x55, 0xe5, 0xa3, 0xe6, 0xfc, 0xc0, 0x69, 0x04, 0x0b, 0x9a, 0xbe, 0x24, 0x6d, 0x95,
-	0x8b, 0x03, 0x1d, 0x20, 0x1a, 0x77, 0xcd, 0xaa, 0xb6, 0xbd, 0x6a, 0x30, 0xde, 0x62, 0xd2, 0xde,
-	0x08, 0x31, 0x7d, 0xc7, 0xd2, 0x1c, 0x46, 0xcd, 0xcf, 0xdf, 0x77, 0x9c, 0xe0, 0x37, 0x42, 0x0f,
-	0x49, 0xd3, 0xcc, 0x24, 0xb8, 0xcd, 0x9e, 0x33, 0xd8, 0x18, 0x3e, 0x59, 0xd5, 0x45, 0x3d, 0xa1,
-	0xb7, 0x33, 0xb9, 0xe8, 0xc4, 0xe2, 0x34, 0x24, 0x77, 0x2c, 0x39, 0x46, 0x61, 0x14, 0xa6, 0xa7,
-	0x29, 0x13, 0xe0, 0xde, 0xb4, 0xed, 0x78, 0xab, 0x9c, 0xaf, 0x59, 0x06, 0x5a, 0xb2, 0x08, 0xe2,
-	0x32, 0xaa, 0xb5, 0xcb, 0x3a, 0xca, 0xc9, 0xdd, 0x8b, 0xfd, 0x5f, 0x43, 0x7d, 0x53, 0x80, 0x4a,
-	0xd9, 0x4c, 0xbb, 0xad, 0xde, 0xda, 0xa0, 0x33, 0xdc, 0x5b, 0x75, 0xca, 0xf1, 0x12, 0x7a, 0xca,
-	0x4c, 0x34, 0x0d, 0xfe, 0xe6, 0xec, 0xf7, 0x08, 0x39, 0x55, 0xa5, 0xca, 0x70, 0xd0, 0x94, 0x92,
-	0xa6, 0x60, 0x19, 0xd8, 0x25, 0x68, 0x07, 0x36, 0xee, 0x7f, 0x71, 0xc8, 0xbd, 0xa5, 0xbd, 0x31,
-	0xcc, 0xe4, 0x9a, 0x8e, 0x49, 0xab, 0x8a, 0x2c, 0xf0, 0x1f, 0x43, 0xad, 0xf6, 0xa3, 0x64, 0x20,
-	0xa8, 0x51, 0xfa, 0x90, 0x6c, 0xd4, 0xd6, 0x83, 0x38, 0x56, 0xa0, 0xb5, 0xdb, 0xe8, 0xad, 0x0d,
-	0xda, 0xc1, 0x1f, 0x59, 0xda, 0x27, 0xb7, 0x0e, 0x95, 0x42, 0x75, 0x02, 0x5a, 0xb3, 0x04, 0xec,
-	0x0a, 0xb4, 0x83, 0x6b, 0xb9, 0xc7, 0x7b, 0xa4, 0x73, 0xe5, 0xbd, 0xd1, 0xdb, 0xa4, 0x93, 0x0b,
-	0x2d, 0x21, 0xe2, 0x13, 0x0e, 0xf1, 0xe6, 0x0d, 0xda, 0x21, 0xeb, 0x5c, 0x24, 0xa5, 0x6e, 0xd3,
-	0xa1, 0x84, 0xb4, 0xa0, 0x8a, 0x1b, 0xa3, 0xf1, 0xd7, 0x79, 0xd7, 0xf9, 0x36, 0xef, 0x3a, 0x3f,
-	0xe6, 0x5d, 0xe7, 0xfd, 0xf3, 0x84, 0x9b, 0x69, 0x1e, 0x7a, 0x11, 0x66, 0x7e, 0xc8, 0xc4, 0x47,
-	0xc6, 0xa3, 0x14, 0xf3, 0xb8, 0xfa, 0x1f, 0x3c, 0x5d, 0xdc, 0xce, 0x2f, 0x86, 0xfe, 0xd5, 0x2f,
-	0x39, 0x6c, 0xd9, 0x35, 0x7c, 0xf6, 0x33, 0x00, 0x00, 0xff, 0xff, 0x76, 0xea, 0xaf, 0xe5, 0x45,
-	0x04, 0x00, 0x00,
+func (m *IstioMeshGatewayStatus) GetComponents() []ComponentStatus {
+	if m != nil {
+		return m.Components
+	}
+	return nil
 }
 
-func (m *IstioMeshGatewaySpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IstioMeshGatewayStatus) GetTls() *IstioMeshGatewayTLSStatus {
+	if m != nil {
+		return m.Tls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IstioMeshGatewaySpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IstioMeshGatewayTLSStatus struct {
+	// Name of the secret the certificate is stored in
+	SecretName string `protobuf:"bytes,1,opt,name=secretName,proto3" json:"secretName,omitempty"`
+	// Issuer of the certificate, either CertManager or OperatorCA
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Time when the current certificate was issued
+	NotBefore *types.Timestamp `protobuf:"bytes,3,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	// Expiry of the current certificate
+	NotAfter *types.Timestamp `protobuf:"bytes,4,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	// Time when the certificate is going to be renewed
+	RenewalTime          *types.Timestamp `protobuf:"bytes,5,opt,name=renewalTime,proto3" json:"renewalTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *IstioMeshGatewaySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.K8SResourceOverlays) > 0 {
-		for iNdEx := len(m.K8SResourceOverlays) - 1; iNdEx >= 0; iNdEx-- {
-			{
+func (m *IstioMeshGatewayTLSStatus) Reset()         { *m = IstioMeshGatewayTLSStatus{} }
+func (m *IstioMeshGatewayTLSStatus) String() string { return proto.CompactTextString(m) }
+func (*IstioMeshGatewayTLSStatus) ProtoMessage()    {}
+func (*IstioMeshGatewayTLSStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c92d5e9af32c16, []int{8}
+}
+func (m *IstioMeshGatewayTLSStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioMeshGatewayTLSStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioMeshGatewayTLSStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioMeshGatewayTLSStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioMeshGatewayTLSStatus.Merge(m, src)
+}
+func (m *IstioMeshGatewayTLSStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioMeshGatewayTLSStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioMeshGatewayTLSStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioMeshGatewayTLSStatus proto.InternalMessageInfo
+
+func (m *IstioMeshGatewayTLSStatus) GetSecretName() string {
+	if m != nil {
+		return m.SecretName
+	}
+	return ""
+}
+
+func (m *IstioMeshGatewayTLSStatus) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *IstioMeshGatewayTLSStatus) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *IstioMeshGatewayTLSStatus) GetNotAfter() *types.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+func (m *IstioMeshGatewayTLSStatus) GetRenewalTime() *types.Timestamp {
+	if m != nil {
+		return m.RenewalTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.GatewayType", GatewayType_name, GatewayType_value)
+	proto.RegisterEnum("istio_operator.v2.api.v1alpha1.AddressResolution", AddressResolution_name, AddressResolution_value)
+	proto.RegisterType((*IstioMeshGatewaySpec)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewaySpec")
+	proto.RegisterType((*EgressConfiguration)(nil), "istio_operator.v2.api.v1alpha1.EgressConfiguration")
+	proto.RegisterType((*EgressHost)(nil), "istio_operator.v2.api.v1alpha1.EgressHost")
+	proto.RegisterType((*EgressPort)(nil), "istio_operator.v2.api.v1alpha1.EgressPort")
+	proto.RegisterType((*IstioMeshGatewayTLS)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewayTLS")
+	proto.RegisterType((*CertManagerIssuerReference)(nil), "istio_operator.v2.api.v1alpha1.CertManagerIssuerReference")
+	proto.RegisterType((*Properties)(nil), "istio_operator.v2.api.v1alpha1.Properties")
+	proto.RegisterType((*IstioMeshGatewayStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewayStatus")
+	proto.RegisterType((*IstioMeshGatewayTLSStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioMeshGatewayTLSStatus")
+}
+
+func init() {
+	proto.RegisterFile("api/v1alpha1/istiomeshgateway.proto", fileDescriptor_b6c92d5e9af32c16)
+}
+
+var fileDescriptor_b6c92d5e9af32c16 = []byte{
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x6f, 0x4f, 0x23, 0x45,
+	0x18, 0xbf, 0x6d, 0x4b, 0xaf, 0x7d, 0x7a, 0x77, 0x96, 0x81, 0x5c, 0xf6, 0x88, 0x29, 0x4d, 0x35,
+	0x8a, 0x18, 0xb7, 0x81, 0xd3, 0x3b, 0x34, 0x17, 0x23, 0xe5, 0x10, 0x09, 0x87, 0x47, 0x16, 0xd4,
+	0xc4, 0x98, 0xe0, 0x74, 0xf7, 0xe9, 0x76, 0xc3, 0x76, 0x66, 0x33, 0x33, 0x5b, 0x82, 0x6f, 0x8d,
+	0x89, 0x1f, 0xc3, 0x8f, 0x73, 0xaf, 0x8c, 0x9f, 0x40, 0x0d, 0x9f, 0xc4, 0xcc, 0xcc, 0x96, 0x96,
+	0x16, 0x2c, 0xfa, 0xee, 0xd9, 0x67, 0x9e, 0xdf, 0x6f, 0x9e, 0xff, 0xb3, 0xf0, 0x0e, 0x4d, 0xe3,
+	0xf6, 0x70, 0x83, 0x26, 0x69, 0x9f, 0x6e, 0xb4, 0x63, 0xa9, 0x62, 0x3e, 0x40, 0xd9, 0x8f, 0xa8,
+	0xc2, 0x73, 0x7a, 0xe1, 0xa5, 0x82, 0x2b, 0x4e, 0x1a, 0x46, 0x7f, 0xca, 0x53, 0x14, 0x54, 0x71,
+	0xe1, 0x0d, 0x37, 0x3d, 0x9a, 0xc6, 0xde, 0x08, 0xb6, 0xd2, 0x88, 0x38, 0x8f, 0x12, 0x6c, 0x1b,
+	0xeb, 0x6e, 0xd6, 0x6b, 0x9f, 0x0b, 0x9a, 0xa6, 0x28, 0xa4, 0xc5, 0xaf, 0xac, 0x4e, 0x9f, 0xab,
+	0x78, 0x80, 0x52, 0xd1, 0x41, 0x9a, 0x1b, 0x3c, 0xb9, 0xe6, 0x45, 0xc0, 0x07, 0x03, 0xce, 0xf2,
+	0xa3, 0xe5, 0x88, 0x47, 0xdc, 0x88, 0x6d, 0x2d, 0x4d, 0x31, 0x6a, 0x5c, 0x2f, 0xc6, 0x24, 0x3c,
+	0xed, 0x62, 0x9f, 0x0e, 0x63, 0x2e, 0x72, 0x83, 0xd6, 0xd9, 0x96, 0xf4, 0x62, 0x6e, 0x0c, 0x02,
+	0x2e, 0xb0, 0x3d, 0xdc, 0x68, 0x47, 0xc8, 0x74, 0x00, 0x18, 0x5a, 0x9b, 0xd6, 0xaf, 0x65, 0x58,
+	0xde, 0xd7, 0x91, 0x1d, 0xa2, 0xec, 0xef, 0xd9, 0x88, 0x8f, 0x53, 0x0c, 0xc8, 0x0f, 0x00, 0x21,
+	0xa6, 0x09, 0xbf, 0x18, 0x20, 0x53, 0xae, 0xd3, 0x74, 0xd6, 0x6a, 0x9b, 0x2f, 0xbc, 0x7f, 0x4f,
+	0x82, 0xd7, 0xa1, 0x12, 0x0f, 0xb2, 0x2e, 0x0a, 0x86, 0x0a, 0xa5, 0x8f, 0x92, 0x67, 0x22, 0xc0,
+	0x1d, 0xce, 0x7a, 0x71, 0xe4, 0x4f, 0xf0, 0x91, 0x3d, 0xb8, 0x2f, 0x51, 0x0c, 0xe3, 0x00, 0xdd,
+	0x82, 0xa1, 0x7e, 0x7f, 0x1e, 0xf5, 0xb1, 0x35, 0xef, 0x94, 0x2e, 0xb7, 0x9d, 0x82, 0x3f, 0x42,
+	0x93, 0xcf, 0xa1, 0x2a, 0x32, 0xb6, 0x2d, 0x7d, 0xce, 0x95, 0x5b, 0x34, 0x54, 0x2b, 0x9e, 0x4d,
+	0x8c, 0x37, 0x4a, 0xb5, 0xd7, 0xe1, 0x3c, 0xf9, 0x96, 0x26, 0x19, 0x76, 0x4a, 0xbf, 0xfd, 0xb5,
+	0xea, 0xf8, 0x63, 0x08, 0xd9, 0x85, 0x92, 0xba, 0x48, 0xd1, 0x2d, 0x35, 0x9d, 0xb5, 0x47, 0x9b,
+	0x1f, 0xce, 0xf3, 0x22, 0xcf, 0xd0, 0xc9, 0x45, 0x3a, 0xf2, 0xc4, 0xc0, 0x49, 0x17, 0x16, 0x0d,
+	0x72, 0x87, 0x33, 0x25, 0x78, 0x72, 0x94, 0x50, 0x86, 0xee, 0x82, 0x71, 0xc7, 0x9b, 0xc7, 0xf9,
+	0x35, 0x1d, 0xa0, 0x4c, 0x69, 0x80, 0xa1, 0x96, 0x72, 0xda, 0x59, 0x3a, 0x12, 0xc3, 0xd2, 0xd9,
+	0xd6, 0x55, 0x52, 0x5f, 0x0f, 0x51, 0x24, 0xf4, 0x42, 0xba, 0xe5, 0x66, 0x71, 0xad, 0xb6, 0xf9,
+	0x7c, 0xde, 0x2d, 0x07, 0x33, 0xd0, 0x23, 0xaa, 0x82, 0xbe, 0x7f, 0x13, 0x27, 0x39, 0x80, 0x32,
+	0x46, 0x02, 0xa5, 0x74, 0xef, 0x9b, 0x18, 0x9e, 0xce, 0x63, 0xdf, 0x35, 0xd6, 0xb6, 0xd0, 0x99,
+	0xa0, 0x2a, 0xe6, 0xcc, 0xcf, 0x29, 0xc8, 0x2e, 0x14, 0x55, 0x22, 0xdd, 0xca, 0xdd, 0x98, 0xa6,
+	0x9b, 0xf1, 0xe4, 0xd5, 0xb1, 0xaf, 0xf1, 0xe4, 0x14, 0x16, 0x69, 0x18, 0x6a, 0x46, 0xed, 0x6e,
+	0x92, 0xe9, 0x3b, 0xdc, 0xaa, 0x29, 0xdb, 0xc6, 0x3c, 0xd2, 0xed, 0x69, 0xa0, 0x3f, 0xcb, 0xd5,
+	0xfa, 0x0e, 0x96, 0x6e, 0x08, 0x83, 0x7c, 0x01, 0x0b, 0x7d, 0x2e, 0x95, 0x74, 0x1d, 0x93, 0xe8,
+	0xf5, 0xbb, 0xa5, 0xe2, 0x2b, 0x2e, 0x95, 0x6f, 0x81, 0x2d, 0x06, 0x30, 0x56, 0x12, 0x17, 0x4a,
+	0x5a, 0x6d, 0x46, 0xaa, 0x3a, 0x6a, 0x22, 0xad, 0x21, 0x5f, 0xc2, 0x42, 0xca, 0x85, 0x92, 0x6e,
+	0xe1, 0xbf, 0xdc, 0x74, 0xc4, 0x85, 0xca, 0x69, 0x2c, 0xbc, 0xf5, 0x0a, 0x60, 0x7c, 0x44, 0xde,
+	0x86, 0x32, 0xcb, 0x06, 0x5d, 0x14, 0xe6, 0xc6, 0x87, 0xb9, 0x69, 0xae, 0x23, 0x4d, 0xa8, 0x98,
+	0x31, 0x09, 0x78, 0xe2, 0x16, 0x26, 0x3c, 0xba, 0xd2, 0xb6, 0x7e, 0x29, 0xc0, 0xd2, 0x0d, 0x45,
+	0x21, 0xef, 0x02, 0x48, 0x0c, 0x04, 0x2a, 0xdd, 0xb5, 0xd7, 0xa2, 0x99, 0xd0, 0x6b, 0xfe, 0x90,
+	0x49, 0x2d, 0xda, 0xb0, 0xae, 0xf8, 0x47, 0x5a, 0xb2, 0x02, 0x95, 0x30, 0xcf, 0xb5, 0x19, 0xe0,
+	0xaa, 0x7f, 0xf5, 0x4d, 0x9a, 0x50, 0x13, 0xc8, 0xf0, 0xbc, 0x83, 0x3d, 0x2e, 0xec, 0x90, 0x56,
+	0xfd, 0x49, 0x15, 0xe9, 0xc3, 0x62, 0x80, 0x42, 0x1d, 0x52, 0x46, 0x23, 0x14, 0xfb, 0x52, 0x66,
+	0x28, 0xf2, 0xc1, 0xfb, 0x6c, 0x5e, 0xfe, 0x76, 0xa6, 0x81, 0x3e, 0xf6, 0x50, 0x20, 0x0b, 0xd0,
+	0x9f, 0x25, 0x6d, 0xfd, 0x08, 0x2b, 0xb7, 0x03, 0x74, 0x55, 0xd9, 0x74, 0x1e, 0x8c, 0x86, 0x10,
+	0x28, 0x9d, 0xc5, 0x2c, 0xb4, 0xd9, 0xf5, 0x8d, 0x4c, 0x96, 0x61, 0x21, 0x12, 0x3c, 0x4b, 0xf3,
+	0x80, 0xed, 0x47, 0xab, 0x09, 0x70, 0x24, 0xb4, 0xb7, 0x2a, 0x46, 0xa9, 0x71, 0x63, 0x46, 0xcb,
+	0xd5, 0xfa, 0xbd, 0x08, 0x8f, 0x67, 0xb6, 0xb5, 0xa2, 0x2a, 0x93, 0x64, 0x07, 0xca, 0x56, 0x72,
+	0x9d, 0xbb, 0xad, 0x32, 0xdb, 0xe5, 0x1a, 0x83, 0x7e, 0x0e, 0x25, 0xef, 0xc1, 0xa3, 0x9c, 0x35,
+	0x9f, 0x18, 0x5b, 0x33, 0x7f, 0x4a, 0x4b, 0x5a, 0xf0, 0x60, 0x57, 0x08, 0x2e, 0x0e, 0x51, 0x4a,
+	0x1a, 0x61, 0x1e, 0xc6, 0x35, 0x1d, 0x79, 0x0d, 0x10, 0x70, 0x16, 0xc6, 0xba, 0x90, 0xd2, 0x2d,
+	0x99, 0x96, 0xfe, 0xe0, 0x0e, 0x4e, 0x59, 0x44, 0xa7, 0xf4, 0xe6, 0xcf, 0xd5, 0x7b, 0xfe, 0x04,
+	0x05, 0xf1, 0x80, 0xf0, 0xae, 0xde, 0xfb, 0x18, 0xee, 0xd9, 0x57, 0x4c, 0xb7, 0x8c, 0xae, 0x75,
+	0xd1, 0xbf, 0xe1, 0x84, 0x7c, 0xa3, 0x1d, 0x18, 0xa4, 0x9c, 0x21, 0x53, 0xa3, 0x35, 0xd9, 0x9e,
+	0xef, 0x40, 0x8e, 0xb0, 0x19, 0x19, 0xbb, 0x31, 0x22, 0x22, 0x07, 0x76, 0x9d, 0xd9, 0xc5, 0xf8,
+	0xe9, 0xff, 0x58, 0x67, 0x96, 0xd9, 0x2c, 0xb5, 0xd6, 0xcf, 0x05, 0x78, 0x72, 0xab, 0x09, 0x69,
+	0xcc, 0x8e, 0xd8, 0xb5, 0xe1, 0x7a, 0x0c, 0xe5, 0xd8, 0x76, 0xbc, 0x6d, 0xae, 0xfc, 0x8b, 0x6c,
+	0x41, 0x95, 0x71, 0x95, 0x0f, 0xcd, 0x6d, 0x8f, 0xe2, 0xc9, 0xe8, 0xff, 0xc3, 0x1f, 0x1b, 0x93,
+	0x67, 0x50, 0x61, 0x5c, 0x6d, 0xf7, 0x14, 0x0a, 0xb7, 0x34, 0x17, 0x78, 0x65, 0x4b, 0x5e, 0xe4,
+	0x83, 0x4a, 0x13, 0x7d, 0xea, 0x2e, 0xcc, 0x85, 0x4e, 0x9a, 0xaf, 0x3f, 0x87, 0xda, 0xc4, 0xc3,
+	0x4a, 0xde, 0x82, 0x5a, 0xc6, 0x64, 0x8a, 0x41, 0xdc, 0x8b, 0x31, 0xac, 0xdf, 0x23, 0x35, 0xb8,
+	0x1f, 0x33, 0xb3, 0xd1, 0xea, 0x0e, 0x81, 0xd1, 0xdb, 0x54, 0x2f, 0xac, 0xbf, 0x84, 0xc5, 0x99,
+	0xd5, 0x4e, 0x1e, 0x40, 0x45, 0x2f, 0x5a, 0x3d, 0x30, 0xf5, 0x7b, 0xa4, 0x02, 0xa5, 0xfd, 0xa3,
+	0xe1, 0xc7, 0x75, 0x27, 0x97, 0x9e, 0xd5, 0x0b, 0xe4, 0x21, 0x54, 0x5f, 0x66, 0x34, 0x39, 0x56,
+	0x34, 0x38, 0xab, 0x17, 0x3b, 0x3b, 0x6f, 0x2e, 0x1b, 0xce, 0x1f, 0x97, 0x0d, 0xe7, 0xef, 0xcb,
+	0x86, 0xf3, 0xfd, 0x27, 0x51, 0xac, 0xfa, 0x59, 0xd7, 0x0b, 0xf8, 0xa0, 0xdd, 0xa5, 0xec, 0x27,
+	0x1a, 0x07, 0x09, 0xcf, 0x42, 0xfb, 0x5f, 0xf8, 0xd1, 0xa8, 0xd0, 0xed, 0xe1, 0x66, 0x7b, 0xf2,
+	0x87, 0xad, 0x5b, 0x36, 0x41, 0x3e, 0xfd, 0x67, 0x00, 0x2a, 0x32, 0xf7, 0x6e, 0x4d, 0x0a, 0x00,
+	0x00,
+}
+
+func (m *IstioMeshGatewaySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioMeshGatewaySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioMeshGatewaySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AddressResolution != 0 {
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(m.AddressResolution))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Tls != nil {
+		{
+			size, err := m.Tls.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Egress != nil {
+		{
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.K8SResourceOverlays) > 0 {
+		for iNdEx := len(m.K8SResourceOverlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.K8SResourceOverlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -399,12 +969,12 @@ func (m *IstioMeshGatewaySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.RunAsRoot != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.RunAsRoot, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_gogo_protobuf_types.StdBoolMarshalTo(*m.RunAsRoot, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -435,7 +1005,7 @@ func (m *IstioMeshGatewaySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Properties) Marshal() (dAtA []byte, err error) {
+func (m *EgressConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -445,12 +1015,12 @@ func (m *Properties) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Properties) MarshalTo(dAtA []byte) (int, error) {
+func (m *EgressConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Properties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EgressConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -459,17 +1029,24 @@ func (m *Properties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Hosts) > 0 {
+		for iNdEx := len(m.Hosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IstioMeshGatewayStatus) Marshal() (dAtA []byte, err error) {
+func (m *EgressHost) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -479,12 +1056,12 @@ func (m *IstioMeshGatewayStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IstioMeshGatewayStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EgressHost) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IstioMeshGatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EgressHost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -493,126 +1070,1753 @@ func (m *IstioMeshGatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GatewayAddress) > 0 {
-		for iNdEx := len(m.GatewayAddress) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GatewayAddress[iNdEx])
-			copy(dAtA[i:], m.GatewayAddress[iNdEx])
-			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.GatewayAddress[iNdEx])))
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if m.Status != 0 {
-		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(m.Status))
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Host)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIstiomeshgateway(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIstiomeshgateway(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EgressPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IstioMeshGatewaySpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EgressPort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Deployment != nil {
-		l = m.Deployment.Size()
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RunAsRoot != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot)
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	if m.Number != 0 {
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Type != 0 {
-		n += 1 + sovIstiomeshgateway(uint64(m.Type))
+	return len(dAtA) - i, nil
+}
+
+func (m *IstioMeshGatewayTLS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.IstioControlPlane != nil {
-		l = m.IstioControlPlane.Size()
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *IstioMeshGatewayTLS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioMeshGatewayTLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.K8SResourceOverlays) > 0 {
-		for _, e := range m.K8SResourceOverlays {
-			l = e.Size()
-			n += 1 + l + sovIstiomeshgateway(uint64(l))
+	if m.CertManagerIssuer != nil {
+		{
+			size, err := m.CertManagerIssuer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.RenewBefore) > 0 {
+		i -= len(m.RenewBefore)
+		copy(dAtA[i:], m.RenewBefore)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.RenewBefore)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *Properties) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Duration) > 0 {
+		i -= len(m.Duration)
+		copy(dAtA[i:], m.Duration)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Duration)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	if len(m.DnsNames) > 0 {
+		for iNdEx := len(m.DnsNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DnsNames[iNdEx])
+			copy(dAtA[i:], m.DnsNames[iNdEx])
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.DnsNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SecretName) > 0 {
+		i -= len(m.SecretName)
+		copy(dAtA[i:], m.SecretName)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.SecretName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IstioMeshGatewayStatus) Size() (n int) {
+func (m *CertManagerIssuerReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertManagerIssuerReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertManagerIssuerReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Properties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Properties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Properties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IstioMeshGatewayStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioMeshGatewayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioMeshGatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tls != nil {
+		{
+			size, err := m.Tls.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GatewayAddress) > 0 {
+		for iNdEx := len(m.GatewayAddress) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GatewayAddress[iNdEx])
+			copy(dAtA[i:], m.GatewayAddress[iNdEx])
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.GatewayAddress[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IstioMeshGatewayTLSStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioMeshGatewayTLSStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioMeshGatewayTLSStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RenewalTime != nil {
+		{
+			size, err := m.RenewalTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NotAfter != nil {
+		{
+			size, err := m.NotAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NotBefore != nil {
+		{
+			size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiomeshgateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SecretName) > 0 {
+		i -= len(m.SecretName)
+		copy(dAtA[i:], m.SecretName)
+		i = encodeVarintIstiomeshgateway(dAtA, i, uint64(len(m.SecretName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIstiomeshgateway(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIstiomeshgateway(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IstioMeshGatewaySpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovIstiomeshgateway(uint64(m.Status))
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
 	}
-	if len(m.GatewayAddress) > 0 {
-		for _, s := range m.GatewayAddress {
-			l = len(s)
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.RunAsRoot != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdBool(*m.RunAsRoot)
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovIstiomeshgateway(uint64(m.Type))
+	}
+	if m.IstioControlPlane != nil {
+		l = m.IstioControlPlane.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if len(m.K8SResourceOverlays) > 0 {
+		for _, e := range m.K8SResourceOverlays {
+			l = e.Size()
 			n += 1 + l + sovIstiomeshgateway(uint64(l))
 		}
 	}
-	l = len(m.ErrorMessage)
-	if l > 0 {
-		n += 1 + l + sovIstiomeshgateway(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.Tls != nil {
+		l = m.Tls.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.AddressResolution != 0 {
+		n += 1 + sovIstiomeshgateway(uint64(m.AddressResolution))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for _, e := range m.Hosts {
+			l = e.Size()
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressHost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EgressPort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovIstiomeshgateway(uint64(m.Number))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstioMeshGatewayTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecretName)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if len(m.DnsNames) > 0 {
+		for _, s := range m.DnsNames {
+			l = len(s)
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	l = len(m.Duration)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	l = len(m.RenewBefore)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.CertManagerIssuer != nil {
+		l = m.CertManagerIssuer.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CertManagerIssuerReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Properties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstioMeshGatewayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovIstiomeshgateway(uint64(m.Status))
+	}
+	if len(m.GatewayAddress) > 0 {
+		for _, s := range m.GatewayAddress {
+			l = len(s)
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovIstiomeshgateway(uint64(m.ObservedGeneration))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovIstiomeshgateway(uint64(l))
+		}
+	}
+	if m.Tls != nil {
+		l = m.Tls.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstioMeshGatewayTLSStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecretName)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = m.NotBefore.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = m.NotAfter.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.RenewalTime != nil {
+		l = m.RenewalTime.Size()
+		n += 1 + l + sovIstiomeshgateway(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovIstiomeshgateway(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIstiomeshgateway(x uint64) (n int) {
+	return sovIstiomeshgateway(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioMeshGatewaySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioMeshGatewaySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &BaseKubernetesResourceConfig{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAsRoot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunAsRoot == nil {
+				m.RunAsRoot = new(bool)
+			}
+			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.RunAsRoot, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= GatewayType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IstioControlPlane == nil {
+				m.IstioControlPlane = &NamespacedName{}
+			}
+			if err := m.IstioControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
+			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &EgressConfiguration{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tls == nil {
+				m.Tls = &IstioMeshGatewayTLS{}
+			}
+			if err := m.Tls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressResolution", wireType)
+			}
+			m.AddressResolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressResolution |= AddressResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, &EgressHost{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressHost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressHost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressHost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &EgressPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressPort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioMeshGatewayTLS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioMeshGatewayTLS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioMeshGatewayTLS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsNames = append(m.DnsNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertManagerIssuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CertManagerIssuer == nil {
+				m.CertManagerIssuer = &CertManagerIssuerReference{}
+			}
+			if err := m.CertManagerIssuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertManagerIssuerReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertManagerIssuerReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertManagerIssuerReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Properties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiomeshgateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Properties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Properties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovIstiomeshgateway(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIstiomeshgateway(x uint64) (n int) {
-	return sovIstiomeshgateway(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
+func (m *IstioMeshGatewayStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -635,17 +2839,36 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioMeshGatewaySpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioMeshGatewayStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioMeshGatewaySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioMeshGatewayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -655,33 +2878,29 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &BaseKubernetesResourceConfig{}
-			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GatewayAddress = append(m.GatewayAddress, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -691,31 +2910,27 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAsRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -742,18 +2957,16 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunAsRoot == nil {
-				m.RunAsRoot = new(bool)
-			}
-			if err := github_com_gogo_protobuf_types.StdBoolUnmarshal(m.RunAsRoot, dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			m.Type = 0
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -763,14 +2976,14 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= GatewayType(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -797,16 +3010,14 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IstioControlPlane == nil {
-				m.IstioControlPlane = &NamespacedName{}
-			}
-			if err := m.IstioControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Components = append(m.Components, ComponentStatus{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SResourceOverlays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -833,8 +3044,10 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SResourceOverlays = append(m.K8SResourceOverlays, &K8SResourceOverlayPatch{})
-			if err := m.K8SResourceOverlays[len(m.K8SResourceOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tls == nil {
+				m.Tls = &IstioMeshGatewayTLSStatus{}
+			}
+			if err := m.Tls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -860,7 +3073,7 @@ func (m *IstioMeshGatewaySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Properties) Unmarshal(dAtA []byte) error {
+func (m *IstioMeshGatewayTLSStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,15 +3096,15 @@ func (m *Properties) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Properties: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioMeshGatewayTLSStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Properties: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioMeshGatewayTLSStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -919,64 +3132,45 @@ func (m *Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIstiomeshgateway(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIstiomeshgateway
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiomeshgateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IstioMeshGatewayStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIstiomeshgateway
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IstioMeshGatewayStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioMeshGatewayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -986,16 +3180,33 @@ func (m *IstioMeshGatewayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ConfigState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiomeshgateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = &types.Timestamp{}
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -1005,29 +3216,33 @@ func (m *IstioMeshGatewayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GatewayAddress = append(m.GatewayAddress, string(dAtA[iNdEx:postIndex]))
+			if m.NotAfter == nil {
+				m.NotAfter = &types.Timestamp{}
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIstiomeshgateway
@@ -1037,23 +3252,27 @@ func (m *IstioMeshGatewayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIstiomeshgateway
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			if m.RenewalTime == nil {
+				m.RenewalTime = &types.Timestamp{}
+			}
+			if err := m.RenewalTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
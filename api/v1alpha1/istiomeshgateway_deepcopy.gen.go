@@ -39,6 +39,111 @@ func (in *IstioMeshGatewaySpec) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
 
+// DeepCopyInto supports using EgressConfiguration within kubernetes types, where deepcopy-gen is used.
+func (in *EgressConfiguration) DeepCopyInto(out *EgressConfiguration) {
+	p := proto.Clone(in).(*EgressConfiguration)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfiguration. Required by controller-gen.
+func (in *EgressConfiguration) DeepCopy() *EgressConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfiguration. Required by controller-gen.
+func (in *EgressConfiguration) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EgressHost within kubernetes types, where deepcopy-gen is used.
+func (in *EgressHost) DeepCopyInto(out *EgressHost) {
+	p := proto.Clone(in).(*EgressHost)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressHost. Required by controller-gen.
+func (in *EgressHost) DeepCopy() *EgressHost {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EgressHost. Required by controller-gen.
+func (in *EgressHost) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using EgressPort within kubernetes types, where deepcopy-gen is used.
+func (in *EgressPort) DeepCopyInto(out *EgressPort) {
+	p := proto.Clone(in).(*EgressPort)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPort. Required by controller-gen.
+func (in *EgressPort) DeepCopy() *EgressPort {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new EgressPort. Required by controller-gen.
+func (in *EgressPort) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using IstioMeshGatewayTLS within kubernetes types, where deepcopy-gen is used.
+func (in *IstioMeshGatewayTLS) DeepCopyInto(out *IstioMeshGatewayTLS) {
+	p := proto.Clone(in).(*IstioMeshGatewayTLS)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshGatewayTLS. Required by controller-gen.
+func (in *IstioMeshGatewayTLS) DeepCopy() *IstioMeshGatewayTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioMeshGatewayTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshGatewayTLS. Required by controller-gen.
+func (in *IstioMeshGatewayTLS) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using CertManagerIssuerReference within kubernetes types, where deepcopy-gen is used.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	p := proto.Clone(in).(*CertManagerIssuerReference)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference. Required by controller-gen.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference. Required by controller-gen.
+func (in *CertManagerIssuerReference) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
 // DeepCopyInto supports using Properties within kubernetes types, where deepcopy-gen is used.
 func (in *Properties) DeepCopyInto(out *Properties) {
 	p := proto.Clone(in).(*Properties)
@@ -80,3 +185,24 @@ func (in *IstioMeshGatewayStatus) DeepCopy() *IstioMeshGatewayStatus {
 func (in *IstioMeshGatewayStatus) DeepCopyInterface() interface{} {
 	return in.DeepCopy()
 }
+
+// DeepCopyInto supports using IstioMeshGatewayTLSStatus within kubernetes types, where deepcopy-gen is used.
+func (in *IstioMeshGatewayTLSStatus) DeepCopyInto(out *IstioMeshGatewayTLSStatus) {
+	p := proto.Clone(in).(*IstioMeshGatewayTLSStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshGatewayTLSStatus. Required by controller-gen.
+func (in *IstioMeshGatewayTLSStatus) DeepCopy() *IstioMeshGatewayTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioMeshGatewayTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioMeshGatewayTLSStatus. Required by controller-gen.
+func (in *IstioMeshGatewayTLSStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
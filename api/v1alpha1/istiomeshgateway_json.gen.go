@@ -31,6 +31,61 @@ func (this *IstioMeshGatewaySpec) UnmarshalJSON(b []byte) error {
 	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for EgressConfiguration
+func (this *EgressConfiguration) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for EgressConfiguration
+func (this *EgressConfiguration) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for EgressHost
+func (this *EgressHost) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for EgressHost
+func (this *EgressHost) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for EgressPort
+func (this *EgressPort) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for EgressPort
+func (this *EgressPort) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for IstioMeshGatewayTLS
+func (this *IstioMeshGatewayTLS) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioMeshGatewayTLS
+func (this *IstioMeshGatewayTLS) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for CertManagerIssuerReference
+func (this *CertManagerIssuerReference) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for CertManagerIssuerReference
+func (this *CertManagerIssuerReference) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 // MarshalJSON is a custom marshaler for Properties
 func (this *Properties) MarshalJSON() ([]byte, error) {
 	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
@@ -53,6 +108,17 @@ func (this *IstioMeshGatewayStatus) UnmarshalJSON(b []byte) error {
 	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
 }
 
+// MarshalJSON is a custom marshaler for IstioMeshGatewayTLSStatus
+func (this *IstioMeshGatewayTLSStatus) MarshalJSON() ([]byte, error) {
+	str, err := IstiomeshgatewayMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioMeshGatewayTLSStatus
+func (this *IstioMeshGatewayTLSStatus) UnmarshalJSON(b []byte) error {
+	return IstiomeshgatewayUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
 var (
 	IstiomeshgatewayMarshaler   = &github_com_gogo_protobuf_jsonpb.Marshaler{Int64Uint64asIntegers: true}
 	IstiomeshgatewayUnmarshaler = &github_com_gogo_protobuf_jsonpb.Unmarshaler{AllowUnknownFields: true}
@@ -45,6 +45,31 @@ func (imgw *IstioMeshGateway) GetStatus() IstioMeshGatewayStatus {
 	return imgw.Status
 }
 
+func (imgw *IstioMeshGateway) SetCondition(condition Condition) {
+	SetCondition(&imgw.Status.Conditions, condition)
+}
+
+func (imgw *IstioMeshGateway) GetCondition(conditionType string) *Condition {
+	return FindCondition(imgw.Status.Conditions, conditionType)
+}
+
+func (imgw *IstioMeshGateway) SetObservedGeneration(generation int64) {
+	imgw.Status.ObservedGeneration = generation
+}
+
+func (imgw *IstioMeshGateway) SetComponentStatus(status ComponentStatus) {
+	SetComponentStatus(&imgw.Status.Components, status)
+}
+
+func (imgw *IstioMeshGateway) GetComponentStatus(name string) *ComponentStatus {
+	return FindComponentStatus(imgw.Status.Components, name)
+}
+
+// ComponentUnmanaged reports whether the component with the given name should be left alone by the operator
+func (imgw *IstioMeshGateway) ComponentUnmanaged(name string) bool {
+	return IsComponentUnmanaged(imgw, name)
+}
+
 func (imgw *IstioMeshGateway) GetSpec() *IstioMeshGatewaySpec {
 	if imgw.Spec != nil {
 		return imgw.Spec
@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiorevisiontag.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IstioRevisionTag is a stable name for the revision of an Istio control plane, namespaces labeled
+// with istio.io/rev set to the name of the tag are injected by the sidecar injector of the control plane the tag points to
+//
+// <!-- crd generation tags
+// +cue-gen:IstioRevisionTag:groupName:servicemesh.cisco.com
+// +cue-gen:IstioRevisionTag:version:v1alpha1
+// +cue-gen:IstioRevisionTag:storageVersion
+// +cue-gen:IstioRevisionTag:annotations:helm.sh/resource-policy=keep
+// +cue-gen:IstioRevisionTag:subresource:status
+// +cue-gen:IstioRevisionTag:scope:Cluster
+// +cue-gen:IstioRevisionTag:resource:shortNames=irt,istiotag
+// +cue-gen:IstioRevisionTag:printerColumn:name="Revision",type="string",JSONPath=".status.revision",description="Revision of the control plane the tag points to"
+// +cue-gen:IstioRevisionTag:printerColumn:name="Status",type="string",JSONPath=".status.status",description="Status of the resource"
+// +cue-gen:IstioRevisionTag:printerColumn:name="Error",type="string",JSONPath=".status.errorMessage",description="Error message"
+// +cue-gen:IstioRevisionTag:printerColumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +cue-gen:IstioRevisionTag:preserveUnknownFields:false
+// +cue-gen:IstioRevisionTag:specIsRequired
+// -->
+//
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioRevisionTagSpec struct {
+	// Istio control plane the tag points to
+	IstioControlPlane    *NamespacedName `protobuf:"bytes,1,opt,name=istioControlPlane,proto3" json:"istioControlPlane,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *IstioRevisionTagSpec) Reset()         { *m = IstioRevisionTagSpec{} }
+func (m *IstioRevisionTagSpec) String() string { return proto.CompactTextString(m) }
+func (*IstioRevisionTagSpec) ProtoMessage()    {}
+func (*IstioRevisionTagSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46f5c00e90e68dc0, []int{0}
+}
+func (m *IstioRevisionTagSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioRevisionTagSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioRevisionTagSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioRevisionTagSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioRevisionTagSpec.Merge(m, src)
+}
+func (m *IstioRevisionTagSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioRevisionTagSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioRevisionTagSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioRevisionTagSpec proto.InternalMessageInfo
+
+func (m *IstioRevisionTagSpec) GetIstioControlPlane() *NamespacedName {
+	if m != nil {
+		return m.IstioControlPlane
+	}
+	return nil
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type IstioRevisionTagStatus struct {
+	// Reconciliation status of the revision tag
+	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
+	// Reconciliation error message if any
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// Namespaced revision of the control plane the tag points to
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Name of the mutating webhook configuration of the tag
+	MutatingWebhookConfigurationName string `protobuf:"bytes,4,opt,name=mutatingWebhookConfigurationName,proto3" json:"mutatingWebhookConfigurationName,omitempty"`
+	// Latest available observations of the state of the revision tag
+	Conditions []Condition `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the revision tag which was last reconciled
+	ObservedGeneration   int64    `protobuf:"varint,6,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IstioRevisionTagStatus) Reset()         { *m = IstioRevisionTagStatus{} }
+func (m *IstioRevisionTagStatus) String() string { return proto.CompactTextString(m) }
+func (*IstioRevisionTagStatus) ProtoMessage()    {}
+func (*IstioRevisionTagStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46f5c00e90e68dc0, []int{1}
+}
+func (m *IstioRevisionTagStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioRevisionTagStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IstioRevisionTagStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IstioRevisionTagStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioRevisionTagStatus.Merge(m, src)
+}
+func (m *IstioRevisionTagStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioRevisionTagStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioRevisionTagStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioRevisionTagStatus proto.InternalMessageInfo
+
+func (m *IstioRevisionTagStatus) GetStatus() ConfigState {
+	if m != nil {
+		return m.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (m *IstioRevisionTagStatus) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *IstioRevisionTagStatus) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *IstioRevisionTagStatus) GetMutatingWebhookConfigurationName() string {
+	if m != nil {
+		return m.MutatingWebhookConfigurationName
+	}
+	return ""
+}
+
+func (m *IstioRevisionTagStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *IstioRevisionTagStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IstioRevisionTagSpec)(nil), "istio_operator.v2.api.v1alpha1.IstioRevisionTagSpec")
+	proto.RegisterType((*IstioRevisionTagStatus)(nil), "istio_operator.v2.api.v1alpha1.IstioRevisionTagStatus")
+}
+
+func init() {
+	proto.RegisterFile("api/v1alpha1/istiorevisiontag.proto", fileDescriptor_46f5c00e90e68dc0)
+}
+
+var fileDescriptor_46f5c00e90e68dc0 = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x8b, 0x13, 0x41,
+	0x10, 0xb5, 0x93, 0x18, 0xb4, 0x57, 0x04, 0x9b, 0x45, 0xc6, 0x1c, 0xb2, 0x43, 0xbc, 0x44, 0xc4,
+	0x1e, 0x76, 0xc4, 0x1f, 0x60, 0x72, 0x10, 0x05, 0x3f, 0x18, 0x05, 0xc1, 0xcb, 0x52, 0x33, 0x53,
+	0xdb, 0x69, 0x9c, 0xe9, 0x1a, 0xba, 0x7b, 0xe6, 0xb0, 0xbf, 0x70, 0x8f, 0xfe, 0x02, 0x91, 0x1c,
+	0xfd, 0x15, 0x32, 0x3d, 0x89, 0xec, 0xb2, 0x62, 0xf6, 0xf6, 0xfa, 0x55, 0xbd, 0x7a, 0xaf, 0xe8,
+	0xe2, 0x4f, 0xa1, 0xd1, 0x49, 0x77, 0x0a, 0x55, 0xb3, 0x81, 0xd3, 0x44, 0x3b, 0xaf, 0xc9, 0x62,
+	0xa7, 0x9d, 0x26, 0xe3, 0x41, 0xc9, 0xc6, 0x92, 0x27, 0x31, 0x0f, 0xfc, 0x19, 0x35, 0x68, 0xc1,
+	0x93, 0x95, 0x5d, 0x2a, 0xa1, 0xd1, 0x72, 0x2f, 0x9b, 0x3d, 0xb9, 0x36, 0xa4, 0xa0, 0xba, 0x26,
+	0x33, 0x48, 0x67, 0xc7, 0x8a, 0x14, 0x05, 0x98, 0xf4, 0x68, 0xc7, 0x9e, 0x28, 0x22, 0x55, 0x61,
+	0xd2, 0xeb, 0xce, 0x35, 0x56, 0xe5, 0x59, 0x8e, 0x1b, 0xe8, 0x34, 0xd9, 0xa1, 0x61, 0x71, 0xc1,
+	0x8f, 0xdf, 0xf6, 0x9e, 0xd9, 0x2e, 0xcb, 0x17, 0x50, 0x9f, 0x1b, 0x2c, 0x44, 0xce, 0x1f, 0x85,
+	0x2c, 0x6b, 0x32, 0xde, 0x52, 0xf5, 0xa9, 0x02, 0x83, 0x11, 0x8b, 0xd9, 0xf2, 0x28, 0x95, 0xf2,
+	0xff, 0x29, 0xe5, 0x07, 0xa8, 0xd1, 0x35, 0x50, 0x60, 0xd9, 0xa3, 0xd5, 0x64, 0xfb, 0x9a, 0x8d,
+	0xb2, 0x9b, 0xe3, 0x16, 0xbf, 0x47, 0xfc, 0xf1, 0x0d, 0x73, 0x0f, 0xbe, 0x75, 0x62, 0xcd, 0xa7,
+	0x2e, 0xa0, 0xe0, 0xf9, 0x30, 0x7d, 0x7e, 0xc8, 0x73, 0x4d, 0xe6, 0x5c, 0x07, 0x35, 0x66, 0x3b,
+	0xa9, 0x58, 0xf0, 0x07, 0x68, 0x2d, 0xd9, 0xf7, 0xe8, 0x1c, 0x28, 0x8c, 0x46, 0x31, 0x5b, 0xde,
+	0xcf, 0xae, 0x71, 0x62, 0xc6, 0xef, 0xed, 0xbf, 0x21, 0x1a, 0x87, 0xfa, 0xdf, 0xb7, 0x78, 0xc7,
+	0xe3, 0xba, 0xf5, 0xe0, 0xb5, 0x51, 0x5f, 0x31, 0xdf, 0x10, 0x7d, 0x1f, 0x5c, 0x5a, 0x0b, 0x5e,
+	0x93, 0xe9, 0x97, 0x8b, 0x26, 0x41, 0x73, 0xb0, 0x4f, 0x7c, 0xe4, 0xbc, 0x20, 0x53, 0xea, 0x9e,
+	0x70, 0xd1, 0xdd, 0x78, 0xbc, 0x3c, 0x4a, 0x9f, 0xdd, 0x62, 0xa9, 0x41, 0xb1, 0x9a, 0x5c, 0xfe,
+	0x3c, 0xb9, 0x93, 0x5d, 0x19, 0x21, 0x24, 0x17, 0x94, 0x3b, 0xb4, 0x1d, 0x96, 0x6f, 0xd0, 0xe0,
+	0x60, 0x15, 0x4d, 0x63, 0xb6, 0x1c, 0x67, 0xff, 0xa8, 0xac, 0xd6, 0x97, 0xdb, 0x39, 0xfb, 0xb1,
+	0x9d, 0xb3, 0x5f, 0xdb, 0x39, 0xfb, 0xf6, 0x4a, 0x69, 0xbf, 0x69, 0x73, 0x59, 0x50, 0x9d, 0xe4,
+	0x60, 0x2e, 0x40, 0x17, 0x15, 0xb5, 0xe5, 0x70, 0x97, 0x2f, 0xf6, 0x81, 0x92, 0x2e, 0x4d, 0xae,
+	0x5e, 0x5c, 0x3e, 0x0d, 0x47, 0xf3, 0xf2, 0xcf, 0x00, 0xfe, 0xcb, 0xc8, 0x04, 0xcd, 0x02, 0x00,
+	0x00,
+}
+
+func (m *IstioRevisionTagSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioRevisionTagSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioRevisionTagSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IstioControlPlane != nil {
+		{
+			size, err := m.IstioControlPlane.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIstiorevisiontag(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IstioRevisionTagStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioRevisionTagStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioRevisionTagStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintIstiorevisiontag(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIstiorevisiontag(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MutatingWebhookConfigurationName) > 0 {
+		i -= len(m.MutatingWebhookConfigurationName)
+		copy(dAtA[i:], m.MutatingWebhookConfigurationName)
+		i = encodeVarintIstiorevisiontag(dAtA, i, uint64(len(m.MutatingWebhookConfigurationName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintIstiorevisiontag(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintIstiorevisiontag(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintIstiorevisiontag(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIstiorevisiontag(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIstiorevisiontag(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IstioRevisionTagSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IstioControlPlane != nil {
+		l = m.IstioControlPlane.Size()
+		n += 1 + l + sovIstiorevisiontag(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IstioRevisionTagStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovIstiorevisiontag(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovIstiorevisiontag(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovIstiorevisiontag(uint64(l))
+	}
+	l = len(m.MutatingWebhookConfigurationName)
+	if l > 0 {
+		n += 1 + l + sovIstiorevisiontag(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovIstiorevisiontag(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovIstiorevisiontag(uint64(m.ObservedGeneration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovIstiorevisiontag(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIstiorevisiontag(x uint64) (n int) {
+	return sovIstiorevisiontag(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IstioRevisionTagSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiorevisiontag
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioRevisionTagSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioRevisionTagSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IstioControlPlane == nil {
+				m.IstioControlPlane = &NamespacedName{}
+			}
+			if err := m.IstioControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiorevisiontag(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioRevisionTagStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIstiorevisiontag
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioRevisionTagStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioRevisionTagStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutatingWebhookConfigurationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutatingWebhookConfigurationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIstiorevisiontag(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIstiorevisiontag
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIstiorevisiontag(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIstiorevisiontag
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIstiorevisiontag
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIstiorevisiontag
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIstiorevisiontag
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIstiorevisiontag
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIstiorevisiontag        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIstiorevisiontag          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIstiorevisiontag = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiorevisiontag.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// DeepCopyInto supports using IstioRevisionTagSpec within kubernetes types, where deepcopy-gen is used.
+func (in *IstioRevisionTagSpec) DeepCopyInto(out *IstioRevisionTagSpec) {
+	p := proto.Clone(in).(*IstioRevisionTagSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioRevisionTagSpec. Required by controller-gen.
+func (in *IstioRevisionTagSpec) DeepCopy() *IstioRevisionTagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioRevisionTagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioRevisionTagSpec. Required by controller-gen.
+func (in *IstioRevisionTagSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using IstioRevisionTagStatus within kubernetes types, where deepcopy-gen is used.
+func (in *IstioRevisionTagStatus) DeepCopyInto(out *IstioRevisionTagStatus) {
+	p := proto.Clone(in).(*IstioRevisionTagStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioRevisionTagStatus. Required by controller-gen.
+func (in *IstioRevisionTagStatus) DeepCopy() *IstioRevisionTagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioRevisionTagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new IstioRevisionTagStatus. Required by controller-gen.
+func (in *IstioRevisionTagStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -0,0 +1,46 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/istiorevisiontag.proto
+
+package v1alpha1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// MarshalJSON is a custom marshaler for IstioRevisionTagSpec
+func (this *IstioRevisionTagSpec) MarshalJSON() ([]byte, error) {
+	str, err := IstiorevisiontagMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioRevisionTagSpec
+func (this *IstioRevisionTagSpec) UnmarshalJSON(b []byte) error {
+	return IstiorevisiontagUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for IstioRevisionTagStatus
+func (this *IstioRevisionTagStatus) MarshalJSON() ([]byte, error) {
+	str, err := IstiorevisiontagMarshaler.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for IstioRevisionTagStatus
+func (this *IstioRevisionTagStatus) UnmarshalJSON(b []byte) error {
+	return IstiorevisiontagUnmarshaler.Unmarshal(bytes.NewReader(b), this)
+}
+
+var (
+	IstiorevisiontagMarshaler   = &github_com_gogo_protobuf_jsonpb.Marshaler{Int64Uint64asIntegers: true}
+	IstiorevisiontagUnmarshaler = &github_com_gogo_protobuf_jsonpb.Unmarshaler{AllowUnknownFields: true}
+)
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// IstioRevisionTag is the Schema for the istiorevisiontags API
+// +kubebuilder:resource:path=istiorevisiontags,scope=Cluster,shortName=irt;istiotag
+type IstioRevisionTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   *IstioRevisionTagSpec  `json:"spec,omitempty"`
+	Status IstioRevisionTagStatus `json:"status,omitempty"`
+}
+
+func (t *IstioRevisionTag) SetStatus(status ConfigState, errorMessage string) {
+	t.Status.Status = status
+	t.Status.ErrorMessage = errorMessage
+}
+
+func (t *IstioRevisionTag) GetStatus() IstioRevisionTagStatus {
+	return t.Status
+}
+
+func (t *IstioRevisionTag) SetCondition(condition Condition) {
+	SetCondition(&t.Status.Conditions, condition)
+}
+
+func (t *IstioRevisionTag) GetCondition(conditionType string) *Condition {
+	return FindCondition(t.Status.Conditions, conditionType)
+}
+
+func (t *IstioRevisionTag) SetObservedGeneration(generation int64) {
+	t.Status.ObservedGeneration = generation
+}
+
+func (t *IstioRevisionTag) GetSpec() *IstioRevisionTagSpec {
+	if t.Spec != nil {
+		return t.Spec
+	}
+
+	return nil
+}
+
+// Tag returns the value of the istio.io/rev label which selects the namespaces of the tag
+func (t *IstioRevisionTag) Tag() string {
+	return t.GetName()
+}
+
+// +kubebuilder:object:root=true
+
+// IstioRevisionTagList contains a list of IstioRevisionTag
+type IstioRevisionTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IstioRevisionTag `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IstioRevisionTag{}, &IstioRevisionTagList{})
+}
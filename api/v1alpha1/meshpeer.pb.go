@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/meshpeer.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	io "io"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MeshPeer is a remote cluster whose Istio control plane is mirrored into a PeerIstioControlPlane in the namespace
+// of the peer, and whose root CA configmaps are synced to the local cluster when the local control plane is passive
+//
+// <!-- crd generation tags
+// +cue-gen:MeshPeer:groupName:servicemesh.cisco.com
+// +cue-gen:MeshPeer:version:v1alpha1
+// +cue-gen:MeshPeer:storageVersion
+// +cue-gen:MeshPeer:annotations:helm.sh/resource-policy=keep
+// +cue-gen:MeshPeer:subresource:status
+// +cue-gen:MeshPeer:scope:Namespaced
+// +cue-gen:MeshPeer:resource:shortNames=mp,meshpeer
+// +cue-gen:MeshPeer:printerColumn:name="Cluster",type="string",JSONPath=".status.clusterID",description="ID of the remote cluster"
+// +cue-gen:MeshPeer:printerColumn:name="Peer",type="string",JSONPath=".status.peerIstioControlPlane",description="Name of the peer Istio control plane"
+// +cue-gen:MeshPeer:printerColumn:name="Status",type="string",JSONPath=".status.status",description="Status of the resource"
+// +cue-gen:MeshPeer:printerColumn:name="Error",type="string",JSONPath=".status.errorMessage",description="Error message"
+// +cue-gen:MeshPeer:printerColumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +cue-gen:MeshPeer:preserveUnknownFields:false
+// +cue-gen:MeshPeer:specIsRequired
+// -->
+//
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type MeshPeerSpec struct {
+	// Secret which holds the kubeconfig of the remote cluster, the namespace of the mesh peer is used if the namespace is not set
+	KubeconfigSecret *NamespacedName `protobuf:"bytes,1,opt,name=kubeconfigSecret,proto3" json:"kubeconfigSecret,omitempty"`
+	// Key of the kubeconfig in the secret, the secret must have a single key if it is not set,
+	// like the reader secrets the control planes create for their cluster
+	KubeconfigSecretKey string `protobuf:"bytes,2,opt,name=kubeconfigSecretKey,proto3" json:"kubeconfigSecretKey,omitempty"`
+	// Istio control plane on the remote cluster, the namespace of the mesh peer is used if the namespace is not set
+	IstioControlPlane    *NamespacedName `protobuf:"bytes,3,opt,name=istioControlPlane,proto3" json:"istioControlPlane,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MeshPeerSpec) Reset()         { *m = MeshPeerSpec{} }
+func (m *MeshPeerSpec) String() string { return proto.CompactTextString(m) }
+func (*MeshPeerSpec) ProtoMessage()    {}
+func (*MeshPeerSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bd9dc306720b8b, []int{0}
+}
+func (m *MeshPeerSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MeshPeerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MeshPeerSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MeshPeerSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeshPeerSpec.Merge(m, src)
+}
+func (m *MeshPeerSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MeshPeerSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeshPeerSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeshPeerSpec proto.InternalMessageInfo
+
+func (m *MeshPeerSpec) GetKubeconfigSecret() *NamespacedName {
+	if m != nil {
+		return m.KubeconfigSecret
+	}
+	return nil
+}
+
+func (m *MeshPeerSpec) GetKubeconfigSecretKey() string {
+	if m != nil {
+		return m.KubeconfigSecretKey
+	}
+	return ""
+}
+
+func (m *MeshPeerSpec) GetIstioControlPlane() *NamespacedName {
+	if m != nil {
+		return m.IstioControlPlane
+	}
+	return nil
+}
+
+// <!-- go code generation tags
+// +genclient
+// +k8s:deepcopy-gen=true
+// -->
+type MeshPeerStatus struct {
+	// Reconciliation status of the mesh peer
+	Status ConfigState `protobuf:"varint,1,opt,name=status,proto3,enum=istio_operator.v2.api.v1alpha1.ConfigState" json:"status,omitempty"`
+	// Reconciliation error message if any
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// ID of the remote cluster as reported by the remote Istio control plane
+	ClusterID string `protobuf:"bytes,3,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	// Name of the peer Istio control plane the remote Istio control plane is mirrored to
+	PeerIstioControlPlane string `protobuf:"bytes,4,opt,name=peerIstioControlPlane,proto3" json:"peerIstioControlPlane,omitempty"`
+	// Last time the remote Istio control plane was synced successfully
+	LastSyncTime *types.Timestamp `protobuf:"bytes,5,opt,name=lastSyncTime,proto3" json:"lastSyncTime,omitempty"`
+	// Latest available observations of the state of the mesh peer
+	Conditions []Condition `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions"`
+	// Generation of the mesh peer which was last reconciled
+	ObservedGeneration   int64    `protobuf:"varint,7,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeshPeerStatus) Reset()         { *m = MeshPeerStatus{} }
+func (m *MeshPeerStatus) String() string { return proto.CompactTextString(m) }
+func (*MeshPeerStatus) ProtoMessage()    {}
+func (*MeshPeerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5bd9dc306720b8b, []int{1}
+}
+func (m *MeshPeerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MeshPeerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MeshPeerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MeshPeerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeshPeerStatus.Merge(m, src)
+}
+func (m *MeshPeerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MeshPeerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeshPeerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeshPeerStatus proto.InternalMessageInfo
+
+func (m *MeshPeerStatus) GetStatus() ConfigState {
+	if m != nil {
+		return m.Status
+	}
+	return ConfigState_Unspecified
+}
+
+func (m *MeshPeerStatus) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *MeshPeerStatus) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *MeshPeerStatus) GetPeerIstioControlPlane() string {
+	if m != nil {
+		return m.PeerIstioControlPlane
+	}
+	return ""
+}
+
+func (m *MeshPeerStatus) GetLastSyncTime() *types.Timestamp {
+	if m != nil {
+		return m.LastSyncTime
+	}
+	return nil
+}
+
+func (m *MeshPeerStatus) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *MeshPeerStatus) GetObservedGeneration() int64 {
+	if m != nil {
+		return m.ObservedGeneration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MeshPeerSpec)(nil), "istio_operator.v2.api.v1alpha1.MeshPeerSpec")
+	proto.RegisterType((*MeshPeerStatus)(nil), "istio_operator.v2.api.v1alpha1.MeshPeerStatus")
+}
+
+func init() { proto.RegisterFile("api/v1alpha1/meshpeer.proto", fileDescriptor_d5bd9dc306720b8b) }
+
+var fileDescriptor_d5bd9dc306720b8b = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x35, 0x6d, 0xad, 0x74, 0xb6, 0x2c, 0x3a, 0x2a, 0xc4, 0x2a, 0xdd, 0xd2, 0xa7, 0x8a, 0x38,
+	0x71, 0xab, 0xbe, 0x0a, 0xb6, 0x82, 0x2c, 0xb2, 0xba, 0xa4, 0xfb, 0xe4, 0xcb, 0x3a, 0x99, 0xde,
+	0x26, 0x83, 0xc9, 0xdc, 0x30, 0x33, 0x09, 0xac, 0x1f, 0xe0, 0xa3, 0xdf, 0xb5, 0x8f, 0x7e, 0x81,
+	0x48, 0xbf, 0x44, 0x32, 0x69, 0x75, 0xbb, 0x2d, 0x2a, 0xec, 0xdb, 0xcd, 0xbd, 0xe7, 0xdc, 0x39,
+	0xf7, 0x70, 0x42, 0x1e, 0xf2, 0x5c, 0x06, 0xe5, 0x21, 0x4f, 0xf3, 0x84, 0x1f, 0x06, 0x19, 0x98,
+	0x24, 0x07, 0xd0, 0x2c, 0xd7, 0x68, 0x91, 0xf6, 0xa5, 0xb1, 0x12, 0xcf, 0x30, 0x07, 0xcd, 0x2d,
+	0x6a, 0x56, 0x8e, 0x19, 0xcf, 0x25, 0x5b, 0xc3, 0x7b, 0x0f, 0x36, 0xc8, 0x02, 0xb3, 0x0c, 0x55,
+	0x4d, 0xed, 0xdd, 0x8b, 0x31, 0x46, 0x57, 0x06, 0x55, 0xb5, 0xea, 0x1e, 0xc4, 0x88, 0x71, 0x0a,
+	0x41, 0xc5, 0x5b, 0x48, 0x48, 0xe7, 0x67, 0x11, 0x24, 0xbc, 0x94, 0xa8, 0xaf, 0x00, 0xdc, 0x57,
+	0x54, 0x2c, 0x02, 0x2b, 0x33, 0x30, 0x96, 0x67, 0x79, 0x0d, 0x18, 0x7e, 0x6d, 0x90, 0xee, 0x31,
+	0x98, 0xe4, 0x04, 0x40, 0xcf, 0x72, 0x10, 0xf4, 0x13, 0xb9, 0xfd, 0xb9, 0x88, 0x40, 0xa0, 0x5a,
+	0xc8, 0x78, 0x06, 0x42, 0x83, 0xf5, 0xbd, 0x81, 0x37, 0xda, 0x1b, 0x33, 0xf6, 0x77, 0xf9, 0xec,
+	0x3d, 0xcf, 0xc0, 0xe4, 0x5c, 0xc0, 0xbc, 0xaa, 0x26, 0xad, 0xe5, 0x6b, 0xaf, 0x11, 0x6e, 0x6d,
+	0xa3, 0xcf, 0xc8, 0xdd, 0xab, 0xbd, 0x77, 0x70, 0xee, 0x37, 0x06, 0xde, 0xa8, 0x13, 0xee, 0x1a,
+	0xd1, 0x88, 0xdc, 0x71, 0x4f, 0x4f, 0x51, 0x59, 0x8d, 0xe9, 0x49, 0xca, 0x15, 0xf8, 0xcd, 0x6b,
+	0x88, 0xda, 0x5e, 0x37, 0xfc, 0xd6, 0x24, 0xfb, 0xbf, 0x8d, 0xb0, 0xdc, 0x16, 0x86, 0x4e, 0x49,
+	0xdb, 0xb8, 0xca, 0x19, 0xb0, 0x3f, 0x7e, 0xf2, 0xaf, 0xb7, 0xa6, 0xb5, 0x6e, 0xcb, 0x2d, 0x84,
+	0x2b, 0x2a, 0x1d, 0x92, 0x2e, 0x68, 0x8d, 0xfa, 0x18, 0x8c, 0xe1, 0x31, 0xac, 0xce, 0xdc, 0xe8,
+	0xd1, 0x47, 0xa4, 0x23, 0xd2, 0xc2, 0x58, 0xd0, 0x47, 0x6f, 0xdc, 0x5d, 0x9d, 0xf0, 0x4f, 0x83,
+	0xbe, 0x20, 0xf7, 0xab, 0x0c, 0x1d, 0x6d, 0x39, 0xd0, 0x72, 0xc8, 0xdd, 0x43, 0xfa, 0x8a, 0x74,
+	0x53, 0x6e, 0xec, 0xec, 0x5c, 0x89, 0x53, 0x99, 0x81, 0x7f, 0xd3, 0xd9, 0xd5, 0x63, 0x75, 0x20,
+	0xd8, 0x3a, 0x10, 0xec, 0x74, 0x1d, 0x88, 0x70, 0x03, 0x4f, 0x3f, 0x10, 0x22, 0x50, 0xcd, 0xa5,
+	0x95, 0xa8, 0x8c, 0xdf, 0x1e, 0x34, 0x47, 0x7b, 0xe3, 0xc7, 0xff, 0x61, 0x40, 0xcd, 0x98, 0xb4,
+	0x2e, 0x7e, 0x1c, 0xdc, 0x08, 0x2f, 0xad, 0xa0, 0x8c, 0x50, 0x8c, 0x0c, 0xe8, 0x12, 0xe6, 0x6f,
+	0x41, 0x55, 0x0b, 0x24, 0x2a, 0xff, 0xd6, 0xc0, 0x1b, 0x35, 0xc3, 0x1d, 0x93, 0xc9, 0xf4, 0x62,
+	0xd9, 0xf7, 0xbe, 0x2f, 0xfb, 0xde, 0xcf, 0x65, 0xdf, 0xfb, 0xf8, 0x32, 0x96, 0x36, 0x29, 0x22,
+	0x26, 0x30, 0x0b, 0x22, 0xae, 0xbe, 0x70, 0x29, 0x52, 0x2c, 0xe6, 0x81, 0x13, 0xf4, 0x74, 0x2d,
+	0x28, 0x28, 0xc7, 0xc1, 0xe5, 0x7f, 0x28, 0x6a, 0xbb, 0x3b, 0x9f, 0xff, 0x1a, 0x00, 0xb7, 0x58,
+	0x56, 0xa5, 0x97, 0x03, 0x00, 0x00,
+}
+
+func (m *MeshPeerSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshPeerSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshPeerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IstioControlPlane != nil {
+		{
+			size, err := m.IstioControlPlane.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshpeer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KubeconfigSecretKey) > 0 {
+		i -= len(m.KubeconfigSecretKey)
+		copy(dAtA[i:], m.KubeconfigSecretKey)
+		i = encodeVarintMeshpeer(dAtA, i, uint64(len(m.KubeconfigSecretKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.KubeconfigSecret != nil {
+		{
+			size, err := m.KubeconfigSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshpeer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MeshPeerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MeshPeerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MeshPeerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintMeshpeer(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMeshpeer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastSyncTime != nil {
+		{
+			size, err := m.LastSyncTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshpeer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeerIstioControlPlane) > 0 {
+		i -= len(m.PeerIstioControlPlane)
+		copy(dAtA[i:], m.PeerIstioControlPlane)
+		i = encodeVarintMeshpeer(dAtA, i, uint64(len(m.PeerIstioControlPlane)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClusterID) > 0 {
+		i -= len(m.ClusterID)
+		copy(dAtA[i:], m.ClusterID)
+		i = encodeVarintMeshpeer(dAtA, i, uint64(len(m.ClusterID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintMeshpeer(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintMeshpeer(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMeshpeer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMeshpeer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MeshPeerSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KubeconfigSecret != nil {
+		l = m.KubeconfigSecret.Size()
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	l = len(m.KubeconfigSecretKey)
+	if l > 0 {
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	if m.IstioControlPlane != nil {
+		l = m.IstioControlPlane.Size()
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MeshPeerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMeshpeer(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	l = len(m.PeerIstioControlPlane)
+	if l > 0 {
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	if m.LastSyncTime != nil {
+		l = m.LastSyncTime.Size()
+		n += 1 + l + sovMeshpeer(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMeshpeer(uint64(l))
+		}
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovMeshpeer(uint64(m.ObservedGeneration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMeshpeer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMeshpeer(x uint64) (n int) {
+	return sovMeshpeer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MeshPeerSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshpeer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MeshPeerSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MeshPeerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeconfigSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeconfigSecret == nil {
+				m.KubeconfigSecret = &NamespacedName{}
+			}
+			if err := m.KubeconfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeconfigSecretKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeconfigSecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstioControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IstioControlPlane == nil {
+				m.IstioControlPlane = &NamespacedName{}
+			}
+			if err := m.IstioControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshpeer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeshPeerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshpeer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MeshPeerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MeshPeerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ConfigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIstioControlPlane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIstioControlPlane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSyncTime == nil {
+				m.LastSyncTime = &types.Timestamp{}
+			}
+			if err := m.LastSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshpeer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshpeer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMeshpeer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMeshpeer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMeshpeer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMeshpeer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMeshpeer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMeshpeer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMeshpeer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMeshpeer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMeshpeer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,60 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/v1alpha1/meshpeer.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	_ "istio.io/gogo-genproto/googleapis/google/api"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// DeepCopyInto supports using MeshPeerSpec within kubernetes types, where deepcopy-gen is used.
+func (in *MeshPeerSpec) DeepCopyInto(out *MeshPeerSpec) {
+	p := proto.Clone(in).(*MeshPeerSpec)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshPeerSpec. Required by controller-gen.
+func (in *MeshPeerSpec) DeepCopy() *MeshPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshPeerSpec. Required by controller-gen.
+func (in *MeshPeerSpec) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using MeshPeerStatus within kubernetes types, where deepcopy-gen is used.
+func (in *MeshPeerStatus) DeepCopyInto(out *MeshPeerStatus) {
+	p := proto.Clone(in).(*MeshPeerStatus)
+	*out = *p
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshPeerStatus. Required by controller-gen.
+func (in *MeshPeerStatus) DeepCopy() *MeshPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new MeshPeerStatus. Required by controller-gen.
+func (in *MeshPeerStatus) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +kubebuilder:object:root=true
+
+// MeshPeer is the Schema for the meshpeers API
+// +kubebuilder:resource:path=meshpeers,shortName=mp
+type MeshPeer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   *MeshPeerSpec  `json:"spec,omitempty"`
+	Status MeshPeerStatus `json:"status,omitempty"`
+}
+
+func (p *MeshPeer) SetStatus(status ConfigState, errorMessage string) {
+	p.Status.Status = status
+	p.Status.ErrorMessage = errorMessage
+}
+
+func (p *MeshPeer) GetStatus() MeshPeerStatus {
+	return p.Status
+}
+
+func (p *MeshPeer) SetCondition(condition Condition) {
+	SetCondition(&p.Status.Conditions, condition)
+}
+
+func (p *MeshPeer) GetCondition(conditionType string) *Condition {
+	return FindCondition(p.Status.Conditions, conditionType)
+}
+
+func (p *MeshPeer) SetObservedGeneration(generation int64) {
+	p.Status.ObservedGeneration = generation
+}
+
+func (p *MeshPeer) GetSpec() *MeshPeerSpec {
+	if p.Spec != nil {
+		return p.Spec
+	}
+
+	return nil
+}
+
+// KubeconfigSecret returns the reference of the secret which holds the kubeconfig of the remote cluster
+func (p *MeshPeer) KubeconfigSecret() types.NamespacedName {
+	return p.withDefaultNamespace(p.GetSpec().GetKubeconfigSecret())
+}
+
+// RemoteIstioControlPlane returns the reference of the Istio control plane on the remote cluster
+func (p *MeshPeer) RemoteIstioControlPlane() types.NamespacedName {
+	return p.withDefaultNamespace(p.GetSpec().GetIstioControlPlane())
+}
+
+// PeerIstioControlPlaneName returns the name of the peer Istio control plane the remote Istio control plane is
+// mirrored to, it follows the <control plane>-<cluster> naming of the cluster registry resource sync rules
+func (p *MeshPeer) PeerIstioControlPlaneName() string {
+	return fmt.Sprintf("%s-%s", p.RemoteIstioControlPlane().Name, p.GetName())
+}
+
+func (p *MeshPeer) withDefaultNamespace(ref *NamespacedName) types.NamespacedName {
+	nn := types.NamespacedName{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}
+	if nn.Namespace == "" {
+		nn.Namespace = p.GetNamespace()
+	}
+
+	return nn
+}
+
+// +kubebuilder:object:root=true
+
+// MeshPeerList contains a list of MeshPeer
+type MeshPeerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshPeer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshPeer{}, &MeshPeerList{})
+}
@@ -47,6 +47,7 @@ func NewComponentReconciler(r components.Reconciler, newComponentFunc components
 			d,
 			templatereconciler.WithNativeReconcilerOptions(
 				reconciler.NativeReconcilerSetControllerRef(),
+				reconciler.NativeReconcilerWithModifier(components.RecordApply),
 			),
 			templatereconciler.WithGenericReconcilerOptions(
 				reconciler.WithEnableRecreateWorkload(),
@@ -18,16 +18,162 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"io/fs"
+	"sync"
 
 	"emperror.dev/errors"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/assets"
 	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
 	"github.com/banzaicloud/operator-tools/pkg/logger"
 )
 
+const (
+	defaultNetworkName = "network1"
+)
+
+type chartImageDefaults struct {
+	Global struct {
+		Hub   string `json:"hub"`
+		Proxy struct {
+			Image string `json:"image"`
+		} `json:"proxy"`
+		ProxyInit struct {
+			Image string `json:"image"`
+		} `json:"proxy_init"`
+	} `json:"global"`
+}
+
+var (
+	imageDefaults   = map[string]chartImageDefaults{}
+	imageDefaultsMu sync.Mutex
+)
+
+func getChartImageDefaults(bundle *assets.ChartBundle) (chartImageDefaults, error) {
+	imageDefaultsMu.Lock()
+	defer imageDefaultsMu.Unlock()
+
+	if defaults, ok := imageDefaults[bundle.Version]; ok {
+		return defaults, nil
+	}
+
+	var defaults chartImageDefaults
+
+	values, err := fs.ReadFile(bundle.DiscoveryChart, "values.yaml")
+	if err != nil {
+		return defaults, errors.WrapIfWithDetails(err, "could not read chart values", "version", bundle.Version)
+	}
+
+	if err := yaml.Unmarshal(values, &defaults); err != nil {
+		return defaults, errors.WrapIfWithDetails(err, "could not parse chart values", "version", bundle.Version)
+	}
+
+	imageDefaults[bundle.Version] = defaults
+
+	return defaults, nil
+}
+
+// SetDefaults fills in the defaults of an IstioControlPlane which would otherwise only be computed in memory
+// on every reconcile, so that they can be persisted in the stored spec. The old object is nil on create.
+func SetDefaults(ctx context.Context, kubeClient client.Client, icp *v1alpha1.IstioControlPlane, old *v1alpha1.IstioControlPlane, k8sConfig *rest.Config, logger logger.Logger, clusterRegistryAPIEnabled bool) error {
+	if err := SetStaticDefaults(icp, old); err != nil {
+		return err
+	}
+
+	// changing the mesh ID of a running mesh is disruptive, so it is only defaulted for new control planes
+	if old == nil && icp.Spec.MeshID == "" {
+		meshes := &v1alpha1.IstioMeshList{}
+		if err := kubeClient.List(ctx, meshes, client.InNamespace(icp.GetNamespace())); err != nil {
+			return errors.WrapIf(err, "could not list Istio meshes")
+		}
+
+		if len(meshes.Items) == 1 {
+			icp.Spec.MeshID = meshes.Items[0].GetName()
+		}
+	}
+
+	return setDynamicDefaults(ctx, kubeClient, icp, k8sConfig, logger, clusterRegistryAPIEnabled)
+}
+
+// SetStaticDefaults fills in the defaults of an IstioControlPlane which do not depend on the state of the cluster
+func SetStaticDefaults(icp *v1alpha1.IstioControlPlane, old *v1alpha1.IstioControlPlane) error {
+	if icp.Spec.Mode == v1alpha1.ModeType_UNSPECIFIED {
+		icp.Spec.Mode = v1alpha1.ModeType_ACTIVE
+	}
+
+	if icp.Spec.NetworkName == "" {
+		icp.Spec.NetworkName = defaultNetworkName
+	}
+
+	return setProxyImageDefaults(icp, old)
+}
+
+// setProxyImageDefaults sets the proxy images when a tag is pinned through the global container image configuration,
+// images that were set by a previous defaulting follow the changes of that configuration
+func setProxyImageDefaults(icp *v1alpha1.IstioControlPlane, old *v1alpha1.IstioControlPlane) error {
+	proxyImage, proxyInitImage, err := proxyImagesFromContainerImageConfiguration(icp.Spec.GetVersion(), icp.Spec.GetContainerImageConfiguration())
+	if err != nil || proxyImage == "" {
+		return err
+	}
+
+	var oldProxyImage, oldProxyInitImage string
+	if old != nil && old.Spec != nil {
+		// the chart defaults of the previous version are used, so that images follow version changes as well
+		oldProxyImage, oldProxyInitImage, err = proxyImagesFromContainerImageConfiguration(old.Spec.GetVersion(), old.Spec.GetContainerImageConfiguration())
+		if err != nil {
+			return err
+		}
+	}
+
+	if image := icp.Spec.GetProxy().GetImage(); image == "" || image == oldProxyImage {
+		if icp.Spec.Proxy == nil {
+			icp.Spec.Proxy = &v1alpha1.ProxyConfiguration{}
+		}
+		icp.Spec.Proxy.Image = proxyImage
+	}
+
+	if image := icp.Spec.GetProxyInit().GetImage(); image == "" || image == oldProxyInitImage {
+		if icp.Spec.ProxyInit == nil {
+			icp.Spec.ProxyInit = &v1alpha1.ProxyInitConfiguration{}
+		}
+		icp.Spec.ProxyInit.Image = proxyInitImage
+	}
+
+	return nil
+}
+
+// proxyImagesFromContainerImageConfiguration returns empty images when no tag is pinned or when there is no chart bundle
+// for the version, the latter is rejected by validation anyway
+func proxyImagesFromContainerImageConfiguration(version string, config *v1alpha1.ContainerImageConfiguration) (string, string, error) {
+	if config.GetTag() == "" {
+		return "", "", nil
+	}
+
+	bundle, err := assets.GetChartBundle(version)
+	if err != nil {
+		return "", "", nil // nolint:nilerr
+	}
+
+	defaults, err := getChartImageDefaults(bundle)
+	if err != nil {
+		return "", "", err
+	}
+
+	hub := config.GetHub()
+	if hub == "" {
+		hub = defaults.Global.Hub
+	}
+
+	return fmt.Sprintf("%s/%s:%s", hub, defaults.Global.Proxy.Image, config.GetTag()),
+		fmt.Sprintf("%s/%s:%s", hub, defaults.Global.ProxyInit.Image, config.GetTag()),
+		nil
+}
+
 func setDynamicDefaults(ctx context.Context, kubeClient client.Client, icp *v1alpha1.IstioControlPlane, k8sConfig *rest.Config, logger logger.Logger, clusterRegistryAPIEnabled bool) error {
 	if icp.Spec.JwtPolicy == v1alpha1.JWTPolicyType_UNSPECIFIED {
 		// try to detect supported jwt policy
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+)
+
+// reasons of the events recorded on the control planes, the mesh gateways and the mesh peers
+const (
+	eventReasonComponentReconcileFailed   = "ComponentReconcileFailed"
+	eventReasonModeChanged                = "ModeChanged"
+	eventReasonCARootCertificateChanged   = "CARootCertificateChanged"
+	eventReasonGatewayAddressChanged      = "GatewayAddressChanged"
+	eventReasonUnsupportedVersion         = "UnsupportedVersion"
+	eventReasonFinalizerRemoved           = "FinalizerRemoved"
+	eventReasonCertificateIssued          = "CertificateIssued"
+	eventReasonCertificateRotated         = "CertificateRotated"
+	eventReasonIntermediateCAIssued       = "IntermediateCAIssued"
+	eventReasonIntermediateCARotated      = "IntermediateCARotated"
+	eventReasonRootCARotationPhaseChanged = "RootCARotationPhaseChanged"
+	eventReasonRootCARotationBatchStarted = "RootCARotationBatchStarted"
+	eventReasonRemoteClusterConnected     = "RemoteClusterConnected"
+	eventReasonRemoteClusterStateChanged  = "RemoteClusterStateChanged"
+	eventReasonReaderSecretSynced         = "ReaderSecretSynced"
+)
+
+// recordGatewayAddressChange records an event on the object when its gateway address has changed,
+// the first assignment of the address is reported as well
+func recordGatewayAddressChange(recorder record.EventRecorder, obj client.Object, previous, current []string) {
+	if len(current) == 0 || reflect.DeepEqual(previous, current) {
+		return
+	}
+
+	if len(previous) == 0 {
+		recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonGatewayAddressChanged, "gateway address assigned: %s", strings.Join(current, ", "))
+
+		return
+	}
+
+	recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonGatewayAddressChanged, "gateway address changed from %s to %s", strings.Join(previous, ", "), strings.Join(current, ", "))
+}
+
+// recordCertificateChange records an event on the mesh gateway when a new TLS certificate has been issued,
+// it is reported as a rotation when it replaces a previous certificate
+func recordCertificateChange(recorder record.EventRecorder, obj client.Object, previous, current *servicemeshv1alpha1.IstioMeshGatewayTLSStatus) {
+	if current.GetNotBefore() == nil || current.GetNotBefore().Equal(previous.GetNotBefore()) {
+		return
+	}
+
+	expiry := current.GetNotAfter().String()
+	if notAfter, err := types.TimestampFromProto(current.GetNotAfter()); err == nil {
+		expiry = notAfter.UTC().Format("2006-01-02")
+	}
+
+	if previous.GetNotBefore() == nil {
+		recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonCertificateIssued, "certificate issued into secret %s by %s, expires %s", current.GetSecretName(), current.GetIssuer(), expiry)
+
+		return
+	}
+
+	recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonCertificateRotated, "certificate in secret %s rotated by %s, expires %s", current.GetSecretName(), current.GetIssuer(), expiry)
+}
+
+// recordIntermediateCAChange records an event on the control plane when a new intermediate CA has been issued
+// into the plug-in CA secret of istiod, it is reported as a rotation when it replaces a previous intermediate CA
+func recordIntermediateCAChange(recorder record.EventRecorder, obj client.Object, previous, current *servicemeshv1alpha1.CAStatus) {
+	if current.GetNotBefore() == nil || current.GetNotBefore().Equal(previous.GetNotBefore()) {
+		return
+	}
+
+	expiry := current.GetNotAfter().String()
+	if notAfter, err := types.TimestampFromProto(current.GetNotAfter()); err == nil {
+		expiry = notAfter.UTC().Format("2006-01-02")
+	}
+
+	if previous.GetNotBefore() == nil {
+		recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonIntermediateCAIssued, "intermediate CA issued by root CA %s, expires %s", current.GetRootCASecret(), expiry)
+
+		return
+	}
+
+	recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonIntermediateCARotated, "intermediate CA rotated by root CA %s, expires %s", current.GetRootCASecret(), expiry)
+}
+
+// removeFinalizer removes the finalizer from the object the same way as util.RemoveFinalizer
+// and records an event on the object when the finalizer was actually removed
+func removeFinalizer(ctx context.Context, c client.Client, recorder record.EventRecorder, obj client.Object, finalizerID string, onDeleteOnly bool) error {
+	if onDeleteOnly && obj.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	if !util.ContainsString(obj.GetFinalizers(), finalizerID) {
+		return nil
+	}
+
+	if err := util.RemoveFinalizer(ctx, c, obj, finalizerID, onDeleteOnly); err != nil {
+		return err
+	}
+
+	recorder.Eventf(obj, corev1.EventTypeNormal, eventReasonFinalizerRemoved, "finalizer %s removed", finalizerID)
+
+	return nil
+}
+
+// describeCertificate returns a short description of the first certificate of the PEM data for events
+func describeCertificate(data string) string {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return "unknown certificate"
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "unknown certificate"
+	}
+
+	return fmt.Sprintf("%q (serial %s, expires %s)", cert.Subject.String(), cert.SerialNumber.String(), cert.NotAfter.UTC().Format("2006-01-02"))
+}
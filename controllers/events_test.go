@@ -0,0 +1,367 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// recordedEvents returns the events recorded so far by the fake recorder
+func recordedEvents(recorder *record.FakeRecorder) []string {
+	events := make([]string, 0)
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestRecordGatewayAddressChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		previous []string
+		current  []string
+		expected []string
+	}{
+		{
+			name:     "first assignment",
+			current:  []string{"10.0.0.1", "10.0.0.2"},
+			expected: []string{"Normal GatewayAddressChanged gateway address assigned: 10.0.0.1, 10.0.0.2"},
+		},
+		{
+			name:     "changed address",
+			previous: []string{"10.0.0.1"},
+			current:  []string{"10.0.0.2"},
+			expected: []string{"Normal GatewayAddressChanged gateway address changed from 10.0.0.1 to 10.0.0.2"},
+		},
+		{
+			name:     "unchanged address",
+			previous: []string{"10.0.0.1"},
+			current:  []string{"10.0.0.1"},
+			expected: []string{},
+		},
+		{
+			name:     "address is gone",
+			previous: []string{"10.0.0.1"},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(10)
+			recordGatewayAddressChange(recorder, newTestMeshGateway("imgw", newUpgradeTestControlPlane("cp-v112x"), nil, servicemeshv1alpha1.ConfigState_Available), tc.previous, tc.current)
+
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expected); diff != "" {
+				t.Fatalf("unexpected events (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordCertificateChange(t *testing.T) {
+	t.Parallel()
+
+	issued, _ := types.TimestampProto(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	rotated, _ := types.TimestampProto(time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC))
+	expiry, _ := types.TimestampProto(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	certificate := func(notBefore *types.Timestamp) *servicemeshv1alpha1.IstioMeshGatewayTLSStatus {
+		return &servicemeshv1alpha1.IstioMeshGatewayTLSStatus{
+			SecretName: "imgw-tls",
+			Issuer:     "ClusterIssuer/letsencrypt",
+			NotBefore:  notBefore,
+			NotAfter:   expiry,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		previous *servicemeshv1alpha1.IstioMeshGatewayTLSStatus
+		current  *servicemeshv1alpha1.IstioMeshGatewayTLSStatus
+		expected []string
+	}{
+		{
+			name:     "issued certificate",
+			current:  certificate(issued),
+			expected: []string{"Normal CertificateIssued certificate issued into secret imgw-tls by ClusterIssuer/letsencrypt, expires 2022-06-01"},
+		},
+		{
+			name:     "rotated certificate",
+			previous: certificate(issued),
+			current:  certificate(rotated),
+			expected: []string{"Normal CertificateRotated certificate in secret imgw-tls rotated by ClusterIssuer/letsencrypt, expires 2022-06-01"},
+		},
+		{
+			name:     "unchanged certificate",
+			previous: certificate(issued),
+			current:  certificate(issued),
+			expected: []string{},
+		},
+		{
+			name:     "pending certificate",
+			current:  certificate(nil),
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(10)
+			recordCertificateChange(recorder, newTestMeshGateway("imgw", newUpgradeTestControlPlane("cp-v112x"), nil, servicemeshv1alpha1.ConfigState_Available), tc.previous, tc.current)
+
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expected); diff != "" {
+				t.Fatalf("unexpected events (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordIntermediateCAChange(t *testing.T) {
+	t.Parallel()
+
+	issued, _ := types.TimestampProto(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	rotated, _ := types.TimestampProto(time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC))
+	expiry, _ := types.TimestampProto(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	ca := func(notBefore *types.Timestamp) *servicemeshv1alpha1.CAStatus {
+		return &servicemeshv1alpha1.CAStatus{
+			RootCASecret: "root-ca",
+			NotBefore:    notBefore,
+			NotAfter:     expiry,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		previous *servicemeshv1alpha1.CAStatus
+		current  *servicemeshv1alpha1.CAStatus
+		expected []string
+	}{
+		{
+			name:     "issued intermediate CA",
+			current:  ca(issued),
+			expected: []string{"Normal IntermediateCAIssued intermediate CA issued by root CA root-ca, expires 2023-03-01"},
+		},
+		{
+			name:     "rotated intermediate CA",
+			previous: ca(issued),
+			current:  ca(rotated),
+			expected: []string{"Normal IntermediateCARotated intermediate CA rotated by root CA root-ca, expires 2023-03-01"},
+		},
+		{
+			name:     "unchanged intermediate CA",
+			previous: ca(issued),
+			current:  ca(issued),
+			expected: []string{},
+		},
+		{
+			name:     "no intermediate CA",
+			previous: ca(issued),
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(10)
+			recordIntermediateCAChange(recorder, newUpgradeTestControlPlane("cp-v112x"), tc.previous, tc.current)
+
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expected); diff != "" {
+				t.Fatalf("unexpected events (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDescribeCertificate(t *testing.T) {
+	t.Parallel()
+
+	certPEM, cert := newTestRootCA(t, "root-ca")
+
+	expected := fmt.Sprintf("\"CN=root-ca,O=Istio\" (serial %s, expires %s)", cert.SerialNumber.String(), cert.NotAfter.UTC().Format("2006-01-02"))
+	if description := describeCertificate(certPEM); description != expected {
+		t.Fatalf("unexpected description: %s, expected: %s", description, expected)
+	}
+
+	for _, data := range []string{"", "not a certificate", "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydGlmaWNhdGU=\n-----END CERTIFICATE-----\n"} {
+		if description := describeCertificate(data); description != "unknown certificate" {
+			t.Errorf("unexpected description of %q: %s", data, description)
+		}
+	}
+}
+
+func TestRemoveFinalizer(t *testing.T) {
+	t.Parallel()
+
+	deleted := metav1.Now()
+
+	tests := []struct {
+		name         string
+		finalizers   []string
+		deleted      bool
+		onDeleteOnly bool
+		remaining    []string
+		expected     []string
+	}{
+		{
+			name:         "finalizer of a deleted object",
+			finalizers:   []string{"istio-operator.servicemesh.cisco.com/finalizer", "other"},
+			deleted:      true,
+			onDeleteOnly: true,
+			remaining:    []string{"other"},
+			expected:     []string{"Normal FinalizerRemoved finalizer istio-operator.servicemesh.cisco.com/finalizer removed"},
+		},
+		{
+			name:       "finalizer of an object which is not deleted",
+			finalizers: []string{"istio-operator.servicemesh.cisco.com/finalizer"},
+			remaining:  nil,
+			expected:   []string{"Normal FinalizerRemoved finalizer istio-operator.servicemesh.cisco.com/finalizer removed"},
+		},
+		{
+			name:         "finalizer is kept until the object is deleted",
+			finalizers:   []string{"istio-operator.servicemesh.cisco.com/finalizer"},
+			onDeleteOnly: true,
+			remaining:    []string{"istio-operator.servicemesh.cisco.com/finalizer"},
+			expected:     []string{},
+		},
+		{
+			name:         "missing finalizer",
+			finalizers:   []string{"other"},
+			deleted:      true,
+			onDeleteOnly: true,
+			remaining:    []string{"other"},
+			expected:     []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "cm",
+					Namespace:  "istio-system",
+					Finalizers: tc.finalizers,
+				},
+			}
+			if tc.deleted {
+				cm.DeletionTimestamp = &deleted
+			}
+			c := newFakeClient(cm)
+			recorder := record.NewFakeRecorder(10)
+
+			if err := c.Get(ctx, client.ObjectKeyFromObject(cm), cm); err != nil {
+				t.Fatal(err)
+			}
+			if err := removeFinalizer(ctx, c, recorder, cm, "istio-operator.servicemesh.cisco.com/finalizer", tc.onDeleteOnly); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := c.Get(ctx, client.ObjectKeyFromObject(cm), cm); err != nil {
+				t.Fatal(err)
+			}
+			if diff := pretty.Compare(cm.GetFinalizers(), tc.remaining); diff != "" {
+				t.Fatalf("unexpected finalizers (-got +want):\n%s", diff)
+			}
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expected); diff != "" {
+				t.Fatalf("unexpected events (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetModeToStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		previous servicemeshv1alpha1.ModeType
+		mode     servicemeshv1alpha1.ModeType
+		expected []string
+	}{
+		{
+			name:     "changed mode",
+			previous: servicemeshv1alpha1.ModeType_ACTIVE,
+			mode:     servicemeshv1alpha1.ModeType_PASSIVE,
+			expected: []string{"Normal ModeChanged control plane mode changed from ACTIVE to PASSIVE"},
+		},
+		{
+			name:     "unchanged mode",
+			previous: servicemeshv1alpha1.ModeType_ACTIVE,
+			mode:     servicemeshv1alpha1.ModeType_ACTIVE,
+			expected: []string{},
+		},
+		{
+			name:     "first reconciliation",
+			previous: servicemeshv1alpha1.ModeType_UNSPECIFIED,
+			mode:     servicemeshv1alpha1.ModeType_ACTIVE,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			icp := newUpgradeTestControlPlane("cp-v112x")
+			icp.Spec.Mode = tc.mode
+			icp.Status.Mode = tc.previous
+
+			recorder := record.NewFakeRecorder(10)
+			r := &IstioControlPlaneReconciler{Recorder: recorder}
+			r.setModeToStatus(icp)
+
+			if icp.Status.Mode != tc.mode {
+				t.Fatalf("unexpected mode in the status: %s", icp.Status.Mode)
+			}
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expected); diff != "" {
+				t.Fatalf("unexpected events (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/gatewayapi"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+// GatewayClassReconciler accepts the Gateway API gateway classes which are handled by the operator
+type GatewayClassReconciler struct {
+	client.Client
+	Log    logger.Logger
+	Scheme *runtime.Scheme
+}
+
+func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	gc := &gatewayapiv1alpha2.GatewayClass{}
+	err := r.Get(ctx, req.NamespacedName, gc)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !gatewayapi.IsManaged(gc) {
+		return ctrl.Result{}, nil
+	}
+
+	current := gc.Status.DeepCopy()
+
+	icpKey, err := gatewayapi.ControlPlaneOf(gc)
+	if err == nil {
+		err = r.Get(ctx, icpKey, &servicemeshv1alpha1.IstioControlPlane{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+		if err != nil {
+			err = errors.Errorf("IstioControlPlane %s is not found", icpKey)
+		}
+	}
+	gatewayapi.SetGatewayClassAccepted(gc, err)
+
+	if equality.Semantic.DeepEqual(current, &gc.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, errors.WrapIf(r.Status().Update(ctx, gc), "could not update gateway class status")
+}
+
+func (r *GatewayClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctrl, err := ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayapiv1alpha2.GatewayClass{}, ctrlBuilder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// control planes come and go independently of the gateway classes which refer to them
+	return ctrl.Watch(&source.Kind{
+		Type: &servicemeshv1alpha1.IstioControlPlane{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "IstioControlPlane",
+				APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+		},
+	}, handler.EnqueueRequestsFromMapFunc(func(a client.Object) []reconcile.Request {
+		gcs := &gatewayapiv1alpha2.GatewayClassList{}
+		if err := r.Client.List(context.Background(), gcs); err != nil {
+			r.Log.Error(err, "could not list gateway classes")
+
+			return nil
+		}
+
+		resources := make([]reconcile.Request, 0)
+		for i := range gcs.Items {
+			if key, err := gatewayapi.ControlPlaneOf(&gcs.Items[i]); err == nil && key == client.ObjectKeyFromObject(a) {
+				resources = append(resources, reconcile.Request{
+					NamespacedName: client.ObjectKey{
+						Name: gcs.Items[i].GetName(),
+					},
+				})
+			}
+		}
+
+		return resources
+	}), predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return false
+		},
+	})
+}
+
+// GatewayReconciler provisions the Gateway API gateways of the gateway classes handled by the operator
+// through IstioMeshGateway resources, so they are deployed by the istio-meshgateway chart
+type GatewayReconciler struct {
+	client.Client
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("gateway", req.NamespacedName)
+
+	gw := &gatewayapiv1alpha2.Gateway{}
+	err := r.Get(ctx, req.NamespacedName, gw)
+	if err != nil {
+		// the mesh gateway of the gateway is garbage collected through its owner reference
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !gw.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	gc := &gatewayapiv1alpha2.GatewayClass{}
+	err = r.Get(ctx, client.ObjectKey{Name: string(gw.Spec.GatewayClassName)}, gc)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !gatewayapi.IsManaged(gc) {
+		return ctrl.Result{}, nil
+	}
+
+	if servicemeshv1alpha1.IsUnmanaged(gw) {
+		logger.Info("gateway is unmanaged, skipping reconciliation")
+
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("reconciling")
+
+	current := gw.Status.DeepCopy()
+
+	imgw, err := r.reconcileMeshGateway(ctx, gw, gc)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if imgw != nil {
+		gatewayapi.SetGatewayStatus(gw, imgw)
+	}
+
+	if equality.Semantic.DeepEqual(current, &gw.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, errors.WrapIf(r.Status().Update(ctx, gw), "could not update gateway status")
+}
+
+// reconcileMeshGateway creates or updates the mesh gateway of the gateway, nil is returned
+// when the gateway cannot be scheduled and its status has been set accordingly
+func (r *GatewayReconciler) reconcileMeshGateway(ctx context.Context, gw *gatewayapiv1alpha2.Gateway, gc *gatewayapiv1alpha2.GatewayClass) (*servicemeshv1alpha1.IstioMeshGateway, error) {
+	icpKey, err := gatewayapi.ControlPlaneOf(gc)
+	if err != nil {
+		gatewayapi.SetGatewayNotScheduled(gw, gatewayapiv1alpha2.GatewayReasonNotReconciled, fmt.Sprintf("invalid gateway class %s: %s", gc.GetName(), err))
+
+		return nil, nil
+	}
+
+	if !gatewayapi.HasSupportedListeners(gw) {
+		gatewayapi.SetGatewayNotScheduled(gw, gatewayapiv1alpha2.GatewayReasonNoResources, "the gateway has no listeners with supported protocols")
+
+		return nil, nil
+	}
+
+	imgw := &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gw.GetName(),
+			Namespace: gw.GetNamespace(),
+		},
+	}
+
+	err = r.Get(ctx, client.ObjectKeyFromObject(imgw), imgw)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	if err == nil && !metav1.IsControlledBy(imgw, gw) {
+		message := fmt.Sprintf("IstioMeshGateway %s already exists and is not owned by the gateway", client.ObjectKeyFromObject(imgw))
+		r.Recorder.Event(gw, corev1.EventTypeWarning, eventReasonComponentReconcileFailed, message)
+		gatewayapi.SetGatewayNotScheduled(gw, gatewayapiv1alpha2.GatewayReasonNotReconciled, message)
+
+		return nil, nil
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, imgw, func() error {
+		gatewayapi.SetMeshGatewaySpec(imgw, gw, icpKey)
+
+		return controllerutil.SetControllerReference(gw, imgw, r.Scheme)
+	})
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not reconcile mesh gateway of the gateway", "name", imgw.GetName(), "namespace", imgw.GetNamespace())
+	}
+
+	return imgw, nil
+}
+
+func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctrl, err := ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayapiv1alpha2.Gateway{}, ctrlBuilder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&servicemeshv1alpha1.IstioMeshGateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "IstioMeshGateway",
+				APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+		}, ctrlBuilder.WithPredicates(predicate.Or(util.ObjectChangePredicate{Logger: r.Log}, util.IMGWAddressChangePredicate{}))).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(&source.Kind{
+		Type: &gatewayapiv1alpha2.GatewayClass{},
+	}, handler.EnqueueRequestsFromMapFunc(func(a client.Object) []reconcile.Request {
+		gws := &gatewayapiv1alpha2.GatewayList{}
+		if err := r.Client.List(context.Background(), gws); err != nil {
+			r.Log.Error(err, "could not list gateways")
+
+			return nil
+		}
+
+		resources := make([]reconcile.Request, 0)
+		for _, gw := range gws.Items {
+			if string(gw.Spec.GatewayClassName) == a.GetName() {
+				resources = append(resources, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(&gw),
+				})
+			}
+		}
+
+		return resources
+	}), predicate.GenerationChangedPredicate{})
+}
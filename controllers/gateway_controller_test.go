@@ -0,0 +1,256 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/gatewayapi"
+)
+
+func newTestGatewayClass(name string, controllerName gatewayapiv1alpha2.GatewayController, icp *servicemeshv1alpha1.IstioControlPlane) *gatewayapiv1alpha2.GatewayClass {
+	namespace := gatewayapiv1alpha2.Namespace(icp.GetNamespace())
+
+	return &gatewayapiv1alpha2.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gatewayapiv1alpha2.GatewayClassSpec{
+			ControllerName: controllerName,
+			ParametersRef: &gatewayapiv1alpha2.ParametersReference{
+				Group:     gatewayapiv1alpha2.Group(servicemeshv1alpha1.SchemeBuilder.GroupVersion.Group),
+				Kind:      "IstioControlPlane",
+				Name:      icp.GetName(),
+				Namespace: &namespace,
+			},
+		},
+	}
+}
+
+func newTestGateway(className string, listeners ...gatewayapiv1alpha2.Listener) *gatewayapiv1alpha2.Gateway {
+	return &gatewayapiv1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "bookinfo",
+			Namespace:  "bookinfo",
+			UID:        k8stypes.UID("uid-of-bookinfo"),
+			Generation: 1,
+		},
+		Spec: gatewayapiv1alpha2.GatewaySpec{
+			GatewayClassName: gatewayapiv1alpha2.ObjectName(className),
+			Listeners:        listeners,
+		},
+	}
+}
+
+func newGatewayTest(objs ...client.Object) (*GatewayReconciler, *record.FakeRecorder) {
+	scheme := newTestScheme()
+	_ = gatewayapiv1alpha2.AddToScheme(scheme)
+
+	recorder := record.NewFakeRecorder(10)
+
+	return &GatewayReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Log:      newTestLogger(),
+		Scheme:   scheme,
+		Recorder: recorder,
+	}, recorder
+}
+
+func reconcileGateway(t *testing.T, r *GatewayReconciler, gw *gatewayapiv1alpha2.Gateway) *gatewayapiv1alpha2.Gateway {
+	t.Helper()
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(gw)}); err != nil {
+		t.Fatal(err)
+	}
+
+	current := &gatewayapiv1alpha2.Gateway{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(gw), current); err != nil {
+		t.Fatal(err)
+	}
+
+	return current
+}
+
+func TestGatewayCreatesMeshGateway(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	gw := newTestGateway("istio-operator", gatewayapiv1alpha2.Listener{
+		Name:     "http",
+		Port:     80,
+		Protocol: gatewayapiv1alpha2.HTTPProtocolType,
+	})
+	r, _ := newGatewayTest(icp, newTestGatewayClass("istio-operator", gatewayapi.ControllerName, icp), gw)
+
+	current := reconcileGateway(t, r, gw)
+
+	imgw := &servicemeshv1alpha1.IstioMeshGateway{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(gw), imgw); err != nil {
+		t.Fatal(err)
+	}
+
+	if !metav1.IsControlledBy(imgw, gw) {
+		t.Error("mesh gateway must be controlled by the gateway")
+	}
+	if imgw.GetSpec().GetType() != servicemeshv1alpha1.GatewayType_ingress {
+		t.Errorf("unexpected gateway type: %s", imgw.GetSpec().GetType())
+	}
+	if ref := imgw.GetSpec().GetIstioControlPlane(); ref.GetName() != icp.GetName() || ref.GetNamespace() != icp.GetNamespace() {
+		t.Errorf("unexpected control plane reference: %+v", ref)
+	}
+
+	// the gateway proxies run as an unprivileged user, so they cannot bind the port of the listener
+	ports := imgw.GetSpec().GetService().GetPorts()
+	if len(ports) != 1 || ports[0].Port != 80 || ports[0].TargetPort == nil || ports[0].TargetPort.IntValue() != 8080 {
+		t.Errorf("unexpected service ports: %+v", ports)
+	}
+
+	if !meta.IsStatusConditionTrue(current.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionScheduled)) {
+		t.Errorf("gateway must be scheduled: %+v", current.Status.Conditions)
+	}
+	if ready := meta.FindStatusCondition(current.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionReady)); ready == nil || ready.Reason != string(gatewayapiv1alpha2.GatewayReasonAddressNotAssigned) {
+		t.Errorf("unexpected ready condition: %+v", ready)
+	}
+
+	imgw.Status.GatewayAddress = []string{"10.0.0.1"}
+	if err := r.Status().Update(context.Background(), imgw); err != nil {
+		t.Fatal(err)
+	}
+
+	current = reconcileGateway(t, r, gw)
+
+	if diff := pretty.Compare(current.Status.Addresses, gatewayapi.Addresses([]string{"10.0.0.1"})); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	// nothing programs the routes of the listeners, so neither the gateway nor its listeners are ready
+	if ready := meta.FindStatusCondition(current.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionReady)); ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != string(gatewayapiv1alpha2.GatewayReasonListenersNotReady) {
+		t.Errorf("unexpected ready condition: %+v", ready)
+	}
+	if len(current.Status.Listeners) != 1 {
+		t.Fatalf("got %d listener statuses, want 1", len(current.Status.Listeners))
+	}
+	if meta.IsStatusConditionTrue(current.Status.Listeners[0].Conditions, string(gatewayapiv1alpha2.ListenerConditionReady)) {
+		t.Error("listener must not be ready")
+	}
+}
+
+func TestGatewayIsNotScheduled(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	http := gatewayapiv1alpha2.Listener{Name: "http", Port: 80, Protocol: gatewayapiv1alpha2.HTTPProtocolType}
+
+	tests := []struct {
+		name           string
+		gw             *gatewayapiv1alpha2.Gateway
+		objs           []client.Object
+		expectedReason gatewayapiv1alpha2.GatewayConditionReason
+		expectedEvents []string
+	}{
+		{
+			name: "invalid gateway class",
+			gw:   newTestGateway("istio-operator", http),
+			objs: []client.Object{
+				&gatewayapiv1alpha2.GatewayClass{
+					ObjectMeta: metav1.ObjectMeta{Name: "istio-operator"},
+					Spec:       gatewayapiv1alpha2.GatewayClassSpec{ControllerName: gatewayapi.ControllerName},
+				},
+			},
+			expectedReason: gatewayapiv1alpha2.GatewayReasonNotReconciled,
+			expectedEvents: []string{},
+		},
+		{
+			name: "no supported listeners",
+			gw: newTestGateway("istio-operator", gatewayapiv1alpha2.Listener{
+				Name:     "dns",
+				Port:     53,
+				Protocol: gatewayapiv1alpha2.UDPProtocolType,
+			}),
+			objs:           []client.Object{newTestGatewayClass("istio-operator", gatewayapi.ControllerName, icp)},
+			expectedReason: gatewayapiv1alpha2.GatewayReasonNoResources,
+			expectedEvents: []string{},
+		},
+		{
+			name: "mesh gateway of someone else",
+			gw:   newTestGateway("istio-operator", http),
+			objs: []client.Object{
+				newTestGatewayClass("istio-operator", gatewayapi.ControllerName, icp),
+				&servicemeshv1alpha1.IstioMeshGateway{
+					ObjectMeta: metav1.ObjectMeta{Name: "bookinfo", Namespace: "bookinfo"},
+				},
+			},
+			expectedReason: gatewayapiv1alpha2.GatewayReasonNotReconciled,
+			expectedEvents: []string{
+				corev1.EventTypeWarning + " " + eventReasonComponentReconcileFailed + " IstioMeshGateway bookinfo/bookinfo already exists and is not owned by the gateway",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, recorder := newGatewayTest(append([]client.Object{icp, tc.gw}, tc.objs...)...)
+
+			current := reconcileGateway(t, r, tc.gw)
+
+			scheduled := meta.FindStatusCondition(current.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionScheduled))
+			if scheduled == nil || scheduled.Status != metav1.ConditionFalse || scheduled.Reason != string(tc.expectedReason) {
+				t.Errorf("unexpected scheduled condition: %+v", scheduled)
+			}
+
+			if diff := pretty.Compare(recordedEvents(recorder), tc.expectedEvents); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayOfOtherController(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	gw := newTestGateway("istio", gatewayapiv1alpha2.Listener{Name: "http", Port: 80, Protocol: gatewayapiv1alpha2.HTTPProtocolType})
+	r, _ := newGatewayTest(icp, newTestGatewayClass("istio", "istio.io/gateway-controller", icp), gw)
+
+	current := reconcileGateway(t, r, gw)
+
+	imgws := &servicemeshv1alpha1.IstioMeshGatewayList{}
+	if err := r.List(context.Background(), imgws); err != nil {
+		t.Fatal(err)
+	}
+	if len(imgws.Items) > 0 {
+		t.Errorf("no mesh gateway must be created for gateways of other controllers, got %d", len(imgws.Items))
+	}
+
+	if len(current.Status.Conditions) > 0 {
+		t.Errorf("status of the gateway must be left to its controller: %+v", current.Status.Conditions)
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"emperror.dev/errors"
 	"github.com/gogo/protobuf/jsonpb"
+	"go.opentelemetry.io/otel/attribute"
 	"istio.io/api/mesh/v1alpha1"
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
@@ -58,14 +59,18 @@ import (
 
 	clusterregistryv1alpha1 "github.com/banzaicloud/cluster-registry/api/v1alpha1"
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/assets"
 	"github.com/banzaicloud/istio-operator/v2/internal/components"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/base"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/cni"
 	discovery_component "github.com/banzaicloud/istio-operator/v2/internal/components/discovery"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/meshexpansion"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/nodeproxy"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/resourcesyncrule"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/sidecarinjector"
 	"github.com/banzaicloud/istio-operator/v2/internal/models"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+	"github.com/banzaicloud/istio-operator/v2/internal/tracing"
 	"github.com/banzaicloud/istio-operator/v2/internal/util"
 	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
@@ -90,13 +95,15 @@ type IstioControlPlaneReconciler struct {
 	ResourceReconciler       reconciler.ResourceReconciler
 	ClusterRegistry          models.ClusterRegistryConfiguration
 	APIServerEndpointAddress string
+	ReaderTokenExpiration    time.Duration
 	SupportedIstioVersion    string
 	Version                  string
 	Recorder                 record.EventRecorder
 
-	watchersInitOnce sync.Once
-	builder          *ctrlBuilder.Builder
-	ctrl             controller.Controller
+	remoteClusterChecker remoteClusterChecker
+	watchersInitOnce     sync.Once
+	builder              *ctrlBuilder.Builder
+	ctrl                 controller.Controller
 }
 
 // +kubebuilder:rbac:groups="",resources=nodes;replicationcontrollers,verbs=get;list;watch
@@ -104,6 +111,7 @@ type IstioControlPlaneReconciler struct {
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=configmaps;endpoints;secrets;services;serviceaccounts;resourcequotas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 // +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="apps",resources=replicasets,verbs=get;list;watch
@@ -150,6 +158,7 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	if icp.Spec.Version == "" {
 		err = errors.New("please set spec.version in your istiocontrolplane CR to be reconciled by this operator")
 		logger.Error(err, "", "name", icp.Name, "namespace", icp.Namespace)
+		r.Recorder.Event(icp, corev1.EventTypeWarning, eventReasonUnsupportedVersion, err.Error())
 
 		return reconcile.Result{
 			Requeue: false,
@@ -158,7 +167,8 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	if !IsIstioVersionSupported(icp.Spec.Version) {
 		err = errors.New("intended Istio version is unsupported by this version of the operator")
-		logger.Error(err, "", "version", icp.Spec.Version)
+		logger.Error(err, "", "version", icp.Spec.Version, "supportedVersions", assets.SupportedVersions())
+		r.Recorder.Eventf(icp, corev1.EventTypeWarning, eventReasonUnsupportedVersion, "Istio version %s is unsupported by this version of the operator, supported versions: %s", icp.Spec.Version, strings.Join(assets.SupportedVersions(), ", "))
 
 		return reconcile.Result{
 			Requeue: false,
@@ -175,7 +185,36 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
+	if servicemeshv1alpha1.IsUnmanaged(icp) {
+		logger.Info("control plane is unmanaged, skipping reconciliation")
+
+		err = components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Unmanaged), "")
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	ctx, span := tracing.Start(ctx, "IstioControlPlane",
+		attribute.String("namespace", icp.GetNamespace()),
+		attribute.String("name", icp.GetName()),
+		attribute.Int64("generation", icp.GetGeneration()),
+	)
 	result, err := r.reconcile(ctx, icp, logger)
+	tracing.End(span, err)
+	if planPendingErr := (&planPendingError{}); errors.As(err, &planPendingErr) {
+		logger.Info(planPendingErr.Error())
+
+		updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Reconciling), planPendingErr.Error())
+		if updateErr != nil && !k8serrors.IsNotFound(updateErr) {
+			logger.Error(updateErr, "failed to update state")
+
+			return result, errors.WithStack(updateErr)
+		}
+
+		return result, nil
+	}
 	if err != nil {
 		updateErr := components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
 		if updateErr != nil {
@@ -198,7 +237,7 @@ func (r *IstioControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return result, errors.WithStack(err)
 	}
 
-	err = util.RemoveFinalizer(ctx, r.Client, icp, istioControlPlaneFinalizerID, true)
+	err = removeFinalizer(ctx, r.Client, r.Recorder, icp, istioControlPlaneFinalizerID, true)
 	if err != nil {
 		return result, errors.WithStack(err)
 	}
@@ -220,126 +259,187 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return ctrl.Result{}, err
 	}
 
-	istioMesh, err := r.getRelatedIstioMesh(ctx, r.Client, icp, logger)
+	var istioMesh *servicemeshv1alpha1.IstioMesh
+	err = tracing.Step(ctx, "getRelatedIstioMesh", func(ctx context.Context) (err error) {
+		istioMesh, err = r.getRelatedIstioMesh(ctx, r.Client, icp, logger)
+
+		return err
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
-		baseComponent, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-			return base.NewComponentReconciler(helmReconciler, r.Log.WithName("base"), r.SupportedIstioVersion)
-		}, r.Log.WithName("base"))
-		if err != nil {
-			return ctrl.Result{}, err
-		}
-
-		result, err := baseComponent.Reconcile(icp)
-		if err != nil {
-			return result, err
-		}
-
-		r.watchersInitOnce.Do(func() {
-			err = r.watchIstioCRs()
-			if err != nil {
-				logger.Error(err, "unable to watch Istio Custom Resources")
-			}
-		})
-	}
-
-	componentReconcilers := []components.ComponentReconciler{}
-
-	err = setDynamicDefaults(ctx, r.Client, icp, k8sConfig, logger, r.ClusterRegistry.ClusterAPI.Enabled)
+	err = tracing.Step(ctx, "setDynamicDefaults", func(ctx context.Context) error {
+		return setDynamicDefaults(ctx, r.Client, icp, k8sConfig, logger, r.ClusterRegistry.ClusterAPI.Enabled)
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	r.setModeToStatus(icp)
+
 	// set cluster ID to status as it is not always in the stored spec
 	icp.Status.ClusterID = icp.Spec.ClusterID
 
-	meshNetworks, err := r.getMeshNetworks(ctx, icp)
+	bundle, err := assets.GetChartBundle(icp.Spec.Version)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	icp.Status.ChartBundleVersion = bundle.Version
 
-	trustedCACertificates, err := r.getCACertificatesFromPeers(ctx, icp)
+	var networks []servicemeshv1alpha1.NetworkStatus
+	err = tracing.Step(ctx, "getNetworks", func(ctx context.Context) (err error) {
+		networks, err = r.getNetworks(ctx, icp)
+
+		return err
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	discoveryReconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return discovery_component.NewChartReconciler(helmReconciler, servicemeshv1alpha1.IstioControlPlaneProperties{
-			Mesh:                         istioMesh,
-			MeshNetworks:                 meshNetworks,
-			TrustedRootCACertificatePEMs: trustedCACertificates,
-		}, r.Log)
-	}, r.Log.WithName("discovery"))
+	var meshNetworks *v1alpha1.MeshNetworks
+	err = tracing.Step(ctx, "getMeshNetworks", func(ctx context.Context) (err error) {
+		meshNetworks, err = r.getMeshNetworks(ctx, icp, networks)
+
+		return err
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	componentReconcilers = append(componentReconcilers, discoveryReconciler)
 
-	cniReconciler, err := NewComponentReconciler(r, cni.NewChartReconciler, r.Log.WithName("cni"))
+	var trustedCACertificates []string
+	err = tracing.Step(ctx, "getCACertificatesFromPeers", func(ctx context.Context) (err error) {
+		trustedCACertificates, err = r.getCACertificatesFromPeers(ctx, icp)
+
+		return err
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	componentReconcilers = append(componentReconcilers, cniReconciler)
 
-	meshExpansionReconciler, err := NewComponentReconciler(r, meshexpansion.NewChartReconciler, r.Log.WithName("meshexpansion"))
-	if err != nil {
-		return ctrl.Result{}, err
+	// in plan mode the changes of the plug-in CA are part of the plan, they are only made once the plan is approved
+	planned := r.planEnabled(icp)
+
+	var pluginCA pluginca.Result
+	var pluginCAChanges []components.Change
+	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+		err = tracing.Step(ctx, "reconcilePluginCA", func(ctx context.Context) (err error) {
+			if planned {
+				pluginCA, pluginCAChanges, err = r.planPluginCA(ctx, icp, logger)
+			} else {
+				pluginCA, err = r.reconcilePluginCA(ctx, r.Client, r.Recorder, icp, logger)
+			}
+
+			return err
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		icp.Status.Ca = nil
+	}
+
+	properties := servicemeshv1alpha1.IstioControlPlaneProperties{
+		Mesh:                         istioMesh,
+		MeshNetworks:                 meshNetworks,
+		TrustedRootCACertificatePEMs: trustedCACertificates,
+		CACertificatesChecksum:       pluginCA.Checksum,
 	}
-	componentReconcilers = append(componentReconcilers, meshExpansionReconciler)
 
-	sidecarInjectorReconciler, err := NewComponentReconciler(r, sidecarinjector.NewChartReconciler, r.Log.WithName("sidecarInjector"))
+	err = tracing.Step(ctx, "reconcilePlan", func(ctx context.Context) error {
+		return r.reconcilePlan(ctx, icp, properties, pluginCAChanges)
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	componentReconcilers = append(componentReconcilers, sidecarInjectorReconciler)
 
-	resourceSyncRuleReconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return resourcesyncrule.NewChartReconciler(helmReconciler, r.ClusterRegistry.ResourceSyncRules.Enabled)
-	}, r.Log.WithName("resourcesyncrule"))
+	if planned && icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+		err = tracing.Step(ctx, "reconcilePluginCA", func(ctx context.Context) (err error) {
+			pluginCA, err = r.reconcilePluginCA(ctx, r.Client, r.Recorder, icp, logger)
+
+			return err
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		properties.CACertificatesChecksum = pluginCA.Checksum
+	}
+
+	componentReconcilers, err := r.newComponentReconcilers(r, icp, properties)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	componentReconcilers = append(componentReconcilers, resourceSyncRuleReconciler)
 
 	var result ctrl.Result
-	for _, r := range componentReconcilers {
-		result, err = r.Reconcile(icp)
+	for _, componentReconciler := range componentReconcilers {
+		componentReconciler := componentReconciler
+		err = tracing.Step(ctx, "component/"+componentReconciler.Name(), func(ctx context.Context) (err error) {
+			result, err = componentReconciler.Reconcile(icp)
+
+			return err
+		})
 		if err != nil {
+			r.Recorder.Eventf(icp, corev1.EventTypeWarning, eventReasonComponentReconcileFailed, "could not reconcile component %s: %s", componentReconciler.Name(), err)
+
 			return result, err
 		}
 	}
 
-	err = r.deleteIstioRootCAConfigmapsOnPassive(ctx, icp, logger)
+	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+		r.watchersInitOnce.Do(func() {
+			err = r.watchIstioCRs()
+			if err != nil {
+				logger.Error(err, "unable to watch Istio Custom Resources")
+			}
+		})
+	}
+
+	err = tracing.Step(ctx, "deleteIstioRootCAConfigmapsOnPassive", func(ctx context.Context) error {
+		return r.deleteIstioRootCAConfigmapsOnPassive(ctx, icp, logger)
+	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	err = r.reconcileNamespaceInjectionLabels(ctx, icp)
+	err = tracing.Step(ctx, "reconcileNamespaceInjectionLabels", func(ctx context.Context) error {
+		return r.reconcileNamespaceInjectionLabels(ctx, icp)
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.setInjectionNamespacesToStatus(ctx, icp)
+	err = tracing.Step(ctx, "setInjectionNamespacesToStatus", func(ctx context.Context) error {
+		return r.setInjectionNamespacesToStatus(ctx, icp)
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.reconcileIstiodEndpoint(ctx, icp)
+	var istiodEndpointResolved bool
+	err = tracing.Step(ctx, "reconcileIstiodEndpoint", func(ctx context.Context) (err error) {
+		istiodEndpointResolved, err = r.reconcileIstiodEndpoint(ctx, icp)
+
+		return err
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.reconcileClusterReaderSecret(ctx, icp, k8sConfig)
+	var readerTokenRefreshAfter time.Duration
+	err = tracing.Step(ctx, "reconcileClusterReaderSecret", func(ctx context.Context) (err error) {
+		readerTokenRefreshAfter, err = r.reconcileClusterReaderSecret(ctx, icp, k8sConfig)
+
+		return err
+	})
 	if err != nil {
 		return result, err
 	}
 
 	// icp is marked for deletion
 	if !icp.DeletionTimestamp.IsZero() {
-		err = r.waitForMeshExpansionGatewayRemoval(ctx, icp)
+		err = tracing.Step(ctx, "waitForMeshExpansionGatewayRemoval", func(ctx context.Context) error {
+			return r.waitForMeshExpansionGatewayRemoval(ctx, icp)
+		})
 		if err != nil {
 			result.Requeue = true
 			result.RequeueAfter = meshExpansionGatewayRemovalRequeueDuration
@@ -354,31 +454,58 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return result, nil
 	}
 
-	err = r.setSidecarInjectorChecksumToStatus(ctx, icp)
+	err = tracing.Step(ctx, "setSidecarInjectorChecksumToStatus", func(ctx context.Context) error {
+		return r.setSidecarInjectorChecksumToStatus(ctx, icp)
+	})
 	if err != nil {
 		return result, err
 	}
 
 	r.setControlPlaneNameToStatus(icp)
 
-	err = r.setMeshConfigToStatus(ctx, icp)
+	err = tracing.Step(ctx, "setMeshConfigToStatus", func(ctx context.Context) error {
+		return r.setMeshConfigToStatus(ctx, icp)
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.setIstiodAddressesToStatus(ctx, icp)
+	err = tracing.Step(ctx, "setIstiodAddressesToStatus", func(ctx context.Context) error {
+		return r.setIstiodAddressesToStatus(ctx, icp)
+	})
+	if err != nil {
+		return result, err
+	}
+
+	err = tracing.Step(ctx, "setIstioCARootCertToStatus", func(ctx context.Context) error {
+		return r.setIstioCARootCertToStatus(ctx, icp)
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.setIstioCARootCertToStatus(ctx, icp)
+	var remoteClusterUnreachable bool
+	err = tracing.Step(ctx, "setRemoteClustersToStatus", func(ctx context.Context) (err error) {
+		remoteClusterUnreachable, err = r.setRemoteClustersToStatus(ctx, icp)
+
+		return err
+	})
 	if err != nil {
 		return result, err
 	}
 
-	err = r.setMeshExpansionGWAddressToStatus(ctx, icp)
+	err = tracing.Step(ctx, "setMeshExpansionGWAddressToStatus", func(ctx context.Context) error {
+		return r.setMeshExpansionGWAddressToStatus(ctx, icp)
+	})
 	if err != nil {
 		logger.Info(fmt.Sprintf("mesh expansion gateway is pending: %s", err.Error()))
+		icp.SetCondition(servicemeshv1alpha1.Condition{
+			Type:               servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned,
+			Status:             servicemeshv1alpha1.ConditionStatus_False,
+			ObservedGeneration: icp.GetGeneration(),
+			Reason:             servicemeshv1alpha1.ConditionReasonAddressPending,
+			Message:            err.Error(),
+		})
 		_ = components.UpdateStatus(ctx, r.Client, icp, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
 		result.Requeue = true
 		result.RequeueAfter = pendingGatewayRequeueDuration
@@ -386,9 +513,90 @@ func (r *IstioControlPlaneReconciler) reconcile(ctx context.Context, icp *servic
 		return result, err
 	}
 
+	// the networks are set to the status with the mesh networks they were rendered into
+	icp.Status.Networks = networks
+
+	if pluginCA.RequeueAfter > 0 && (result.RequeueAfter == 0 || pluginCA.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = pluginCA.RequeueAfter
+	}
+
+	if remoteClusterUnreachable && (result.RequeueAfter == 0 || remoteClusterRequeueDuration < result.RequeueAfter) {
+		result.RequeueAfter = remoteClusterRequeueDuration
+	}
+
+	if readerTokenRefreshAfter > 0 && (result.RequeueAfter == 0 || readerTokenRefreshAfter < result.RequeueAfter) {
+		result.RequeueAfter = readerTokenRefreshAfter
+	}
+
+	// the resolved addresses of hostnames could change without any related resource being updated
+	if istiodEndpointResolved && (result.RequeueAfter == 0 || hostnameSyncWaitDuration < result.RequeueAfter) {
+		result.RequeueAfter = hostnameSyncWaitDuration
+	}
+
 	return result, nil
 }
 
+// newComponentReconcilers returns the reconcilers of the components of the control plane in the order they get reconciled
+func (r *IstioControlPlaneReconciler) newComponentReconcilers(c components.Reconciler, icp *servicemeshv1alpha1.IstioControlPlane, properties servicemeshv1alpha1.IstioControlPlaneProperties) ([]components.ComponentReconciler, error) {
+	componentReconcilers := []components.ComponentReconciler{}
+
+	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
+		baseReconciler, err := NewComponentReconciler(c, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
+			return base.NewComponentReconciler(helmReconciler, r.Log.WithName("base"), r.SupportedIstioVersion)
+		}, r.Log.WithName("base"))
+		if err != nil {
+			return nil, err
+		}
+		componentReconcilers = append(componentReconcilers, baseReconciler)
+	}
+
+	discoveryReconciler, err := NewComponentReconciler(c, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
+		return discovery_component.NewChartReconciler(helmReconciler, properties, r.Log)
+	}, r.Log.WithName("discovery"))
+	if err != nil {
+		return nil, err
+	}
+	componentReconcilers = append(componentReconcilers, discoveryReconciler)
+
+	cniReconciler, err := NewComponentReconciler(c, cni.NewChartReconciler, r.Log.WithName("cni"))
+	if err != nil {
+		return nil, err
+	}
+	componentReconcilers = append(componentReconcilers, cniReconciler)
+
+	// there is nothing to render or clean up for the node proxies with bundles which do not support them,
+	// when they are enabled nevertheless, the reconciliation of the component fails
+	if nodeproxy.Supported(icp) || utils.PointerToBool(icp.GetSpec().GetNodeProxy().GetEnabled()) {
+		nodeProxyReconciler, err := NewComponentReconciler(c, nodeproxy.NewChartReconciler, r.Log.WithName("nodeproxy"))
+		if err != nil {
+			return nil, err
+		}
+		componentReconcilers = append(componentReconcilers, nodeProxyReconciler)
+	}
+
+	meshExpansionReconciler, err := NewComponentReconciler(c, meshexpansion.NewChartReconciler, r.Log.WithName("meshexpansion"))
+	if err != nil {
+		return nil, err
+	}
+	componentReconcilers = append(componentReconcilers, meshExpansionReconciler)
+
+	sidecarInjectorReconciler, err := NewComponentReconciler(c, sidecarinjector.NewChartReconciler, r.Log.WithName("sidecarInjector"))
+	if err != nil {
+		return nil, err
+	}
+	componentReconcilers = append(componentReconcilers, sidecarInjectorReconciler)
+
+	resourceSyncRuleReconciler, err := NewComponentReconciler(c, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
+		return resourcesyncrule.NewChartReconciler(helmReconciler, r.ClusterRegistry.ResourceSyncRules.Enabled)
+	}, r.Log.WithName("resourcesyncrule"))
+	if err != nil {
+		return nil, err
+	}
+	componentReconcilers = append(componentReconcilers, resourceSyncRuleReconciler)
+
+	return componentReconcilers, nil
+}
+
 func (r *IstioControlPlaneReconciler) GetClient() client.Client {
 	return r.Client
 }
@@ -560,7 +768,7 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				return nil
 			}
 
-			// only act on mesh expansion gateway
+			// only act on the mesh expansion gateway and the east-west gateways of the networks of the control plane
 			sel := labels.SelectorFromValidatedSet((&servicemeshv1alpha1.IstioControlPlane{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      icp.Name,
@@ -568,10 +776,13 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				},
 			}).MeshExpansionGatewayLabels())
 			if !sel.Matches(labels.Set(imgw.GetLabels())) {
-				return nil
+				cp := &servicemeshv1alpha1.IstioControlPlane{}
+				if err := r.Client.Get(context.Background(), client.ObjectKey{Name: icp.Name, Namespace: icp.Namespace}, cp); err != nil || !isNetworkGateway(imgw, cp) {
+					return nil
+				}
 			}
 
-			r.Log.V(1).Info("trigger reconcile by mesh expansion gateway change")
+			r.Log.V(1).Info("trigger reconcile by mesh gateway change")
 
 			return []reconcile.Request{
 				{
@@ -674,6 +885,93 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	// the root CA secrets the plug-in CAs of the control planes are issued from or rotated to
+	err = r.ctrl.Watch(
+		&source.Kind{
+			Type: &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Secret",
+					APIVersion: corev1.SchemeGroupVersion.String(),
+				},
+			},
+		},
+		handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			icps := &servicemeshv1alpha1.IstioControlPlaneList{}
+			err := r.Client.List(context.Background(), icps)
+			if err != nil {
+				r.Log.Error(err, "could not list Istio control plane resources")
+
+				return nil
+			}
+
+			resources := make([]reconcile.Request, 0)
+			for _, icp := range icps.Items {
+				icp := icp
+				if icp.GetSpec().GetCa() == nil {
+					continue
+				}
+
+				key := client.ObjectKeyFromObject(obj)
+				if pluginca.RootCASecretKey(&icp) == key || (icp.GetSpec().GetCa().GetNextRootCASecret() != nil && pluginca.NextRootCASecretKey(&icp) == key) {
+					resources = append(resources, reconcile.Request{
+						NamespacedName: client.ObjectKey{
+							Name:      icp.GetName(),
+							Namespace: icp.GetNamespace(),
+						},
+					})
+				}
+			}
+
+			return resources
+		}),
+		objectChangePredicate,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the multi-cluster secrets istiod reads the kubeconfigs of the remote clusters from
+	err = r.ctrl.Watch(
+		&source.Kind{
+			Type: &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Secret",
+					APIVersion: corev1.SchemeGroupVersion.String(),
+				},
+			},
+		},
+		handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			icps := &servicemeshv1alpha1.IstioControlPlaneList{}
+			err := r.Client.List(context.Background(), icps, client.InNamespace(obj.GetNamespace()))
+			if err != nil {
+				r.Log.Error(err, "could not list Istio control plane resources")
+
+				return nil
+			}
+
+			resources := make([]reconcile.Request, 0)
+			for _, icp := range icps.Items {
+				icp := icp
+				if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE && labels.SelectorFromSet(multiClusterSecretLabels(&icp)).Matches(labels.Set(obj.GetLabels())) {
+					resources = append(resources, reconcile.Request{
+						NamespacedName: client.ObjectKey{
+							Name:      icp.GetName(),
+							Namespace: icp.GetNamespace(),
+						},
+					})
+				}
+			}
+
+			return resources
+		}),
+		predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetLabels()[multiClusterSecretLabel] == "true"
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	err = r.ctrl.Watch(
 		&source.Kind{
 			Type: &corev1.Namespace{
@@ -763,6 +1061,7 @@ func (r *IstioControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 type ControlPlane interface {
 	GetName() string
+	GetLabels() map[string]string
 	GetStatus() servicemeshv1alpha1.IstioControlPlaneStatus
 	GetSpec() *servicemeshv1alpha1.IstioControlPlaneSpec
 }
@@ -781,9 +1080,8 @@ func (list SortableControlPlanes) Less(i, j int) bool {
 	return list[i].GetName() > list[j].GetName()
 }
 
-func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]string, error) {
-	certData := make([]string, 0)
-
+// getActivePeers returns the active peer control planes of the control plane from the other clusters of the mesh
+func (r *IstioControlPlaneReconciler) getActivePeers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (SortableControlPlanes, error) {
 	cps := make(SortableControlPlanes, 0)
 
 	picpList := &servicemeshv1alpha1.PeerIstioControlPlaneList{}
@@ -801,6 +1099,17 @@ func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Con
 
 	sort.Sort(cps)
 
+	return cps, nil
+}
+
+func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]string, error) {
+	certData := make([]string, 0)
+
+	cps, err := r.getActivePeers(ctx, icp)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, cp := range cps {
 		if cp.GetStatus().CaRootCertificate != "" {
 			certData = append(certData, cp.GetStatus().CaRootCertificate)
@@ -810,7 +1119,9 @@ func (r *IstioControlPlaneReconciler) getCACertificatesFromPeers(ctx context.Con
 	return certData, nil
 }
 
-func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (*v1alpha1.MeshNetworks, error) {
+// getMeshNetworks returns the mesh networks of istiod generated from the networks of the control plane
+// and the networks its peers report in their status
+func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, localNetworks []servicemeshv1alpha1.NetworkStatus) (*v1alpha1.MeshNetworks, error) {
 	networks := make(map[string]*v1alpha1.Network)
 
 	cps := make(SortableControlPlanes, 0)
@@ -832,18 +1143,7 @@ func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *
 	sort.Sort(cps)
 
 	for _, cp := range cps {
-		gateways := make([]*v1alpha1.Network_IstioNetworkGateway, 0)
-		sort.Strings(cp.GetStatus().GatewayAddress)
-		for _, address := range cp.GetStatus().GatewayAddress {
-			gateways = append(gateways, &v1alpha1.Network_IstioNetworkGateway{
-				Gw: &v1alpha1.Network_IstioNetworkGateway_Address{
-					Address: address,
-				},
-				Port: 15443, // nolint:gomnd
-			})
-		}
-
-		networkName := cp.GetSpec().GetNetworkName()
+		networkName := getControlPlaneNetwork(icp, cp)
 		if networks[networkName] == nil {
 			networks[networkName] = &v1alpha1.Network{}
 		}
@@ -852,7 +1152,25 @@ func (r *IstioControlPlaneReconciler) getMeshNetworks(ctx context.Context, icp *
 				FromRegistry: cp.GetStatus().ClusterID,
 			},
 		})
-		networks[networkName].Gateways = append(networks[networkName].Gateways, gateways...)
+
+		cpNetworks := localNetworks
+		if cp != ControlPlane(icp) {
+			cpNetworks = getNetworksFromStatus(cp, networkName)
+		}
+
+		for _, network := range cpNetworks {
+			if networks[network.Name] == nil {
+				networks[network.Name] = &v1alpha1.Network{}
+			}
+			for _, gateway := range network.Gateways {
+				networks[network.Name].Gateways = append(networks[network.Name].Gateways, &v1alpha1.Network_IstioNetworkGateway{
+					Gw: &v1alpha1.Network_IstioNetworkGateway_Address{
+						Address: gateway.Address,
+					},
+					Port: gateway.Port,
+				})
+			}
+		}
 	}
 
 	return &v1alpha1.MeshNetworks{
@@ -885,28 +1203,34 @@ func (r *IstioControlPlaneReconciler) getRelatedIstioMesh(ctx context.Context, c
 }
 
 // reconcileIstiodEndpoint creates the k8s Endpoint resource for the headless istiod service
-// on PASSIVE Istio Control planes to be able to connect to istiod pods on active clusters
-func (r *IstioControlPlaneReconciler) reconcileIstiodEndpoint(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+// on PASSIVE Istio Control planes to be able to connect to istiod pods on active clusters,
+// it reports whether hostnames had to be resolved for the addresses of the endpoint
+func (r *IstioControlPlaneReconciler) reconcileIstiodEndpoint(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (bool, error) {
 	if !icp.DeletionTimestamp.IsZero() || icp.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_PASSIVE {
 		// In active mode the k8s endpoint controller takes care of creating/updating the Endpoint
 		// resource based on the istiod service with selector, so istio operator does nothing
-		return nil
+		return false, nil
 	}
 
 	serviceName := icp.WithRevision("istiod")
 	serviceNamespace := icp.GetNamespace()
 
-	istiodEndpointAddresses, err := k8sutil.GetIstiodEndpointAddresses(ctx, r.Client, icp.GetName(), icp.GetSpec().GetNetworkName(), serviceNamespace)
+	service, err := k8sutil.GetService(ctx, r.Client, serviceName, serviceNamespace)
 	if err != nil {
-		return errors.WithStackIf(err)
+		return false, errors.WithStackIf(err)
+	}
+
+	istiodEndpointAddresses, resolved, err := k8sutil.GetIstiodEndpointAddresses(ctx, r.Client, icp.GetName(), icp.GetSpec().GetNetworkName(), serviceNamespace, k8sutil.GetAddressResolutionForService(service))
+	if err != nil {
+		return resolved, errors.WithStackIf(err)
 	}
 	if len(istiodEndpointAddresses) == 0 {
-		return errors.New("no valid istiod address found")
+		return resolved, errors.New("no valid istiod address found")
 	}
 
 	istiodEndpointPorts, err := k8sutil.GetIstiodEndpointPorts(ctx, r.Client, serviceName, serviceNamespace)
 	if err != nil {
-		return errors.WithStackIf(err)
+		return resolved, errors.WithStackIf(err)
 	}
 
 	endpoints := k8sutil.CreateK8sEndpoints(serviceName, serviceNamespace, istiodEndpointAddresses, istiodEndpointPorts)
@@ -914,14 +1238,17 @@ func (r *IstioControlPlaneReconciler) reconcileIstiodEndpoint(ctx context.Contex
 
 	_, err = r.ResourceReconciler.ReconcileResource(endpoints, reconciler.StatePresent)
 	if err != nil {
-		return errors.WithStackIf(err)
+		return resolved, errors.WithStackIf(err)
 	}
 
-	return nil
+	return resolved, nil
 }
 
-func (r *IstioControlPlaneReconciler) reconcileClusterReaderSecret(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, kubeConfig *rest.Config) error {
+// reconcileClusterReaderSecret reconciles the secret with the kubeconfig of the reader service account and returns
+// the duration after which its token has to be refreshed
+func (r *IstioControlPlaneReconciler) reconcileClusterReaderSecret(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, kubeConfig *rest.Config) (time.Duration, error) {
 	var err error
+	var refreshAfter time.Duration
 	state := reconciler.StateAbsent
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -947,21 +1274,24 @@ func (r *IstioControlPlaneReconciler) reconcileClusterReaderSecret(ctx context.C
 			},
 			r.APIServerEndpointAddress,
 			r.ClusterRegistry.ClusterAPI.Enabled,
+			r.ReaderTokenExpiration,
 		)
 		if err != nil {
-			return errors.WithStackIf(err)
+			return 0, errors.WithStackIf(err)
 		}
 
 		secret.Type = readerSecretType
 		k8sutil.SetICPMetadataOnObject(secret, icp)
+
+		refreshAfter, _ = k8sutil.ReaderTokenRefreshAfter(secret)
 	}
 
 	_, err = r.ResourceReconciler.ReconcileResource(secret, state)
 	if err != nil {
-		return errors.WithStackIf(err)
+		return 0, errors.WithStackIf(err)
 	}
 
-	return errors.WithStackIf(err)
+	return refreshAfter, nil
 }
 
 func (r *IstioControlPlaneReconciler) removeFinalizerFromRelatedMeshGateways(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
@@ -977,7 +1307,7 @@ func (r *IstioControlPlaneReconciler) removeFinalizerFromRelatedMeshGateways(ctx
 		if imgw.GetSpec().GetIstioControlPlane().Name != icp.Name || imgw.GetSpec().GetIstioControlPlane().Namespace != icp.Namespace {
 			continue
 		}
-		err = util.RemoveFinalizer(ctx, r.Client, &imgw, istioMeshGatewayFinalizerID, false)
+		err = removeFinalizer(ctx, r.Client, r.Recorder, &imgw, istioMeshGatewayFinalizerID, false)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -1024,38 +1354,59 @@ func (r *IstioControlPlaneReconciler) setIstiodAddressesToStatus(ctx context.Con
 func (r *IstioControlPlaneReconciler) setMeshExpansionGWAddressToStatus(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
 	if icp.DeletionTimestamp.IsZero() && !utils.PointerToBool(icp.GetSpec().GetMeshExpansion().GetEnabled()) {
 		icp.Status.GatewayAddress = nil
+		servicemeshv1alpha1.RemoveCondition(&icp.Status.Conditions, servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned)
 
 		return nil
 	}
 
-	l := &servicemeshv1alpha1.IstioMeshGatewayList{}
-	err := r.Client.List(ctx, l, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(
-		utils.MergeLabels(icp.RevisionLabels(), map[string]string{
-			"app": "istio-meshexpansion-gateway",
-		}),
-	))
+	imgws, err := r.getMeshExpansionGateways(ctx, icp)
 	if err != nil {
 		return err
 	}
 
-	if len(l.Items) == 0 {
+	if len(imgws) == 0 {
 		return errors.New("could not find mesh expansion gateway")
 	}
 
-	if len(l.Items) > 1 {
+	if len(imgws) > 1 {
 		return errors.New("multiple mesh expansion gateways were found")
 	}
 
-	imgw := l.Items[0]
+	imgw := imgws[0]
 	if imgw.GetStatus().Status != servicemeshv1alpha1.ConfigState_Available {
 		return errors.New(imgw.GetStatus().ErrorMessage)
 	}
 
+	recordGatewayAddressChange(r.Recorder, icp, icp.Status.GatewayAddress, imgw.GetStatus().GatewayAddress)
+	setGatewayAddressAssignedCondition(&icp.Status.Conditions, icp.GetGeneration(), icp.Status.GatewayAddress, imgw.GetStatus().GatewayAddress)
 	icp.Status.GatewayAddress = imgw.GetStatus().GatewayAddress
 
 	return nil
 }
 
+func (r *IstioControlPlaneReconciler) getMeshExpansionGateways(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]servicemeshv1alpha1.IstioMeshGateway, error) {
+	l := &servicemeshv1alpha1.IstioMeshGatewayList{}
+	err := r.Client.List(ctx, l, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(
+		utils.MergeLabels(icp.RevisionLabels(), map[string]string{
+			"app": "istio-meshexpansion-gateway",
+		}),
+	))
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not list mesh expansion gateways")
+	}
+
+	return l.Items, nil
+}
+
+// setModeToStatus records the mode of the control plane in its status and emits an event when the mode has been switched
+func (r *IstioControlPlaneReconciler) setModeToStatus(icp *servicemeshv1alpha1.IstioControlPlane) {
+	mode := icp.GetSpec().GetMode()
+	if previous := icp.Status.Mode; previous != servicemeshv1alpha1.ModeType_UNSPECIFIED && previous != mode {
+		r.Recorder.Eventf(icp, corev1.EventTypeNormal, eventReasonModeChanged, "control plane mode changed from %s to %s", previous, mode)
+	}
+	icp.Status.Mode = mode
+}
+
 func (r *IstioControlPlaneReconciler) setControlPlaneNameToStatus(icp *servicemeshv1alpha1.IstioControlPlane) {
 	icp.Status.IstioControlPlaneName = icp.GetName()
 }
@@ -1071,9 +1422,15 @@ func (r *IstioControlPlaneReconciler) setMeshConfigToStatus(ctx context.Context,
 		return nil
 	}
 
+	return SetMeshConfigToStatus(icp, &configmaps.Items[0])
+}
+
+// SetMeshConfigToStatus sets the mesh config and its checksum to the status of the control plane
+// from the mesh config configmap of the control plane
+func SetMeshConfigToStatus(icp *servicemeshv1alpha1.IstioControlPlane, configmap *corev1.ConfigMap) error {
 	var mc v1alpha1.MeshConfig
 
-	mcYAML := configmaps.Items[0].Data["mesh"]
+	mcYAML := configmap.Data["mesh"]
 	mcJSON, err := yaml.YAMLToJSON([]byte(mcYAML))
 	if err != nil {
 		return errors.WithStackIf(err)
@@ -1104,19 +1461,25 @@ func (r *IstioControlPlaneReconciler) setSidecarInjectorChecksumToStatus(ctx con
 	}
 
 	if len(configmaps.Items) == 1 {
-		cm := configmaps.Items[0]
-		jm, err := json.Marshal(cm.Data)
-		if err != nil {
-			return err
-		}
+		return SetSidecarInjectorChecksumToStatus(icp, &configmaps.Items[0])
+	}
 
-		cs := icp.Status.GetChecksums()
-		if cs == nil {
-			cs = &servicemeshv1alpha1.StatusChecksums{}
-		}
-		cs.SidecarInjector = fmt.Sprintf("%x", sha256.Sum256(jm))
-		icp.Status.Checksums = cs
+	return nil
+}
+
+// SetSidecarInjectorChecksumToStatus sets the checksum of the sidecar injector configmap of the control plane to its status
+func SetSidecarInjectorChecksumToStatus(icp *servicemeshv1alpha1.IstioControlPlane, configmap *corev1.ConfigMap) error {
+	jm, err := json.Marshal(configmap.Data)
+	if err != nil {
+		return err
+	}
+
+	cs := icp.Status.GetChecksums()
+	if cs == nil {
+		cs = &servicemeshv1alpha1.StatusChecksums{}
 	}
+	cs.SidecarInjector = fmt.Sprintf("%x", sha256.Sum256(jm))
+	icp.Status.Checksums = cs
 
 	return nil
 }
@@ -1141,9 +1504,9 @@ func (r *IstioControlPlaneReconciler) setInjectionNamespacesToStatus(ctx context
 }
 
 // deleteIstioRootCAConfigmapsOnPassive deletes the istio-ca-root-cert-<revision> configmaps from passive clusters
-// to gracefully handle the ACTIVE --> PASSIVE ICP mode switch and letting the cluster registry controller to recreate
-// the configmap for the passive cluster
-// NOTE: if cluster registry controller is not used, these configmaps need to be recreated manually
+// to gracefully handle the ACTIVE --> PASSIVE ICP mode switch and letting the cluster registry controller or
+// the mesh peers of the active clusters to recreate the configmap for the passive cluster
+// NOTE: if neither the cluster registry controller nor mesh peers are used, these configmaps need to be recreated manually
 func (r *IstioControlPlaneReconciler) deleteIstioRootCAConfigmapsOnPassive(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) error {
 	if icp.GetSpec().GetMode() == servicemeshv1alpha1.ModeType_ACTIVE {
 		return nil
@@ -1158,6 +1521,12 @@ func (r *IstioControlPlaneReconciler) deleteIstioRootCAConfigmapsOnPassive(ctx c
 	}
 	selectors = selectors.Add(*crOwnershipFilter)
 
+	meshPeerFilter, err := labels.NewRequirement(meshPeerLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+	selectors = selectors.Add(*meshPeerFilter)
+
 	istioConfigFilter, err := labels.NewRequirement("istio.io/config", selection.Equals, []string{"true"})
 	if err != nil {
 		return errors.WithStackIf(err)
@@ -1210,21 +1579,33 @@ func (r *IstioControlPlaneReconciler) setIstioCARootCertToStatus(ctx context.Con
 		return nil
 	}
 
-	secret := &corev1.Secret{}
-	err := r.GetClient().Get(ctx, client.ObjectKey{
-		Name:      "istio-ca-secret",
-		Namespace: icp.GetNamespace(),
-	}, secret)
-	if k8serrors.IsNotFound(err) {
-		icp.Status.CaRootCertificate = ""
+	// istiod prefers the plug-in CA over its self-signed CA
+	var caRootCertificate string
+	for _, source := range []struct{ name, key string }{
+		{name: pluginca.SecretName, key: pluginca.RootCertKey},
+		{name: "istio-ca-secret", key: "ca-cert.pem"},
+	} {
+		secret := &corev1.Secret{}
+		err := r.GetClient().Get(ctx, client.ObjectKey{
+			Name:      source.name,
+			Namespace: icp.GetNamespace(),
+		}, secret)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
 
-		return nil
-	}
-	if err != nil {
-		return err
+		caRootCertificate = string(secret.Data[source.key])
+
+		break
 	}
 
-	icp.Status.CaRootCertificate = string(secret.Data["ca-cert.pem"])
+	if icp.Status.CaRootCertificate != "" && caRootCertificate != "" && icp.Status.CaRootCertificate != caRootCertificate {
+		r.Recorder.Eventf(icp, corev1.EventTypeNormal, eventReasonCARootCertificateChanged, "root certificate of the Istio CA changed to %s", describeCertificate(caRootCertificate))
+	}
+	icp.Status.CaRootCertificate = caRootCertificate
 
 	return nil
 }
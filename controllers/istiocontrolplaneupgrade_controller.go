@@ -0,0 +1,437 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	upgradeRequeueDuration = time.Second * 10
+
+	sidecarInjectAnnotation = "sidecar.istio.io/inject"
+)
+
+// errSameControlPlane is returned for upgrades which can not proceed until their spec is changed, they are not retried
+const errSameControlPlane = errors.Sentinel("source and target Istio control planes must be different")
+
+// IstioControlPlaneUpgradeReconciler reconciles an IstioControlPlaneUpgrade object
+type IstioControlPlaneUpgradeReconciler struct {
+	client.Client
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiocontrolplaneupgrades,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiocontrolplaneupgrades/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="apps",resources=statefulsets,verbs=get;list;watch;patch
+
+func (r *IstioControlPlaneUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("istiocontrolplaneupgrade", req.NamespacedName)
+
+	upgrade := &servicemeshv1alpha1.IstioControlPlaneUpgrade{}
+	err := r.Get(ctx, req.NamespacedName, upgrade)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	if !upgrade.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, upgrade, logger)
+	if errors.Is(err, errSameControlPlane) {
+		logger.Info("upgrade is invalid, it is not retried until its spec is changed", "error", err.Error())
+		if updateErr := components.UpdateStatus(ctx, r.Client, upgrade, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error()); updateErr != nil {
+			return ctrl.Result{}, errors.WithStack(updateErr)
+		}
+
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		updateErr := components.UpdateStatus(ctx, r.Client, upgrade, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return result, errors.WithStack(err)
+	}
+
+	state := servicemeshv1alpha1.ConfigState_Reconciling
+	switch upgrade.Status.Phase {
+	case servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Paused,
+		servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Completed,
+		servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RolledBack:
+		state = servicemeshv1alpha1.ConfigState_Available
+	}
+
+	if err := components.UpdateStatus(ctx, r.Client, upgrade, components.ConvertConfigStateToReconcileStatus(state), ""); err != nil && !k8serrors.IsNotFound(err) {
+		return result, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) reconcile(ctx context.Context, upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, logger logger.Logger) (ctrl.Result, error) {
+	source, err := r.getIstioControlPlane(ctx, upgrade.GetSpec().GetSource())
+	if err != nil {
+		return ctrl.Result{RequeueAfter: upgradeRequeueDuration}, errors.WrapIf(err, "could not get source Istio control plane")
+	}
+
+	target, err := r.getIstioControlPlane(ctx, upgrade.GetSpec().GetTarget())
+	if err != nil {
+		return ctrl.Result{RequeueAfter: upgradeRequeueDuration}, errors.WrapIf(err, "could not get target Istio control plane")
+	}
+
+	if source.NamespacedRevision() == target.NamespacedRevision() {
+		return ctrl.Result{}, errors.WithDetails(errSameControlPlane, "revision", source.NamespacedRevision())
+	}
+
+	upgrade.Status.SourceRevision = source.NamespacedRevision()
+	upgrade.Status.TargetRevision = target.NamespacedRevision()
+
+	if upgrade.Status.Phase == servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Pending {
+		namespaces, err := r.getNamespacesToUpgrade(ctx, upgrade, source)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		upgrade.Status.PendingNamespaces = namespaces
+		r.Recorder.Eventf(upgrade, corev1.EventTypeNormal, "UpgradeStarted",
+			"moving %d namespaces from control plane %s to %s", len(namespaces), source.NamespacedRevision(), target.NamespacedRevision())
+	}
+
+	if len(upgrade.Status.CurrentBatch) > 0 {
+		ready, err := r.areNamespacesReady(ctx, upgrade, upgrade.Status.CurrentBatch)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !ready {
+			logger.Info("waiting for the workloads of the current batch to become ready", "namespaces", upgrade.Status.CurrentBatch)
+
+			return ctrl.Result{RequeueAfter: upgradeRequeueDuration}, nil
+		}
+
+		// the batch in progress is always finished in the direction it was started, even if rollback was requested meanwhile
+		if upgrade.Status.Phase == servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RollingBack {
+			upgrade.Status.PendingNamespaces = append(upgrade.Status.PendingNamespaces, upgrade.Status.CurrentBatch...)
+		} else {
+			upgrade.Status.UpgradedNamespaces = append(upgrade.Status.UpgradedNamespaces, upgrade.Status.CurrentBatch...)
+		}
+
+		r.Recorder.Eventf(upgrade, corev1.EventTypeNormal, "BatchCompleted", "namespaces %s are ready", strings.Join(upgrade.Status.CurrentBatch, ", "))
+		upgrade.Status.CurrentBatch = nil
+	}
+
+	switch {
+	case upgrade.GetSpec().GetPaused():
+		r.setPhase(upgrade, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Paused)
+
+		return ctrl.Result{}, nil
+	case upgrade.GetSpec().GetRollback():
+		if len(upgrade.Status.UpgradedNamespaces) == 0 {
+			r.setPhase(upgrade, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RolledBack)
+
+			return ctrl.Result{}, nil
+		}
+
+		r.setPhase(upgrade, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RollingBack)
+
+		var batch []string
+		batch, upgrade.Status.UpgradedNamespaces = nextBatch(upgrade.Status.UpgradedNamespaces, upgrade.GetSpec().BatchSizeOrDefault())
+
+		return r.moveBatch(ctx, upgrade, source, batch, logger)
+	default:
+		if len(upgrade.Status.PendingNamespaces) == 0 {
+			r.setPhase(upgrade, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Completed)
+
+			return ctrl.Result{}, nil
+		}
+
+		r.setPhase(upgrade, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing)
+
+		var batch []string
+		batch, upgrade.Status.PendingNamespaces = nextBatch(upgrade.Status.PendingNamespaces, upgrade.GetSpec().BatchSizeOrDefault())
+
+		return r.moveBatch(ctx, upgrade, target, batch, logger)
+	}
+}
+
+// moveBatch relabels the namespaces of a batch to the given control plane and restarts their workloads,
+// provided that the istiod of the control plane is healthy. Every namespace is recorded in the current batch
+// as soon as it is moved, so that the status always tracks the namespaces which were already relabelled.
+func (r *IstioControlPlaneUpgradeReconciler) moveBatch(ctx context.Context, upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, icp *servicemeshv1alpha1.IstioControlPlane, batch []string, logger logger.Logger) (ctrl.Result, error) {
+	healthy, err := r.isIstiodHealthy(ctx, icp)
+	if err != nil {
+		putBackBatch(upgrade, batch)
+
+		return ctrl.Result{}, err
+	}
+
+	if !healthy {
+		logger.Info("waiting for the control plane to become healthy", "revision", icp.NamespacedRevision())
+
+		// put back the batch, it is picked up again on the next reconcile
+		putBackBatch(upgrade, batch)
+
+		return ctrl.Result{RequeueAfter: upgradeRequeueDuration}, nil
+	}
+
+	for i, name := range batch {
+		moved, err := r.moveNamespace(ctx, upgrade, icp, name, logger)
+		if err != nil {
+			// the namespace which failed and the rest of the batch are picked up again on the next reconcile
+			putBackBatch(upgrade, batch[i:])
+
+			return ctrl.Result{}, err
+		}
+		if moved {
+			upgrade.Status.CurrentBatch = append(upgrade.Status.CurrentBatch, name)
+		}
+	}
+
+	if len(upgrade.Status.CurrentBatch) > 0 {
+		r.Recorder.Eventf(upgrade, corev1.EventTypeNormal, "BatchStarted", "moving namespaces %s to control plane %s", strings.Join(upgrade.Status.CurrentBatch, ", "), icp.NamespacedRevision())
+	}
+
+	return ctrl.Result{RequeueAfter: upgradeRequeueDuration}, nil
+}
+
+// moveNamespace relabels the namespace to the given control plane and restarts its workloads,
+// it returns false if the namespace does not exist anymore
+func (r *IstioControlPlaneUpgradeReconciler) moveNamespace(ctx context.Context, upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, icp *servicemeshv1alpha1.IstioControlPlane, name string, logger logger.Logger) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("namespace is gone, skipping it", "namespace", name)
+
+			return false, nil
+		}
+
+		return false, errors.WrapIfWithDetails(err, "could not get namespace", "namespace", name)
+	}
+
+	patch := client.MergeFrom(ns.DeepCopy())
+	labels := ns.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	// the legacy injection label takes precedence over the revision label, so it must be removed
+	delete(labels, servicemeshv1alpha1.DeprecatedAutoInjectionLabel)
+	labels[servicemeshv1alpha1.RevisionedAutoInjectionLabel] = icp.NamespacedRevision()
+	ns.SetLabels(labels)
+
+	if err := r.Patch(ctx, ns, patch); err != nil {
+		return false, errors.WrapIfWithDetails(err, "could not relabel namespace", "namespace", name)
+	}
+
+	if upgrade.GetSpec().ShouldRestartWorkloads() {
+		restarted, err := k8sutil.RestartWorkloads(ctx, r.Client, name, isWorkloadAffectedByNamespaceRevision)
+		if err != nil {
+			return false, errors.WrapIfWithDetails(err, "could not restart workloads", "namespace", name)
+		}
+		logger.Info("workloads restarted", "namespace", name, "count", restarted)
+	}
+
+	return true, nil
+}
+
+// putBackBatch returns the namespaces which were not moved to the list they were taken from
+func putBackBatch(upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, namespaces []string) {
+	if upgrade.Status.Phase == servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RollingBack {
+		upgrade.Status.UpgradedNamespaces = append(append([]string{}, namespaces...), upgrade.Status.UpgradedNamespaces...)
+	} else {
+		upgrade.Status.PendingNamespaces = append(append([]string{}, namespaces...), upgrade.Status.PendingNamespaces...)
+	}
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) setPhase(upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, phase servicemeshv1alpha1.IstioControlPlaneUpgradePhase) {
+	if upgrade.Status.Phase == phase {
+		return
+	}
+
+	r.Recorder.Eventf(upgrade, corev1.EventTypeNormal, "Upgrade"+phase.String(), "upgrade phase changed from %s to %s", upgrade.Status.Phase, phase)
+	upgrade.Status.Phase = phase
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) getNamespacesToUpgrade(ctx context.Context, upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, source *servicemeshv1alpha1.IstioControlPlane) ([]string, error) {
+	if namespaces := upgrade.GetSpec().GetNamespaces(); len(namespaces) > 0 {
+		return append([]string{}, namespaces...), nil
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces, client.MatchingLabels(source.RevisionLabels())); err != nil {
+		return nil, errors.WrapIf(err, "could not list namespaces")
+	}
+
+	names := make([]string, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		names = append(names, ns.GetName())
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) areNamespacesReady(ctx context.Context, upgrade *servicemeshv1alpha1.IstioControlPlaneUpgrade, namespaces []string) (bool, error) {
+	if !upgrade.GetSpec().ShouldRestartWorkloads() {
+		return true, nil
+	}
+
+	for _, namespace := range namespaces {
+		ready, err := k8sutil.AreWorkloadsReady(ctx, r.Client, namespace, isWorkloadAffectedByNamespaceRevision)
+		if err != nil || !ready {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// isIstiodHealthy returns whether the control plane is reconciled and its istiod deployment is available,
+// passive control planes are served by the istiod of an active control plane of another cluster
+func (r *IstioControlPlaneUpgradeReconciler) isIstiodHealthy(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (bool, error) {
+	if icp.Status.Status != servicemeshv1alpha1.ConfigState_Available {
+		return false, nil
+	}
+
+	if icp.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		return true, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      icp.WithRevision("istiod"),
+		Namespace: icp.GetNamespace(),
+	}, deployment)
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapIfWithDetails(err, "could not get istiod deployment", "revision", icp.NamespacedRevision())
+	}
+
+	return k8sutil.IsWorkloadReady(deployment) && deployment.Status.AvailableReplicas > 0, nil
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) getIstioControlPlane(ctx context.Context, ref *servicemeshv1alpha1.NamespacedName) (*servicemeshv1alpha1.IstioControlPlane, error) {
+	icp := &servicemeshv1alpha1.IstioControlPlane{}
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}, icp)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get Istio control plane", "name", ref.GetName(), "namespace", ref.GetNamespace())
+	}
+
+	return icp, nil
+}
+
+func (r *IstioControlPlaneUpgradeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&servicemeshv1alpha1.IstioControlPlaneUpgrade{}, ctrlBuilder.WithPredicates(util.ObjectChangePredicate{Logger: r.Log})).
+		Watches(&source.Kind{
+			Type: &servicemeshv1alpha1.IstioControlPlane{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "IstioControlPlane",
+					APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+				},
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			upgrades := &servicemeshv1alpha1.IstioControlPlaneUpgradeList{}
+			if err := r.List(context.Background(), upgrades); err != nil {
+				r.Log.Error(err, "could not list istiocontrolplaneupgrade resources")
+
+				return nil
+			}
+
+			requests := make([]reconcile.Request, 0)
+			for _, upgrade := range upgrades.Items {
+				for _, ref := range []*servicemeshv1alpha1.NamespacedName{upgrade.GetSpec().GetSource(), upgrade.GetSpec().GetTarget()} {
+					if ref.GetName() == obj.GetName() && ref.GetNamespace() == obj.GetNamespace() {
+						requests = append(requests, reconcile.Request{
+							NamespacedName: client.ObjectKey{
+								Name:      upgrade.GetName(),
+								Namespace: upgrade.GetNamespace(),
+							},
+						})
+
+						break
+					}
+				}
+			}
+
+			return requests
+		})).
+		Complete(r)
+}
+
+func nextBatch(namespaces []string, size int) ([]string, []string) {
+	if size > len(namespaces) {
+		size = len(namespaces)
+	}
+
+	batch := append([]string{}, namespaces[:size]...)
+	rest := append([]string{}, namespaces[size:]...)
+
+	return batch, rest
+}
+
+// isWorkloadAffectedByNamespaceRevision returns false for workloads which opted out of injection or which are pinned
+// to a revision through their own label, changing the namespace label has no effect on them
+func isWorkloadAffectedByNamespaceRevision(template *corev1.PodTemplateSpec) bool {
+	if v, ok := template.GetAnnotations()[sidecarInjectAnnotation]; ok && v == "false" {
+		return false
+	}
+	if v, ok := template.GetLabels()[sidecarInjectAnnotation]; ok && v == "false" {
+		return false
+	}
+	if _, ok := template.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; ok {
+		return false
+	}
+
+	return true
+}
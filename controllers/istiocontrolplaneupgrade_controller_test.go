@@ -0,0 +1,351 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/kylelemons/godebug/pretty"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestNextBatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		namespaces    []string
+		size          int
+		expectedBatch []string
+		expectedRest  []string
+	}{
+		{
+			name:          "batch smaller than the namespaces",
+			namespaces:    []string{"a", "b", "c"},
+			size:          2,
+			expectedBatch: []string{"a", "b"},
+			expectedRest:  []string{"c"},
+		},
+		{
+			name:          "batch larger than the namespaces",
+			namespaces:    []string{"a"},
+			size:          3,
+			expectedBatch: []string{"a"},
+			expectedRest:  []string{},
+		},
+		{
+			name:          "no namespaces",
+			size:          1,
+			expectedBatch: []string{},
+			expectedRest:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			batch, rest := nextBatch(tc.namespaces, tc.size)
+			if diff := pretty.Compare(batch, tc.expectedBatch); diff != "" {
+				t.Errorf("unexpected batch (-got +want):\n%s", diff)
+			}
+			if diff := pretty.Compare(rest, tc.expectedRest); diff != "" {
+				t.Errorf("unexpected rest (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func newUpgradeTestControlPlane(name string) *servicemeshv1alpha1.IstioControlPlane {
+	return &servicemeshv1alpha1.IstioControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "IstioControlPlane",
+			APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system"},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+			Version: "1.12.5",
+			Mode:    servicemeshv1alpha1.ModeType_ACTIVE,
+		},
+		Status: servicemeshv1alpha1.IstioControlPlaneStatus{
+			Status: servicemeshv1alpha1.ConfigState_Available,
+		},
+	}
+}
+
+func newReadyIstiod(icp *servicemeshv1alpha1.IstioControlPlane) *appsv1.Deployment {
+	replicas := int32(1)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: icp.WithRevision("istiod"), Namespace: icp.GetNamespace()},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          1,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 1,
+		},
+	}
+}
+
+type upgradeTest struct {
+	client   client.Client
+	r        *IstioControlPlaneUpgradeReconciler
+	source   *servicemeshv1alpha1.IstioControlPlane
+	target   *servicemeshv1alpha1.IstioControlPlane
+	upgrade  *servicemeshv1alpha1.IstioControlPlaneUpgrade
+	recorder *record.FakeRecorder
+}
+
+func newUpgradeTest(t *testing.T, namespaces ...string) *upgradeTest {
+	t.Helper()
+
+	source := newUpgradeTestControlPlane("cp-v111x")
+	target := newUpgradeTestControlPlane("cp-v112x")
+	restartWorkloads := false
+	batchSize := int32(2)
+	upgrade := &servicemeshv1alpha1.IstioControlPlaneUpgrade{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "IstioControlPlaneUpgrade",
+			APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "upgrade", Namespace: "istio-system"},
+		Spec: &servicemeshv1alpha1.IstioControlPlaneUpgradeSpec{
+			Source:           &servicemeshv1alpha1.NamespacedName{Name: source.GetName(), Namespace: source.GetNamespace()},
+			Target:           &servicemeshv1alpha1.NamespacedName{Name: target.GetName(), Namespace: target.GetNamespace()},
+			BatchSize:        &batchSize,
+			RestartWorkloads: &restartWorkloads,
+		},
+	}
+
+	objects := []client.Object{source, target, newReadyIstiod(source), newReadyIstiod(target), upgrade}
+	for _, name := range namespaces {
+		objects = append(objects, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: source.RevisionLabels()},
+		})
+	}
+
+	c := newFakeClient(objects...)
+	recorder := record.NewFakeRecorder(100)
+
+	return &upgradeTest{
+		client: c,
+		r: &IstioControlPlaneUpgradeReconciler{
+			Client:   c,
+			Log:      newTestLogger(),
+			Scheme:   newTestScheme(),
+			Recorder: recorder,
+		},
+		source:   source,
+		target:   target,
+		upgrade:  upgrade,
+		recorder: recorder,
+	}
+}
+
+func (u *upgradeTest) reconcile(t *testing.T) ctrl.Result {
+	t.Helper()
+
+	result, err := u.r.reconcile(context.Background(), u.upgrade, newTestLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return result
+}
+
+func (u *upgradeTest) expectStatus(t *testing.T, phase servicemeshv1alpha1.IstioControlPlaneUpgradePhase, pending, current, upgraded []string) {
+	t.Helper()
+
+	if u.upgrade.Status.Phase != phase {
+		t.Errorf("expected phase %s, got %s", phase, u.upgrade.Status.Phase)
+	}
+	for _, list := range []struct {
+		name     string
+		got      []string
+		expected []string
+	}{
+		{name: "pending", got: u.upgrade.Status.PendingNamespaces, expected: pending},
+		{name: "current", got: u.upgrade.Status.CurrentBatch, expected: current},
+		{name: "upgraded", got: u.upgrade.Status.UpgradedNamespaces, expected: upgraded},
+	} {
+		if len(list.got) == 0 && len(list.expected) == 0 {
+			continue
+		}
+		if diff := pretty.Compare(list.got, list.expected); diff != "" {
+			t.Errorf("unexpected %s namespaces (-got +want):\n%s", list.name, diff)
+		}
+	}
+}
+
+func (u *upgradeTest) expectNamespaceRevision(t *testing.T, icp *servicemeshv1alpha1.IstioControlPlane, namespaces ...string) {
+	t.Helper()
+
+	for _, name := range namespaces {
+		ns := &corev1.Namespace{}
+		if err := u.client.Get(context.Background(), client.ObjectKey{Name: name}, ns); err != nil {
+			t.Fatalf("could not get namespace %s: %v", name, err)
+		}
+		if rev := ns.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; rev != icp.NamespacedRevision() {
+			t.Errorf("expected namespace %s to be on revision %s, got %s", name, icp.NamespacedRevision(), rev)
+		}
+	}
+}
+
+func TestUpgradeMovesNamespacesInBatches(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a", "b", "c")
+
+	if result := u.reconcile(t); result.RequeueAfter != upgradeRequeueDuration {
+		t.Errorf("expected requeue after %s, got %s", upgradeRequeueDuration, result.RequeueAfter)
+	}
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, []string{"c"}, []string{"a", "b"}, nil)
+	u.expectNamespaceRevision(t, u.target, "a", "b")
+	u.expectNamespaceRevision(t, u.source, "c")
+
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, nil, []string{"c"}, []string{"a", "b"})
+	u.expectNamespaceRevision(t, u.target, "a", "b", "c")
+
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Completed, nil, nil, []string{"a", "b", "c"})
+}
+
+func TestUpgradeWaitsForHealthyTarget(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a", "b", "c")
+
+	target := &servicemeshv1alpha1.IstioControlPlane{}
+	if err := u.client.Get(context.Background(), client.ObjectKeyFromObject(u.target), target); err != nil {
+		t.Fatal(err)
+	}
+	target.Status.Status = servicemeshv1alpha1.ConfigState_Reconciling
+	if err := u.client.Update(context.Background(), target); err != nil {
+		t.Fatal(err)
+	}
+
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, []string{"a", "b", "c"}, nil, nil)
+	u.expectNamespaceRevision(t, u.source, "a", "b", "c")
+}
+
+func TestUpgradePause(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a", "b", "c")
+
+	u.reconcile(t)
+
+	// the batch in progress is finished, but the next one is not started
+	u.upgrade.Spec.Paused = true
+	if result := u.reconcile(t); result.RequeueAfter != 0 {
+		t.Errorf("paused upgrade should not be requeued, got %s", result.RequeueAfter)
+	}
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Paused, []string{"c"}, nil, []string{"a", "b"})
+	u.expectNamespaceRevision(t, u.source, "c")
+
+	u.upgrade.Spec.Paused = false
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, nil, []string{"c"}, []string{"a", "b"})
+}
+
+func TestUpgradeRollback(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a", "b", "c")
+
+	u.reconcile(t)
+
+	// the batch in progress is finished in the upgrade direction before the namespaces are moved back
+	u.upgrade.Spec.Rollback = true
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RollingBack, []string{"c"}, []string{"a", "b"}, nil)
+	u.expectNamespaceRevision(t, u.source, "a", "b", "c")
+
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_RolledBack, []string{"c", "a", "b"}, nil, nil)
+}
+
+// failingPatchClient fails the patches of the given namespace
+type failingPatchClient struct {
+	client.Client
+	namespace string
+}
+
+func (c failingPatchClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if _, ok := obj.(*corev1.Namespace); ok && obj.GetName() == c.namespace {
+		return errors.New("patch failed")
+	}
+
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestUpgradeKeepsPartialBatchOnError(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a", "b", "c")
+	u.r.Client = failingPatchClient{Client: u.client, namespace: "b"}
+
+	if _, err := u.r.reconcile(context.Background(), u.upgrade, newTestLogger()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	// the relabelled namespace is tracked in the current batch, the rest is retried
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, []string{"b", "c"}, []string{"a"}, nil)
+	u.expectNamespaceRevision(t, u.target, "a")
+
+	u.r.Client = u.client
+	u.reconcile(t)
+	u.expectStatus(t, servicemeshv1alpha1.IstioControlPlaneUpgradePhase_Progressing, nil, []string{"b", "c"}, []string{"a"})
+}
+
+func TestUpgradeWithSameControlPlaneIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	u := newUpgradeTest(t, "a")
+	u.upgrade.Spec.Target = u.upgrade.Spec.Source
+	if err := u.client.Update(context.Background(), u.upgrade); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := u.r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(u.upgrade)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Requeue || result.RequeueAfter != 0 {
+		t.Errorf("expected no requeue, got %+v", result)
+	}
+
+	upgrade := &servicemeshv1alpha1.IstioControlPlaneUpgrade{}
+	if err := u.client.Get(context.Background(), client.ObjectKeyFromObject(u.upgrade), upgrade); err != nil {
+		t.Fatal(err)
+	}
+	if upgrade.Status.Status != servicemeshv1alpha1.ConfigState_ReconcileFailed {
+		t.Errorf("expected status %s, got %s", servicemeshv1alpha1.ConfigState_ReconcileFailed, upgrade.Status.Status)
+	}
+	u.expectNamespaceRevision(t, u.source, "a")
+}
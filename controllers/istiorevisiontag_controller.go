@@ -0,0 +1,345 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	revisionTagRequeueDuration = time.Second * 10
+
+	sidecarInjectorWebhookPath = "/inject"
+)
+
+// IstioRevisionTagReconciler reconciles an IstioRevisionTag object
+type IstioRevisionTagReconciler struct {
+	client.Client
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiorevisiontags,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiorevisiontags/status,verbs=get;update;patch
+
+func (r *IstioRevisionTagReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("istiorevisiontag", req.Name)
+
+	tag := &servicemeshv1alpha1.IstioRevisionTag{}
+	err := r.Get(ctx, req.NamespacedName, tag)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the webhook configuration of the tag is garbage collected through its owner reference
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	if !tag.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, tag, logger)
+	if err != nil {
+		updateErr := components.UpdateStatus(ctx, r.Client, tag, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return result, errors.WithStack(err)
+	}
+
+	state := servicemeshv1alpha1.ConfigState_Available
+	if result.RequeueAfter > 0 {
+		state = servicemeshv1alpha1.ConfigState_Reconciling
+	}
+
+	if err := components.UpdateStatus(ctx, r.Client, tag, components.ConvertConfigStateToReconcileStatus(state), ""); err != nil && !k8serrors.IsNotFound(err) {
+		return result, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+func (r *IstioRevisionTagReconciler) reconcile(ctx context.Context, tag *servicemeshv1alpha1.IstioRevisionTag, logger logger.Logger) (ctrl.Result, error) {
+	ref := tag.GetSpec().GetIstioControlPlane()
+
+	icp := &servicemeshv1alpha1.IstioControlPlane{}
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}, icp)
+	if err != nil {
+		return ctrl.Result{}, errors.WrapIfWithDetails(err, "could not get Istio control plane", "name", ref.GetName(), "namespace", ref.GetNamespace())
+	}
+
+	if !icp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, errors.NewWithDetails("Istio control plane is being deleted", "revision", icp.NamespacedRevision())
+	}
+
+	caBundle, err := r.getSidecarInjectorCABundle(ctx, icp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if len(caBundle) == 0 {
+		logger.Info("waiting for the sidecar injector webhook of the control plane to get its CA bundle", "revision", icp.NamespacedRevision())
+
+		return ctrl.Result{RequeueAfter: revisionTagRequeueDuration}, nil
+	}
+
+	// the webhook configuration is updated in place, so injection for the namespaces of the tag
+	// switches from one control plane to the other with a single API call
+	desired := revisionTagWebhookConfiguration(tag, icp, caBundle)
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: desired.GetName(),
+		},
+	}
+	operation, err := controllerutil.CreateOrUpdate(ctx, r.Client, mwc, func() error {
+		mwc.SetLabels(desired.GetLabels())
+		mwc.Webhooks = desired.Webhooks
+
+		return controllerutil.SetControllerReference(tag, mwc, r.Scheme)
+	})
+	if err != nil {
+		return ctrl.Result{}, errors.WrapIfWithDetails(err, "could not reconcile mutating webhook configuration", "name", mwc.GetName())
+	}
+
+	if operation != controllerutil.OperationResultNone {
+		logger.Info("mutating webhook configuration reconciled", "name", mwc.GetName(), "operation", operation, "revision", icp.NamespacedRevision())
+	}
+
+	if tag.Status.Revision != "" && tag.Status.Revision != icp.NamespacedRevision() {
+		r.Recorder.Eventf(tag, corev1.EventTypeNormal, "TagRepointed", "revision tag %s moved from control plane %s to %s", tag.Tag(), tag.Status.Revision, icp.NamespacedRevision())
+	}
+
+	tag.Status.Revision = icp.NamespacedRevision()
+	tag.Status.MutatingWebhookConfigurationName = mwc.GetName()
+
+	return ctrl.Result{}, nil
+}
+
+// getSidecarInjectorCABundle returns the CA bundle of the sidecar injector webhook of a control plane,
+// which is patched into the webhook configuration by istiod
+func (r *IstioRevisionTagReconciler) getSidecarInjectorCABundle(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]byte, error) {
+	mwcs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := r.List(ctx, mwcs, client.MatchingLabels(icp.RevisionLabels())); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list mutating webhook configurations", "revision", icp.NamespacedRevision())
+	}
+
+	for _, mwc := range mwcs.Items {
+		// the webhook configurations of other tags pointing to the same control plane carry the same labels
+		if _, ok := mwc.GetLabels()[servicemeshv1alpha1.RevisionTagLabel]; ok {
+			continue
+		}
+
+		for _, webhook := range mwc.Webhooks {
+			if len(webhook.ClientConfig.CABundle) > 0 {
+				return webhook.ClientConfig.CABundle, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *IstioRevisionTagReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&servicemeshv1alpha1.IstioRevisionTag{}, ctrlBuilder.WithPredicates(util.ObjectChangePredicate{Logger: r.Log})).
+		Owns(&admissionregistrationv1.MutatingWebhookConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "MutatingWebhookConfiguration",
+				APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+			},
+		}).
+		Watches(&source.Kind{
+			Type: &servicemeshv1alpha1.IstioControlPlane{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "IstioControlPlane",
+					APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+				},
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			return r.tagRequestsForControlPlane(obj.GetName(), obj.GetNamespace())
+		})).
+		Watches(&source.Kind{
+			Type: &admissionregistrationv1.MutatingWebhookConfiguration{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "MutatingWebhookConfiguration",
+					APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+				},
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			// CA bundle changes of the sidecar injector webhooks of the control planes are propagated to the tags
+			if _, ok := obj.GetLabels()[servicemeshv1alpha1.RevisionTagLabel]; ok {
+				return nil
+			}
+
+			revision, ok := obj.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]
+			if !ok {
+				return nil
+			}
+
+			icp := servicemeshv1alpha1.NamespacedNameFromRevision(revision)
+
+			return r.tagRequestsForControlPlane(icp.Name, icp.Namespace)
+		})).
+		Complete(r)
+}
+
+func (r *IstioRevisionTagReconciler) tagRequestsForControlPlane(name, namespace string) []reconcile.Request {
+	tags := &servicemeshv1alpha1.IstioRevisionTagList{}
+	if err := r.List(context.Background(), tags); err != nil {
+		r.Log.Error(err, "could not list istiorevisiontag resources")
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, tag := range tags.Items {
+		ref := tag.GetSpec().GetIstioControlPlane()
+		if ref.GetName() == name && ref.GetNamespace() == namespace {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name: tag.GetName(),
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// revisionTagWebhookConfiguration returns the mutating webhook configuration of a revision tag, it is the same as the
+// revision-tags.yaml template of the istio-discovery chart renders for a tag pointing to the revision of the control plane
+func revisionTagWebhookConfiguration(tag *servicemeshv1alpha1.IstioRevisionTag, icp *servicemeshv1alpha1.IstioControlPlane, caBundle []byte) *admissionregistrationv1.MutatingWebhookConfiguration {
+	serviceName := icp.WithRevision("istiod")
+	if icp.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		serviceName = icp.WithRevision("istio-sidecar-injector")
+	}
+
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	failurePolicy := admissionregistrationv1.Fail
+	webhook := func(prefix string, namespaceSelector, objectSelector *metav1.LabelSelector) admissionregistrationv1.MutatingWebhook {
+		return admissionregistrationv1.MutatingWebhook{
+			Name: fmt.Sprintf("%ssidecar-injector.istio.io", prefix),
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Name:      serviceName,
+					Namespace: icp.GetNamespace(),
+					Path:      utils.StringPointer(sidecarInjectorWebhookPath),
+					Port:      utils.IntPointer(443),
+				},
+				CABundle: caBundle,
+			},
+			Rules: []admissionregistrationv1.RuleWithOperations{
+				{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{""},
+						APIVersions: []string{"v1"},
+						Resources:   []string{"pods"},
+					},
+				},
+			},
+			SideEffects:             &sideEffects,
+			FailurePolicy:           &failurePolicy,
+			AdmissionReviewVersions: []string{"v1beta1", "v1"},
+			NamespaceSelector:       namespaceSelector,
+			ObjectSelector:          objectSelector,
+		}
+	}
+
+	notOptedOut := metav1.LabelSelectorRequirement{
+		Key:      sidecarInjectAnnotation,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{"false"},
+	}
+	noLegacyLabel := metav1.LabelSelectorRequirement{
+		Key:      servicemeshv1alpha1.DeprecatedAutoInjectionLabel,
+		Operator: metav1.LabelSelectorOpDoesNotExist,
+	}
+
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("istio-revision-tag-%s", tag.Tag()),
+			Labels: map[string]string{
+				servicemeshv1alpha1.RevisionTagLabel:             tag.Tag(),
+				servicemeshv1alpha1.RevisionedAutoInjectionLabel: icp.NamespacedRevision(),
+				"app": "sidecar-injector",
+			},
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			webhook("rev.namespace.", &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      servicemeshv1alpha1.RevisionedAutoInjectionLabel,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{tag.Tag()},
+					},
+					noLegacyLabel,
+				},
+			}, &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{notOptedOut},
+			}),
+			webhook("rev.object.", &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      servicemeshv1alpha1.RevisionedAutoInjectionLabel,
+						Operator: metav1.LabelSelectorOpDoesNotExist,
+					},
+					noLegacyLabel,
+				},
+			}, &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					notOptedOut,
+					{
+						Key:      servicemeshv1alpha1.RevisionedAutoInjectionLabel,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{tag.Tag()},
+					},
+				},
+			}),
+		},
+	}
+}
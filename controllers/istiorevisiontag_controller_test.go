@@ -0,0 +1,315 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func newTestRevisionTag(name string, icp *servicemeshv1alpha1.IstioControlPlane) *servicemeshv1alpha1.IstioRevisionTag {
+	return &servicemeshv1alpha1.IstioRevisionTag{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: k8stypes.UID("uid-of-" + name)},
+		Spec: &servicemeshv1alpha1.IstioRevisionTagSpec{
+			IstioControlPlane: &servicemeshv1alpha1.NamespacedName{
+				Name:      icp.GetName(),
+				Namespace: icp.GetNamespace(),
+			},
+		},
+	}
+}
+
+// newSidecarInjectorWebhook returns the sidecar injector webhook configuration of the control plane
+// with the CA bundle istiod patches into it
+func newSidecarInjectorWebhook(icp *servicemeshv1alpha1.IstioControlPlane, caBundle string) *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   icp.WithRevision("istio-sidecar-injector") + "-" + icp.GetNamespace(),
+			Labels: icp.RevisionLabels(),
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name: "rev.namespace.sidecar-injector.istio.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					CABundle: []byte(caBundle),
+				},
+			},
+		},
+	}
+}
+
+func newRevisionTagTest(objects ...client.Object) (*IstioRevisionTagReconciler, client.Client, *record.FakeRecorder) {
+	c := newFakeClient(objects...)
+	recorder := record.NewFakeRecorder(10)
+
+	return &IstioRevisionTagReconciler{
+		Client:   c,
+		Log:      newTestLogger(),
+		Scheme:   c.Scheme(),
+		Recorder: recorder,
+	}, c, recorder
+}
+
+func getRevisionTagWebhook(t *testing.T, c client.Client, tag string) *admissionregistrationv1.MutatingWebhookConfiguration {
+	t.Helper()
+
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: fmt.Sprintf("istio-revision-tag-%s", tag)}, mwc); err != nil {
+		t.Fatal(err)
+	}
+
+	return mwc
+}
+
+func TestRevisionTagCreatesWebhookConfiguration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	tag := newTestRevisionTag("stable", icp)
+	r, c, _ := newRevisionTagTest(icp, tag, newSidecarInjectorWebhook(icp, "ca of cp-v112x"))
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Fatalf("unexpected requeue: %v", result)
+	}
+
+	mwc := getRevisionTagWebhook(t, c, "stable")
+	expectedLabels := map[string]string{
+		servicemeshv1alpha1.RevisionTagLabel:             "stable",
+		servicemeshv1alpha1.RevisionedAutoInjectionLabel: icp.NamespacedRevision(),
+		"app": "sidecar-injector",
+	}
+	if diff := pretty.Compare(mwc.GetLabels(), expectedLabels); diff != "" {
+		t.Fatalf("unexpected labels (-got +want):\n%s", diff)
+	}
+	if len(mwc.Webhooks) != 2 {
+		t.Fatalf("unexpected webhooks: %v", mwc.Webhooks)
+	}
+	for _, webhook := range mwc.Webhooks {
+		if string(webhook.ClientConfig.CABundle) != "ca of cp-v112x" {
+			t.Errorf("CA bundle of the control plane is not copied to %s: %s", webhook.Name, webhook.ClientConfig.CABundle)
+		}
+		if service := webhook.ClientConfig.Service; service.Name != icp.WithRevision("istiod") || service.Namespace != icp.GetNamespace() {
+			t.Errorf("unexpected service of %s: %v", webhook.Name, service)
+		}
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+	if tag.Status.Status != servicemeshv1alpha1.ConfigState_Available || tag.Status.Revision != icp.NamespacedRevision() || tag.Status.MutatingWebhookConfigurationName != mwc.GetName() {
+		t.Fatalf("unexpected status: %v", tag.Status)
+	}
+}
+
+func TestRevisionTagWaitsForCABundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	tag := newTestRevisionTag("stable", icp)
+	r, c, _ := newRevisionTagTest(icp, tag, newSidecarInjectorWebhook(icp, ""))
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != revisionTagRequeueDuration {
+		t.Fatalf("tag should be requeued until the CA bundle is available: %v", result)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+	if tag.Status.Status != servicemeshv1alpha1.ConfigState_Reconciling {
+		t.Fatalf("unexpected status: %v", tag.Status)
+	}
+
+	// the CA bundle patched into the injector webhook by istiod is picked up on the next reconcile
+	injector := newSidecarInjectorWebhook(icp, "")
+	if err := c.Get(ctx, client.ObjectKeyFromObject(injector), injector); err != nil {
+		t.Fatal(err)
+	}
+	injector.Webhooks[0].ClientConfig.CABundle = []byte("ca of cp-v112x")
+	if err := c.Update(ctx, injector); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)}); err != nil {
+		t.Fatal(err)
+	}
+	if caBundle := getRevisionTagWebhook(t, c, "stable").Webhooks[0].ClientConfig.CABundle; string(caBundle) != "ca of cp-v112x" {
+		t.Fatalf("unexpected CA bundle: %s", caBundle)
+	}
+}
+
+func TestRevisionTagRepointsToAnotherRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := newUpgradeTestControlPlane("cp-v112x")
+	target := newUpgradeTestControlPlane("cp-v113x")
+	target.Spec.Mode = servicemeshv1alpha1.ModeType_PASSIVE
+	tag := newTestRevisionTag("stable", source)
+	r, c, recorder := newRevisionTagTest(source, target, tag,
+		newSidecarInjectorWebhook(source, "ca of cp-v112x"),
+		newSidecarInjectorWebhook(target, "ca of cp-v113x"),
+	)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)}); err != nil {
+		t.Fatal(err)
+	}
+	// marks the webhook configuration to tell whether it is updated in place
+	created := getRevisionTagWebhook(t, c, "stable")
+	created.SetAnnotations(map[string]string{"test": "created"})
+	if err := c.Update(ctx, created); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+	tag.Spec.IstioControlPlane.Name = target.GetName()
+	if err := c.Update(ctx, tag); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the webhook configuration is updated in place
+	mwc := getRevisionTagWebhook(t, c, "stable")
+	if mwc.GetAnnotations()["test"] != "created" {
+		t.Fatal("webhook configuration of the tag should be updated instead of recreated")
+	}
+	if revision := mwc.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; revision != target.NamespacedRevision() {
+		t.Fatalf("unexpected revision label: %s", revision)
+	}
+	for _, webhook := range mwc.Webhooks {
+		if string(webhook.ClientConfig.CABundle) != "ca of cp-v113x" {
+			t.Errorf("CA bundle of the new control plane is not copied to %s: %s", webhook.Name, webhook.ClientConfig.CABundle)
+		}
+		// the injector of a passive control plane is served by its sidecar injector service
+		if service := webhook.ClientConfig.Service; service.Name != target.WithRevision("istio-sidecar-injector") {
+			t.Errorf("unexpected service of %s: %v", webhook.Name, service)
+		}
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+	if tag.Status.Revision != target.NamespacedRevision() {
+		t.Fatalf("unexpected status: %v", tag.Status)
+	}
+
+	expectedEvent := fmt.Sprintf("%s TagRepointed revision tag stable moved from control plane %s to %s", corev1.EventTypeNormal, source.NamespacedRevision(), target.NamespacedRevision())
+	if diff := pretty.Compare(recordedEvents(recorder), []string{expectedEvent}); diff != "" {
+		t.Fatalf("unexpected events (-got +want):\n%s", diff)
+	}
+}
+
+func TestRevisionTagIgnoresWebhooksOfOtherTags(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	otherTag := newSidecarInjectorWebhook(icp, "stale ca")
+	otherTag.Name = "istio-revision-tag-canary"
+	otherTag.Labels = map[string]string{
+		servicemeshv1alpha1.RevisionTagLabel:             "canary",
+		servicemeshv1alpha1.RevisionedAutoInjectionLabel: icp.NamespacedRevision(),
+	}
+	r, _, _ := newRevisionTagTest(otherTag, newSidecarInjectorWebhook(icp, "ca of cp-v112x"))
+
+	caBundle, err := r.getSidecarInjectorCABundle(context.Background(), icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(caBundle) != "ca of cp-v112x" {
+		t.Fatalf("unexpected CA bundle: %s", caBundle)
+	}
+}
+
+func TestRevisionTagWebhookConfigurationIsCleanedUp(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	tag := newTestRevisionTag("stable", icp)
+	r, c, _ := newRevisionTagTest(icp, tag, newSidecarInjectorWebhook(icp, "ca of cp-v112x"))
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+
+	// the webhook configuration is garbage collected by the API server along with the tag
+	owner := metav1.GetControllerOf(getRevisionTagWebhook(t, c, "stable"))
+	if owner == nil || owner.Kind != "IstioRevisionTag" || owner.Name != tag.GetName() || owner.UID != tag.GetUID() {
+		t.Fatalf("webhook configuration should be controlled by the tag: %v", owner)
+	}
+
+	if err := c.Delete(ctx, tag); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Requeue || result.RequeueAfter != 0 {
+		t.Fatalf("deleted tag should not be requeued: %v", result)
+	}
+}
+
+func TestRevisionTagOfMissingControlPlane(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tag := newTestRevisionTag("stable", newUpgradeTestControlPlane("cp-v112x"))
+	r, c, _ := newRevisionTagTest(tag)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tag)}); err == nil {
+		t.Fatal("missing control plane should be reported")
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(tag), tag); err != nil {
+		t.Fatal(err)
+	}
+	if tag.Status.Status != servicemeshv1alpha1.ConfigState_ReconcileFailed || tag.Status.ErrorMessage == "" {
+		t.Fatalf("unexpected status: %v", tag.Status)
+	}
+}
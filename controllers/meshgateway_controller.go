@@ -20,9 +20,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"emperror.dev/errors"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -30,17 +33,23 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	"github.com/banzaicloud/istio-operator/v2/internal/components"
 	"github.com/banzaicloud/istio-operator/v2/internal/components/istiomeshgateway"
+	"github.com/banzaicloud/istio-operator/v2/internal/gatewaytls"
+	"github.com/banzaicloud/istio-operator/v2/internal/tracing"
 	"github.com/banzaicloud/istio-operator/v2/internal/util"
 	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
@@ -60,12 +69,18 @@ const (
 // IstioMeshGatewayReconciler reconciles a IstioMeshGateway object
 type IstioMeshGatewayReconciler struct {
 	client.Client
-	Log    logger.Logger
-	Scheme *runtime.Scheme
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	watchersInitOnce         sync.Once
+	certificateWatchInitOnce sync.Once
+	ctrl                     controller.Controller
 }
 
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiomeshgateways,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=istiomeshgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 
 func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := r.Log.WithValues("istiomeshgateway", req.NamespacedName)
@@ -92,11 +107,22 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
+	if servicemeshv1alpha1.IsUnmanaged(imgw) {
+		logger.Info("mesh gateway is unmanaged, skipping reconciliation")
+
+		err = components.UpdateStatus(ctx, r.Client, imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Unmanaged), "")
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, errors.WithStack(err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
 	logger.Info("reconciling")
 
 	icp, err := r.getRelatedIstioControlPlane(ctx, r.GetClient(), imgw, logger)
 	if err != nil {
-		if err := util.RemoveFinalizer(ctx, r.Client, imgw, istioMeshGatewayFinalizerID, false); err != nil {
+		if err := removeFinalizer(ctx, r.Client, r.Recorder, imgw, istioMeshGatewayFinalizerID, false); err != nil {
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
@@ -104,6 +130,8 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	if !IsIstioVersionSupported(icp.Spec.Version) {
+		r.Recorder.Eventf(imgw, corev1.EventTypeWarning, eventReasonUnsupportedVersion, "Istio version %s of control plane %s is unsupported by this version of the operator", icp.Spec.Version, client.ObjectKeyFromObject(icp))
+
 		return ctrl.Result{}, nil
 	}
 
@@ -112,36 +140,39 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	enablePrometheusMerge := true
-	if icp.Status.GetMeshConfig().GetEnablePrometheusMerge() != nil {
-		enablePrometheusMerge = icp.Status.GetMeshConfig().GetEnablePrometheusMerge().Value
-	}
-
-	generateExternalService := false
-	if v, ok := imgw.GetAnnotations()[generateExternalServiceAnnotation]; ok && v == "true" {
-		generateExternalService = true
-	}
-
 	reconciler, err := NewComponentReconciler(r, func(helmReconciler *components.HelmReconciler) components.ComponentReconciler {
-		return istiomeshgateway.NewChartReconciler(helmReconciler, servicemeshv1alpha1.IstioMeshGatewayProperties{
-			Revision:                fmt.Sprintf("%s.%s", icp.GetName(), icp.GetNamespace()),
-			EnablePrometheusMerge:   utils.BoolPointer(enablePrometheusMerge),
-			InjectionTemplate:       "gateway",
-			InjectionChecksum:       icp.Status.GetChecksums().GetSidecarInjector(),
-			MeshConfigChecksum:      icp.Status.GetChecksums().GetMeshConfig(),
-			IstioControlPlane:       icp,
-			GenerateExternalService: generateExternalService,
-		}, r.Log)
+		return istiomeshgateway.NewChartReconciler(helmReconciler, NewIstioMeshGatewayProperties(imgw, icp), r.Log)
 	}, r.Log.WithName("istiomeshgateway"))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	result, err := reconciler.Reconcile(imgw)
+	var result ctrl.Result
+	err = tracing.Step(ctx, "component/"+reconciler.Name(), func(ctx context.Context) (err error) {
+		result, err = reconciler.Reconcile(imgw)
+
+		return err
+	})
 	if err != nil {
+		r.Recorder.Eventf(imgw, corev1.EventTypeWarning, eventReasonComponentReconcileFailed, "could not reconcile component %s: %s", reconciler.Name(), err)
+
 		return result, errors.WrapIf(err, "could not reconcile istio mesh gateway")
 	}
 
+	// the Istio CRDs are installed by the control plane, so the generated egress resources
+	// can only be watched once a mesh gateway of an existing control plane has been reconciled
+	r.watchersInitOnce.Do(func() {
+		err = r.watchIstioCRs()
+		if err != nil {
+			logger.Error(err, "unable to watch Istio Custom Resources")
+		}
+	})
+
+	renewAfter, err := r.reconcileTLS(ctx, imgw, icp, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if result.Requeue {
 		result.RequeueAfter = 0
 
@@ -153,7 +184,11 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return result, errors.WrapIf(err, "could not set gateway address")
 	}
 
-	err = util.RemoveFinalizer(ctx, r.Client, imgw, istioMeshGatewayFinalizerID, true)
+	if renewAfter > 0 && (result.RequeueAfter == 0 || renewAfter < result.RequeueAfter) {
+		result.RequeueAfter = renewAfter
+	}
+
+	err = removeFinalizer(ctx, r.Client, r.Recorder, imgw, istioMeshGatewayFinalizerID, true)
 	if err != nil {
 		return result, errors.WithStack(err)
 	}
@@ -161,6 +196,29 @@ func (r *IstioMeshGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	return result, nil
 }
 
+// NewIstioMeshGatewayProperties returns the properties of the mesh gateway which are derived from its control plane
+func NewIstioMeshGatewayProperties(imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) servicemeshv1alpha1.IstioMeshGatewayProperties {
+	enablePrometheusMerge := true
+	if icp.Status.GetMeshConfig().GetEnablePrometheusMerge() != nil {
+		enablePrometheusMerge = icp.Status.GetMeshConfig().GetEnablePrometheusMerge().Value
+	}
+
+	generateExternalService := false
+	if v, ok := imgw.GetAnnotations()[generateExternalServiceAnnotation]; ok && v == "true" {
+		generateExternalService = true
+	}
+
+	return servicemeshv1alpha1.IstioMeshGatewayProperties{
+		Revision:                fmt.Sprintf("%s.%s", icp.GetName(), icp.GetNamespace()),
+		EnablePrometheusMerge:   utils.BoolPointer(enablePrometheusMerge),
+		InjectionTemplate:       "gateway",
+		InjectionChecksum:       icp.Status.GetChecksums().GetSidecarInjector(),
+		MeshConfigChecksum:      icp.Status.GetChecksums().GetMeshConfig(),
+		IstioControlPlane:       icp,
+		GenerateExternalService: generateExternalService,
+	}
+}
+
 func (r *IstioMeshGatewayReconciler) GetClient() client.Client {
 	return r.Client
 }
@@ -188,6 +246,12 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				APIVersion: corev1.SchemeGroupVersion.String(),
 			},
 		}, ctrlBuilder.WithPredicates(objectChangePredicate)).
+		Owns(&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+		}, ctrlBuilder.WithPredicates(objectChangePredicate)).
 		Owns(&corev1.ServiceAccount{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "ServiceAccount",
@@ -229,6 +293,8 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	r.ctrl = ctrl
+
 	err = ctrl.Watch(&source.Kind{
 		Type: &servicemeshv1alpha1.IstioControlPlane{
 			TypeMeta: metav1.TypeMeta{
@@ -272,6 +338,138 @@ func (r *IstioMeshGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return nil
 }
 
+// watchIstioCRs watches the Istio networking resources generated for the egress configuration of the mesh gateways
+func (r *IstioMeshGatewayReconciler) watchIstioCRs() error {
+	if r.ctrl == nil {
+		return errors.New("ctrl is not set")
+	}
+
+	eventHandler := &handler.EnqueueRequestForOwner{
+		OwnerType: &servicemeshv1alpha1.IstioMeshGateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "IstioMeshGateway",
+				APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+		},
+		IsController: true,
+	}
+
+	types := []client.Object{
+		&istionetworkingv1alpha3.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Gateway",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.VirtualService{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualService",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.DestinationRule{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DestinationRule",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+		&istionetworkingv1alpha3.ServiceEntry{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ServiceEntry",
+				APIVersion: istionetworkingv1alpha3.SchemeGroupVersion.String(),
+			},
+		},
+	}
+
+	for _, t := range types {
+		err := r.ctrl.Watch(&source.Kind{Type: t}, eventHandler, util.ObjectChangePredicate{Logger: r.Log})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileTLS reconciles the TLS certificate of the mesh gateway and sets its state on the status,
+// which is persisted together with the gateway address. It returns the time after which the certificate has to be renewed.
+func (r *IstioMeshGatewayReconciler) reconcileTLS(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (time.Duration, error) {
+	previous := imgw.Status.GetTls()
+
+	result, err := gatewaytls.NewReconciler(r.Client, r.Scheme).Reconcile(ctx, imgw, icp)
+	if err != nil {
+		r.Recorder.Eventf(imgw, corev1.EventTypeWarning, eventReasonComponentReconcileFailed, "could not reconcile TLS certificate: %s", err)
+
+		imgw.SetCondition(servicemeshv1alpha1.Condition{
+			Type:               servicemeshv1alpha1.ConditionTypeCertificateReady,
+			Status:             servicemeshv1alpha1.ConditionStatus_False,
+			ObservedGeneration: imgw.GetGeneration(),
+			Reason:             servicemeshv1alpha1.ConditionReasonReconcileFailed,
+			Message:            errors.Cause(err).Error(),
+		})
+		updateErr := components.UpdateStatus(ctx, r.Client, imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), errors.Cause(err).Error())
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return 0, errors.WrapIf(err, "could not reconcile TLS certificate of istio mesh gateway")
+	}
+
+	imgw.Status.Tls = result.Status
+	if result.Status == nil {
+		servicemeshv1alpha1.RemoveCondition(&imgw.Status.Conditions, servicemeshv1alpha1.ConditionTypeCertificateReady)
+
+		return 0, nil
+	}
+
+	if imgw.GetSpec().GetTls().GetCertManagerIssuer() != nil {
+		// cert-manager is optional, so its certificates are only watched once a mesh gateway requested one
+		r.certificateWatchInitOnce.Do(func() {
+			err = r.watchCertificates()
+			if err != nil {
+				logger.Error(err, "unable to watch cert-manager certificates")
+			}
+		})
+	}
+
+	condition := servicemeshv1alpha1.Condition{
+		Type:               servicemeshv1alpha1.ConditionTypeCertificateReady,
+		Status:             servicemeshv1alpha1.ConditionStatus_False,
+		ObservedGeneration: imgw.GetGeneration(),
+		Reason:             servicemeshv1alpha1.ConditionReasonCertificatePending,
+		Message:            result.Message,
+	}
+	if result.Ready {
+		condition.Status = servicemeshv1alpha1.ConditionStatus_True
+		condition.Reason = servicemeshv1alpha1.ConditionReasonCertificateIssued
+	}
+	imgw.SetCondition(condition)
+
+	recordCertificateChange(r.Recorder, imgw, previous, result.Status)
+
+	return result.RequeueAfter, nil
+}
+
+// watchCertificates watches the cert-manager certificates requested for the mesh gateways
+func (r *IstioMeshGatewayReconciler) watchCertificates() error {
+	if r.ctrl == nil {
+		return errors.New("ctrl is not set")
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(gatewaytls.CertificateGVK)
+
+	return r.ctrl.Watch(&source.Kind{Type: certificate}, &handler.EnqueueRequestForOwner{
+		OwnerType: &servicemeshv1alpha1.IstioMeshGateway{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "IstioMeshGateway",
+				APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+		},
+		IsController: true,
+	}, predicate.Or(util.ObjectChangePredicate{Logger: r.Log}, util.UnstructuredStatusChangePredicate{}))
+}
+
 func (r *IstioMeshGatewayReconciler) getRelatedIstioControlPlane(ctx context.Context, c client.Client, imgw *servicemeshv1alpha1.IstioMeshGateway, logger logger.Logger) (*servicemeshv1alpha1.IstioControlPlane, error) {
 	icp := &servicemeshv1alpha1.IstioControlPlane{}
 
@@ -295,27 +493,27 @@ func (r *IstioMeshGatewayReconciler) getRelatedIstioControlPlane(ctx context.Con
 
 func (r *IstioMeshGatewayReconciler) getGatewayAddress(imgw *servicemeshv1alpha1.IstioMeshGateway) ([]string, bool, error) {
 	var service corev1.Service
-	var ips []string
-	var hasHostname bool
+	var addresses []string
+	var resolved bool
 
 	err := r.Get(context.Background(), client.ObjectKey{
 		Name:      imgw.GetName(),
 		Namespace: imgw.GetNamespace(),
 	}, &service)
 	if err != nil {
-		return nil, hasHostname, err
+		return nil, resolved, err
 	}
 
-	ips, hasHostname, err = k8sutil.GetServiceEndpointIPs(service)
+	addresses, resolved, err = k8sutil.GetServiceEndpointAddresses(service, imgw.GetSpec().GetAddressResolution())
 	if err != nil {
-		return nil, hasHostname, err
+		return nil, resolved, err
 	}
 
-	return ips, hasHostname, nil
+	return addresses, resolved, nil
 }
 
 func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c client.Client, imgw *servicemeshv1alpha1.IstioMeshGateway, logger logger.Logger, result ctrl.Result) (ctrl.Result, error) {
-	var gatewayHasHostname bool
+	var gatewayAddressResolved bool
 	var err error
 
 	if !imgw.DeletionTimestamp.IsZero() {
@@ -323,9 +521,16 @@ func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c cl
 	}
 
 	currentGatewayAddress := imgw.Status.GatewayAddress
-	imgw.Status.GatewayAddress, gatewayHasHostname, err = r.getGatewayAddress(imgw)
+	imgw.Status.GatewayAddress, gatewayAddressResolved, err = r.getGatewayAddress(imgw)
 	if err != nil {
 		logger.Info(fmt.Sprintf("gateway address pending: %s", err.Error()))
+		imgw.SetCondition(servicemeshv1alpha1.Condition{
+			Type:               servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned,
+			Status:             servicemeshv1alpha1.ConditionStatus_False,
+			ObservedGeneration: imgw.GetGeneration(),
+			Reason:             servicemeshv1alpha1.ConditionReasonAddressPending,
+			Message:            errors.Cause(err).Error(),
+		})
 		updateErr := components.UpdateStatus(ctx, c, imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), errors.Cause(err).Error())
 		if updateErr != nil {
 			logger.Error(updateErr, "failed to update state")
@@ -338,6 +543,8 @@ func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c cl
 		return result, nil
 	}
 
+	setGatewayAddressAssignedCondition(&imgw.Status.Conditions, imgw.GetGeneration(), currentGatewayAddress, imgw.Status.GatewayAddress)
+
 	updateErr := components.UpdateStatus(ctx, c, imgw, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Available), "")
 	if updateErr != nil {
 		logger.Error(updateErr, "failed to update state")
@@ -347,13 +554,30 @@ func (r *IstioMeshGatewayReconciler) setGatewayAddress(ctx context.Context, c cl
 
 	if !reflect.DeepEqual(currentGatewayAddress, imgw.Status.GatewayAddress) {
 		logger.Info("gateway address has changed, trigger reconciler by requeuing")
+		recordGatewayAddressChange(r.Recorder, imgw, currentGatewayAddress, imgw.Status.GatewayAddress)
 		result.Requeue = true
 	}
 
-	if gatewayHasHostname {
-		logger.Info(fmt.Sprintf("gateway uses hostname, trigger reconciliation after %s", hostnameSyncWaitDuration.String()))
+	if gatewayAddressResolved {
+		logger.Info(fmt.Sprintf("gateway address is resolved from hostname, trigger reconciliation after %s", hostnameSyncWaitDuration.String()))
 		result.RequeueAfter = hostnameSyncWaitDuration
 	}
 
 	return result, nil
 }
+
+// setGatewayAddressAssignedCondition sets the gateway address assigned condition, its last transition time is reset
+// whenever the address changes so that it tells when the current address was assigned
+func setGatewayAddressAssignedCondition(conditions *[]servicemeshv1alpha1.Condition, generation int64, previous, current []string) {
+	if !reflect.DeepEqual(previous, current) {
+		servicemeshv1alpha1.RemoveCondition(conditions, servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned)
+	}
+
+	servicemeshv1alpha1.SetCondition(conditions, servicemeshv1alpha1.Condition{
+		Type:               servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned,
+		Status:             servicemeshv1alpha1.ConditionStatus_True,
+		ObservedGeneration: generation,
+		Reason:             servicemeshv1alpha1.ConditionReasonAddressAssigned,
+		Message:            strings.Join(current, ", "),
+	})
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestSetGatewayAddressAssignedCondition(t *testing.T) {
+	t.Parallel()
+
+	assigned, _ := types.TimestampProto(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	tests := []struct {
+		name       string
+		status     servicemeshv1alpha1.ConditionStatus
+		previous   []string
+		current    []string
+		keepsTime  bool
+		newMessage string
+	}{
+		{
+			name:       "unchanged address keeps the assignment time",
+			status:     servicemeshv1alpha1.ConditionStatus_True,
+			previous:   []string{"10.0.0.1"},
+			current:    []string{"10.0.0.1"},
+			keepsTime:  true,
+			newMessage: "10.0.0.1",
+		},
+		{
+			name:       "changed address resets the assignment time",
+			status:     servicemeshv1alpha1.ConditionStatus_True,
+			previous:   []string{"10.0.0.1"},
+			current:    []string{"10.0.0.1", "10.0.0.2"},
+			newMessage: "10.0.0.1, 10.0.0.2",
+		},
+		{
+			name:       "assigned address after a pending one resets the assignment time",
+			status:     servicemeshv1alpha1.ConditionStatus_False,
+			current:    []string{"10.0.0.1"},
+			newMessage: "10.0.0.1",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conditions := []servicemeshv1alpha1.Condition{
+				{
+					Type:               servicemeshv1alpha1.ConditionTypeGatewayAddressAssigned,
+					Status:             tc.status,
+					LastTransitionTime: assigned,
+				},
+			}
+
+			setGatewayAddressAssignedCondition(&conditions, 2, tc.previous, tc.current)
+
+			if len(conditions) != 1 {
+				t.Fatalf("unexpected conditions: %v", conditions)
+			}
+			condition := conditions[0]
+			if condition.Status != servicemeshv1alpha1.ConditionStatus_True || condition.Message != tc.newMessage || condition.ObservedGeneration != 2 {
+				t.Fatalf("unexpected condition: %v", condition)
+			}
+			if keepsTime := condition.LastTransitionTime.Equal(assigned); keepsTime != tc.keepsTime {
+				t.Fatalf("unexpected last transition time %v, assigned at %v", condition.LastTransitionTime, assigned)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/gatewaytls"
+)
+
+// the certificates of the mesh gateways are reconciled against the API server, cert-manager itself is
+// replaced by the stub of its Certificate CRD and the status of the certificates is set by the tests
+var _ = Describe("IstioMeshGateway TLS", func() {
+	var (
+		ctx  context.Context
+		icp  *servicemeshv1alpha1.IstioControlPlane
+		imgw *servicemeshv1alpha1.IstioMeshGateway
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "imgw-tls-"}}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		icp = &servicemeshv1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "icp-v112x", Namespace: ns.GetName()},
+			Spec: &servicemeshv1alpha1.IstioControlPlaneSpec{
+				Version: "1.12.5",
+				Mode:    servicemeshv1alpha1.ModeType_ACTIVE,
+			},
+		}
+		Expect(k8sClient.Create(ctx, icp)).To(Succeed())
+
+		imgw = &servicemeshv1alpha1.IstioMeshGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: ns.GetName()},
+			Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+				Type: servicemeshv1alpha1.GatewayType_ingress,
+				Service: &servicemeshv1alpha1.Service{
+					Type:  string(corev1.ServiceTypeClusterIP),
+					Ports: []servicemeshv1alpha1.ServicePort{{Name: "https", Port: 443, Protocol: "TCP"}},
+				},
+				IstioControlPlane: &servicemeshv1alpha1.NamespacedName{
+					Name:      icp.GetName(),
+					Namespace: icp.GetNamespace(),
+				},
+				Tls: &servicemeshv1alpha1.IstioMeshGatewayTLS{
+					SecretName: "ingress-tls",
+					DnsNames:   []string{"example.com"},
+				},
+			},
+		}
+	})
+
+	It("issues the certificate from the CA of the operator", func() {
+		Expect(k8sClient.Create(ctx, imgw)).To(Succeed())
+
+		result, err := gatewaytls.NewReconciler(k8sClient, scheme.Scheme).Reconcile(ctx, imgw, icp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Ready).To(BeTrue())
+		Expect(result.Status.GetIssuer()).To(Equal(gatewaytls.IssuerOperatorCA))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		ca := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: gatewaytls.CASecretName, Namespace: icp.GetNamespace()}, ca)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "ingress-tls", Namespace: imgw.GetNamespace()}, secret)).To(Succeed())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(secret.Data).To(HaveKeyWithValue(gatewaytls.CACertKey, ca.Data[gatewaytls.CACertKey]))
+		Expect(metav1.IsControlledBy(secret, imgw)).To(BeTrue())
+
+		imgw.Status.Tls = result.Status
+		Expect(k8sClient.Status().Update(ctx, imgw)).To(Succeed())
+
+		stored := &servicemeshv1alpha1.IstioMeshGateway{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(imgw), stored)).To(Succeed())
+		Expect(stored.Status.GetTls().GetRenewalTime()).To(Equal(result.Status.GetRenewalTime()))
+	})
+
+	It("requests the certificate from cert-manager", func() {
+		imgw.Spec.Tls.CertManagerIssuer = &servicemeshv1alpha1.CertManagerIssuerReference{
+			Name: "letsencrypt",
+			Kind: "ClusterIssuer",
+		}
+		Expect(k8sClient.Create(ctx, imgw)).To(Succeed())
+
+		reconciler := gatewaytls.NewReconciler(k8sClient, scheme.Scheme)
+
+		result, err := reconciler.Reconcile(ctx, imgw, icp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Ready).To(BeFalse())
+
+		cert := &unstructured.Unstructured{}
+		cert.SetGroupVersionKind(gatewaytls.CertificateGVK)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(imgw), cert)).To(Succeed())
+		Expect(metav1.IsControlledBy(cert, imgw)).To(BeTrue())
+		kind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+		Expect(kind).To(Equal("ClusterIssuer"))
+
+		Expect(unstructured.SetNestedField(cert.Object, map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "Ready", "message": "Certificate is up to date and has not expired"},
+			},
+			"notBefore":   "2022-03-01T12:00:00Z",
+			"notAfter":    "2022-05-30T12:00:00Z",
+			"renewalTime": "2022-04-30T12:00:00Z",
+		}, "status")).To(Succeed())
+		Expect(k8sClient.Status().Update(ctx, cert)).To(Succeed())
+
+		result, err = reconciler.Reconcile(ctx, imgw, icp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Ready).To(BeTrue())
+		Expect(result.Status.GetIssuer()).To(Equal(gatewaytls.IssuerCertManager))
+		Expect(result.Status.GetNotAfter()).NotTo(BeNil())
+
+		imgw.Status.Tls = result.Status
+		imgw.Spec.Tls = nil
+
+		result, err = reconciler.Reconcile(ctx, imgw, icp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(BeNil())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(imgw), cert)).NotTo(Succeed())
+	})
+})
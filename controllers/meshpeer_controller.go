@@ -0,0 +1,634 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlBuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	clusterregistryv1alpha1 "github.com/banzaicloud/cluster-registry/api/v1alpha1"
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	meshPeerFinalizerID = "meshpeer.servicemesh.cisco.com"
+	// the mesh peers are resynced periodically as well, in case an event of the remote cluster was missed
+	meshPeerResyncDuration = time.Minute * 5
+	meshPeerRemoteTimeout  = time.Second * 30
+
+	// meshPeerLabel and meshPeerNamespaceLabel mark the root CA configmaps and the reader secrets synced by a mesh peer
+	meshPeerLabel          = "servicemesh.cisco.com/mesh-peer"
+	meshPeerNamespaceLabel = "servicemesh.cisco.com/mesh-peer-namespace"
+
+	istioCARootCertConfigMapName = "istio-ca-root-cert"
+	istioConfigLabel             = "istio.io/config"
+)
+
+// MeshPeerReconciler reconciles a MeshPeer object
+type MeshPeerReconciler struct {
+	client.Client
+	Log      logger.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	ctrl    controller.Controller
+	mu      sync.Mutex
+	remotes map[k8stypes.NamespacedName]*remoteCluster
+}
+
+// remoteCluster is the connection to the cluster of a mesh peer, its cache feeds the watches of the remote resources
+type remoteCluster struct {
+	cluster.Cluster
+
+	checksum string
+	cancel   context.CancelFunc
+}
+
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=meshpeers,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=servicemesh.cisco.com,resources=meshpeers/status,verbs=get;update;patch
+
+func (r *MeshPeerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("meshpeer", req.NamespacedName)
+
+	peer := &servicemeshv1alpha1.MeshPeer{}
+	err := r.Get(ctx, req.NamespacedName, peer)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the peer control plane is garbage collected through its owner reference
+			r.stopRemoteCluster(req.NamespacedName)
+
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	if !peer.DeletionTimestamp.IsZero() {
+		r.stopRemoteCluster(req.NamespacedName)
+
+		if err := r.deleteAllSyncedObjects(ctx, peer); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, errors.WithStack(removeFinalizer(ctx, r.Client, r.Recorder, peer, meshPeerFinalizerID, true))
+	}
+
+	if err := util.AddFinalizer(ctx, r.Client, peer, meshPeerFinalizerID); err != nil {
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	result, err := r.reconcile(ctx, peer, logger)
+	if err != nil {
+		updateErr := components.UpdateStatus(ctx, r.Client, peer, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_ReconcileFailed), err.Error())
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return result, errors.WithStack(err)
+	}
+
+	if err := components.UpdateStatus(ctx, r.Client, peer, components.ConvertConfigStateToReconcileStatus(servicemeshv1alpha1.ConfigState_Available), ""); err != nil && !k8serrors.IsNotFound(err) {
+		return result, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+func (r *MeshPeerReconciler) reconcile(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, logger logger.Logger) (ctrl.Result, error) {
+	remote, err := r.getRemoteCluster(ctx, peer)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, meshPeerRemoteTimeout)
+	defer cancel()
+
+	ref := peer.RemoteIstioControlPlane()
+	remoteICP := &servicemeshv1alpha1.IstioControlPlane{}
+	if err := remote.GetAPIReader().Get(ctx, ref, remoteICP); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the remote control plane is gone, so is everything synced from it
+			if err := r.deletePeerIstioControlPlane(ctx, peer); err != nil {
+				return ctrl.Result{}, err
+			}
+			if err := r.deleteAllSyncedObjects(ctx, peer); err != nil {
+				return ctrl.Result{}, err
+			}
+			peer.Status.PeerIstioControlPlane = ""
+		}
+
+		return ctrl.Result{}, errors.WrapIfWithDetails(err, "could not get remote Istio control plane", "name", ref.Name, "namespace", ref.Namespace)
+	}
+
+	picp, err := r.reconcilePeerIstioControlPlane(ctx, peer, remoteICP, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	localICP, err := r.getLocalIstioControlPlane(ctx, peer, remoteICP)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileRootCAConfigMaps(ctx, peer, remote, localICP, remoteICP, logger); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileReaderSecret(ctx, peer, remote, localICP, remoteICP, logger); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	peer.Status.ClusterID = remoteICP.GetStatus().ClusterID
+	peer.Status.PeerIstioControlPlane = picp.GetName()
+	peer.Status.LastSyncTime, _ = types.TimestampProto(time.Now().Truncate(time.Second))
+
+	return ctrl.Result{RequeueAfter: meshPeerResyncDuration}, nil
+}
+
+// reconcilePeerIstioControlPlane mirrors the remote control plane into a peer control plane the same way as
+// the resource sync rules of the cluster registry do, the status is copied as well so the local control planes
+// find their peers through the name of the control plane in the status
+func (r *MeshPeerReconciler) reconcilePeerIstioControlPlane(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, remoteICP *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (*servicemeshv1alpha1.PeerIstioControlPlane, error) {
+	picp := &servicemeshv1alpha1.PeerIstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      peer.PeerIstioControlPlaneName(),
+			Namespace: peer.GetNamespace(),
+		},
+	}
+	operation, err := controllerutil.CreateOrUpdate(ctx, r.Client, picp, func() error {
+		picp.SetLabels(remoteICP.GetLabels())
+		picp.Spec = remoteICP.GetSpec()
+
+		return controllerutil.SetControllerReference(peer, picp, r.Scheme)
+	})
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not reconcile peer Istio control plane", "name", picp.GetName())
+	}
+
+	if operation != controllerutil.OperationResultNone {
+		logger.Info("peer Istio control plane reconciled", "name", picp.GetName(), "operation", operation)
+	}
+
+	if !reflect.DeepEqual(picp.Status, remoteICP.GetStatus()) {
+		picp.Status = remoteICP.GetStatus()
+		if err := r.Status().Update(ctx, picp); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not update status of peer Istio control plane", "name", picp.GetName())
+		}
+	}
+
+	return picp, nil
+}
+
+// deletePeerIstioControlPlane deletes the peer control plane mirrored by the mesh peer
+func (r *MeshPeerReconciler) deletePeerIstioControlPlane(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer) error {
+	picp := &servicemeshv1alpha1.PeerIstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      peer.PeerIstioControlPlaneName(),
+			Namespace: peer.GetNamespace(),
+		},
+	}
+	if err := r.Delete(ctx, picp); client.IgnoreNotFound(err) != nil {
+		return errors.WrapIfWithDetails(err, "could not delete peer Istio control plane", "name", picp.GetName())
+	}
+
+	return nil
+}
+
+// getLocalIstioControlPlane returns the local control plane the remote control plane is peered with, or nil
+// if there is no such control plane in the namespace of the mesh peer
+func (r *MeshPeerReconciler) getLocalIstioControlPlane(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, remoteICP *servicemeshv1alpha1.IstioControlPlane) (*servicemeshv1alpha1.IstioControlPlane, error) {
+	localICP := &servicemeshv1alpha1.IstioControlPlane{}
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      remoteICP.GetStatus().IstioControlPlaneName,
+		Namespace: peer.GetNamespace(),
+	}, localICP)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not get local Istio control plane")
+	}
+
+	return localICP, nil
+}
+
+// reconcileRootCAConfigMaps syncs the root CA configmaps of an active remote control plane to the local cluster
+// when the local control plane of the same name is passive, as there is no istiod on the local cluster to create them
+func (r *MeshPeerReconciler) reconcileRootCAConfigMaps(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, remote *remoteCluster, localICP, remoteICP *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) error {
+	if localICP == nil || localICP.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_PASSIVE || remoteICP.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		return r.deleteSyncedObjects(ctx, peer, &corev1.ConfigMapList{}, nil)
+	}
+
+	name := localICP.WithRevisionIf(istioCARootCertConfigMapName, localICP.GetSpec().GetDistribution() == "cisco")
+
+	remoteConfigMaps := &corev1.ConfigMapList{}
+	if err := remote.GetAPIReader().List(ctx, remoteConfigMaps, client.MatchingLabels{istioConfigLabel: "true"}); err != nil {
+		return errors.WrapIf(err, "could not list remote root ca configmaps")
+	}
+
+	synced := make(map[k8stypes.NamespacedName]bool)
+	for _, remoteConfigMap := range remoteConfigMaps.Items {
+		if remoteConfigMap.GetName() != name {
+			continue
+		}
+
+		namespace := &corev1.Namespace{}
+		if err := r.Get(ctx, client.ObjectKey{Name: remoteConfigMap.GetNamespace()}, namespace); k8serrors.IsNotFound(err) || !namespace.DeletionTimestamp.IsZero() {
+			continue
+		} else if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      remoteConfigMap.GetName(),
+				Namespace: remoteConfigMap.GetNamespace(),
+			},
+		}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cm), cm); client.IgnoreNotFound(err) != nil {
+			return errors.WithStackIf(err)
+		}
+
+		if syncedByOther(cm, peer) {
+			continue
+		}
+
+		operation, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+			cm.SetLabels(peerLabels(remoteConfigMap.GetLabels(), peer))
+			cm.Data = remoteConfigMap.Data
+
+			return nil
+		})
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not reconcile root ca configmap", "name", cm.GetName(), "namespace", cm.GetNamespace())
+		}
+
+		if operation != controllerutil.OperationResultNone {
+			logger.Info("root ca configmap synced", "name", cm.GetName(), "namespace", cm.GetNamespace(), "operation", operation)
+		}
+
+		synced[client.ObjectKeyFromObject(cm)] = true
+	}
+
+	return r.deleteSyncedObjects(ctx, peer, &corev1.ConfigMapList{}, synced)
+}
+
+// reconcileReaderSecret syncs the reader secret of the remote cluster to the namespace of the local
+// control plane as a multi-cluster secret when the local control plane is active, so that its istiod discovers
+// the endpoints of the remote cluster without the resource sync rules of the cluster registry
+func (r *MeshPeerReconciler) reconcileReaderSecret(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, remote *remoteCluster, localICP, remoteICP *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) error {
+	if localICP == nil || localICP.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		return r.deleteSyncedObjects(ctx, peer, &corev1.SecretList{}, nil)
+	}
+
+	// only the reader secret of the remote cluster itself is synced, the ones of other clusters are synced by their own mesh peers
+	remoteSecret := &corev1.Secret{}
+	err := remote.GetAPIReader().Get(ctx, client.ObjectKey{
+		Name:      remoteICP.WithRevision(strings.ToLower(remoteICP.GetSpec().GetClusterID())),
+		Namespace: remoteICP.GetNamespace(),
+	}, remoteSecret)
+	if k8serrors.IsNotFound(err) || (err == nil && remoteSecret.Type != readerSecretType) {
+		return r.deleteSyncedObjects(ctx, peer, &corev1.SecretList{}, nil)
+	}
+	if err != nil {
+		return errors.WrapIf(err, "could not get remote reader secret")
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      remoteSecret.GetName(),
+			Namespace: localICP.GetNamespace(),
+		},
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return errors.WithStackIf(err)
+	}
+
+	if syncedByOther(secret, peer) {
+		return r.deleteSyncedObjects(ctx, peer, &corev1.SecretList{}, nil)
+	}
+
+	operation, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secretLabels := peerLabels(remoteSecret.GetLabels(), peer)
+		// istiod of the local control plane only picks up the multi-cluster secrets of its own revision
+		for k, v := range localICP.RevisionLabels() {
+			secretLabels[k] = v
+		}
+		secretLabels[multiClusterSecretLabel] = "true"
+		secret.SetLabels(secretLabels)
+		// the synced secret is not a reader secret of the local cluster, otherwise it would be synced
+		// further by the cluster registry
+		if secret.Type == "" {
+			secret.Type = corev1.SecretTypeOpaque
+		}
+		secret.Data = remoteSecret.Data
+
+		return nil
+	})
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "could not reconcile reader secret", "name", secret.GetName(), "namespace", secret.GetNamespace())
+	}
+
+	if operation != controllerutil.OperationResultNone {
+		logger.Info("reader secret synced", "name", secret.GetName(), "namespace", secret.GetNamespace(), "operation", operation)
+		r.Recorder.Eventf(peer, corev1.EventTypeNormal, eventReasonReaderSecretSynced, "reader secret %s/%s %s", secret.GetNamespace(), secret.GetName(), operation)
+	}
+
+	return r.deleteSyncedObjects(ctx, peer, &corev1.SecretList{}, map[k8stypes.NamespacedName]bool{
+		client.ObjectKeyFromObject(secret): true,
+	})
+}
+
+// deleteSyncedObjects deletes the objects of the list type synced by the mesh peer except the ones to keep
+func (r *MeshPeerReconciler) deleteSyncedObjects(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer, list client.ObjectList, keep map[k8stypes.NamespacedName]bool) error {
+	if err := r.List(ctx, list, client.MatchingLabels{
+		meshPeerLabel:          peer.GetName(),
+		meshPeerNamespaceLabel: peer.GetNamespace(),
+	}); err != nil {
+		return errors.WrapIf(err, "could not list synced objects")
+	}
+
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	for _, o := range objects {
+		obj, ok := o.(client.Object)
+		if !ok || keep[client.ObjectKeyFromObject(obj)] {
+			continue
+		}
+
+		if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return errors.WrapIfWithDetails(err, "could not delete synced object", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		}
+	}
+
+	return nil
+}
+
+// deleteAllSyncedObjects deletes every object synced by the mesh peer
+func (r *MeshPeerReconciler) deleteAllSyncedObjects(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer) error {
+	for _, list := range []client.ObjectList{&corev1.ConfigMapList{}, &corev1.SecretList{}} {
+		if err := r.deleteSyncedObjects(ctx, peer, list, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncedByOther tells whether the object is synced by the cluster registry or by another mesh peer,
+// these objects are left alone
+func syncedByOther(obj client.Object, peer *servicemeshv1alpha1.MeshPeer) bool {
+	if _, ok := obj.GetAnnotations()[clusterregistryv1alpha1.OwnershipAnnotation]; ok {
+		return true
+	}
+	if owner, ok := obj.GetLabels()[meshPeerLabel]; ok && (owner != peer.GetName() || obj.GetLabels()[meshPeerNamespaceLabel] != peer.GetNamespace()) {
+		return true
+	}
+
+	return false
+}
+
+// peerLabels returns a copy of the labels with the labels of the mesh peer added
+func peerLabels(l map[string]string, peer *servicemeshv1alpha1.MeshPeer) map[string]string {
+	result := make(map[string]string, len(l)+2)
+	for k, v := range l {
+		result[k] = v
+	}
+	result[meshPeerLabel] = peer.GetName()
+	result[meshPeerNamespaceLabel] = peer.GetNamespace()
+
+	return result
+}
+
+// getRemoteCluster returns the connection to the cluster of the mesh peer, a new connection is started when
+// the kubeconfig has changed and the changes of the resources on the remote cluster are watched through its cache
+func (r *MeshPeerReconciler) getRemoteCluster(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer) (*remoteCluster, error) {
+	kubeconfig, err := r.getKubeconfig(ctx, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	key := client.ObjectKeyFromObject(peer)
+	checksum := fmt.Sprintf("%x", sha256.Sum256(kubeconfig))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if remote, ok := r.remotes[key]; ok {
+		if remote.checksum == checksum {
+			return remote, nil
+		}
+
+		remote.cancel()
+		delete(r.remotes, key)
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not parse kubeconfig of the remote cluster")
+	}
+	config.Timeout = meshPeerRemoteTimeout
+
+	c, err := cluster.New(config, func(o *cluster.Options) {
+		o.Scheme = r.Scheme
+		o.NewCache = cache.BuilderWithOptions(cache.Options{
+			SelectorsByObject: cache.SelectorsByObject{
+				&corev1.ConfigMap{}: {
+					Label: labels.SelectorFromSet(labels.Set{istioConfigLabel: "true"}),
+				},
+				&corev1.Secret{}: {
+					Field: fields.OneTermEqualSelector("type", readerSecretType),
+				},
+			},
+		})
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not connect to the remote cluster")
+	}
+
+	remoteCtx, cancel := context.WithCancel(context.Background())
+	remote := &remoteCluster{
+		Cluster:  c,
+		checksum: checksum,
+		cancel:   cancel,
+	}
+
+	go func() {
+		if err := c.Start(remoteCtx); err != nil {
+			r.Log.Error(err, "remote cluster of mesh peer stopped", "meshpeer", key)
+		}
+	}()
+
+	enqueue := handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: key}}
+	})
+	if err := r.ctrl.Watch(source.NewKindWithCache(&servicemeshv1alpha1.IstioControlPlane{}, c.GetCache()), enqueue); err != nil {
+		cancel()
+
+		return nil, errors.WrapIf(err, "could not watch remote Istio control planes")
+	}
+	if err := r.ctrl.Watch(source.NewKindWithCache(&corev1.ConfigMap{}, c.GetCache()), enqueue, predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return strings.HasPrefix(obj.GetName(), istioCARootCertConfigMapName)
+	})); err != nil {
+		cancel()
+
+		return nil, errors.WrapIf(err, "could not watch remote root ca configmaps")
+	}
+	if err := r.ctrl.Watch(source.NewKindWithCache(&corev1.Secret{}, c.GetCache()), enqueue); err != nil {
+		cancel()
+
+		return nil, errors.WrapIf(err, "could not watch remote reader secrets")
+	}
+
+	r.remotes[key] = remote
+	r.Recorder.Eventf(peer, corev1.EventTypeNormal, eventReasonRemoteClusterConnected, "connected to remote cluster %s", config.Host)
+
+	return remote, nil
+}
+
+func (r *MeshPeerReconciler) stopRemoteCluster(key k8stypes.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if remote, ok := r.remotes[key]; ok {
+		remote.cancel()
+		delete(r.remotes, key)
+	}
+}
+
+func (r *MeshPeerReconciler) getKubeconfig(ctx context.Context, peer *servicemeshv1alpha1.MeshPeer) ([]byte, error) {
+	ref := peer.KubeconfigSecret()
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, ref, secret); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get kubeconfig secret", "name", ref.Name, "namespace", ref.Namespace)
+	}
+
+	key := peer.GetSpec().GetKubeconfigSecretKey()
+	if key == "" {
+		if len(secret.Data) != 1 {
+			return nil, errors.NewWithDetails("kubeconfig secret must have a single key if the key is not set", "name", ref.Name, "namespace", ref.Namespace)
+		}
+		for k := range secret.Data {
+			key = k
+		}
+	}
+
+	kubeconfig, ok := secret.Data[key]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, errors.NewWithDetails("kubeconfig secret has no kubeconfig", "name", ref.Name, "namespace", ref.Namespace, "key", key)
+	}
+
+	return kubeconfig, nil
+}
+
+func (r *MeshPeerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.remotes = make(map[k8stypes.NamespacedName]*remoteCluster)
+
+	var err error
+	r.ctrl, err = ctrl.NewControllerManagedBy(mgr).
+		For(&servicemeshv1alpha1.MeshPeer{}, ctrlBuilder.WithPredicates(util.ObjectChangePredicate{Logger: r.Log})).
+		Owns(&servicemeshv1alpha1.PeerIstioControlPlane{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PeerIstioControlPlane",
+				APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+		}).
+		Watches(&source.Kind{
+			Type: &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Secret",
+					APIVersion: corev1.SchemeGroupVersion.String(),
+				},
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			return r.peerRequests(obj.GetNamespace(), func(peer servicemeshv1alpha1.MeshPeer) bool {
+				return peer.KubeconfigSecret() == client.ObjectKeyFromObject(obj)
+			})
+		})).
+		Watches(&source.Kind{
+			Type: &servicemeshv1alpha1.IstioControlPlane{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "IstioControlPlane",
+					APIVersion: servicemeshv1alpha1.SchemeBuilder.GroupVersion.String(),
+				},
+			},
+		}, handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+			// the root CA configmaps and the reader secret are synced depending on the mode of the local control plane
+			return r.peerRequests(obj.GetNamespace(), func(peer servicemeshv1alpha1.MeshPeer) bool {
+				return true
+			})
+		}), ctrlBuilder.WithPredicates(util.ObjectChangePredicate{Logger: r.Log})).
+		Build(r)
+
+	return err
+}
+
+func (r *MeshPeerReconciler) peerRequests(namespace string, filter func(peer servicemeshv1alpha1.MeshPeer) bool) []reconcile.Request {
+	peers := &servicemeshv1alpha1.MeshPeerList{}
+	if err := r.List(context.Background(), peers, client.InNamespace(namespace)); err != nil {
+		r.Log.Error(err, "could not list meshpeer resources")
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, peer := range peers.Items {
+		if filter(peer) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&peer),
+			})
+		}
+	}
+
+	return requests
+}
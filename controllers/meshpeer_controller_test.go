@@ -0,0 +1,556 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	clusterregistryv1alpha1 "github.com/banzaicloud/cluster-registry/api/v1alpha1"
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+const testKubeconfig = "kubeconfig of cluster-2"
+
+// fakeRemoteCluster is the cluster of a mesh peer which is read through a fake client
+type fakeRemoteCluster struct {
+	cluster.Cluster
+
+	reader client.Reader
+}
+
+func (c fakeRemoteCluster) GetAPIReader() client.Reader {
+	return c.reader
+}
+
+func newTestMeshPeer() *servicemeshv1alpha1.MeshPeer {
+	return &servicemeshv1alpha1.MeshPeer{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-2", Namespace: "istio-system"},
+		Spec: &servicemeshv1alpha1.MeshPeerSpec{
+			KubeconfigSecret:  &servicemeshv1alpha1.NamespacedName{Name: "cluster-2-kubeconfig"},
+			IstioControlPlane: &servicemeshv1alpha1.NamespacedName{Name: "cp-v112x"},
+		},
+	}
+}
+
+func newTestKubeconfigSecret(kubeconfig string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-2-kubeconfig", Namespace: "istio-system"},
+		Data:       map[string][]byte{"kubeconfig": []byte(kubeconfig)},
+	}
+}
+
+func newSyncedConfigMap(name, namespace string, peer *servicemeshv1alpha1.MeshPeer) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: peerLabels(nil, peer)},
+	}
+}
+
+func newRootCAConfigMap(namespace, rootCert string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      istioCARootCertConfigMapName,
+			Namespace: namespace,
+			Labels:    map[string]string{istioConfigLabel: "true"},
+		},
+		Data: map[string]string{"root-cert.pem": rootCert},
+	}
+}
+
+// newMeshPeerTest returns a reconciler which is connected to the remote cluster of the mesh peer through
+// a fake client, and the client of the local cluster
+func newMeshPeerTest(remoteObjects []client.Object, localObjects ...client.Object) (*MeshPeerReconciler, client.Client, *record.FakeRecorder) {
+	peer := newTestMeshPeer()
+	local := newFakeClient(append(localObjects, peer, newTestKubeconfigSecret(testKubeconfig))...)
+	recorder := record.NewFakeRecorder(10)
+
+	r := &MeshPeerReconciler{
+		Client:   local,
+		Log:      newTestLogger(),
+		Scheme:   local.Scheme(),
+		Recorder: recorder,
+		remotes: map[k8stypes.NamespacedName]*remoteCluster{
+			client.ObjectKeyFromObject(peer): {
+				Cluster:  fakeRemoteCluster{reader: newFakeClient(remoteObjects...)},
+				checksum: fmt.Sprintf("%x", sha256.Sum256([]byte(testKubeconfig))),
+				cancel:   func() {},
+			},
+		},
+	}
+
+	return r, local, recorder
+}
+
+func newRemoteTestControlPlane(mode servicemeshv1alpha1.ModeType) *servicemeshv1alpha1.IstioControlPlane {
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Labels = map[string]string{"region": "eu-west-1"}
+	icp.Spec.Mode = mode
+	icp.Spec.ClusterID = "cluster-2"
+	icp.Status.ClusterID = "cluster-2"
+	icp.Status.IstioControlPlaneName = "cp-v112x"
+
+	return icp
+}
+
+func newLocalTestControlPlane(mode servicemeshv1alpha1.ModeType) *servicemeshv1alpha1.IstioControlPlane {
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Spec.Mode = mode
+
+	return icp
+}
+
+func TestSyncedByOther(t *testing.T) {
+	t.Parallel()
+
+	peer := newTestMeshPeer()
+	otherNamespacePeer := newTestMeshPeer()
+	otherNamespacePeer.Namespace = "istio-system-2"
+	otherPeer := newTestMeshPeer()
+	otherPeer.Name = "cluster-3"
+
+	testCases := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "object which is not synced",
+			expected: false,
+		},
+		{
+			name:     "object synced by the mesh peer",
+			labels:   peerLabels(nil, peer),
+			expected: false,
+		},
+		{
+			name:     "object synced by another mesh peer",
+			labels:   peerLabels(nil, otherPeer),
+			expected: true,
+		},
+		{
+			name:     "object synced by a mesh peer of the same name in another namespace",
+			labels:   peerLabels(nil, otherNamespacePeer),
+			expected: true,
+		},
+		{
+			name:        "object synced by the cluster registry",
+			annotations: map[string]string{clusterregistryv1alpha1.OwnershipAnnotation: "cluster-2"},
+			expected:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "istio-ca-root-cert", Namespace: "default", Labels: tc.labels, Annotations: tc.annotations},
+			}
+
+			if syncedByOther(cm, peer) != tc.expected {
+				t.Fatalf("expected synced by other to be %t", tc.expected)
+			}
+		})
+	}
+}
+
+func TestPeerLabels(t *testing.T) {
+	t.Parallel()
+
+	original := map[string]string{"istio.io/config": "true", meshPeerLabel: "cluster-3"}
+
+	result := peerLabels(original, newTestMeshPeer())
+
+	if diff := pretty.Compare(result, map[string]string{
+		"istio.io/config":      "true",
+		meshPeerLabel:          "cluster-2",
+		meshPeerNamespaceLabel: "istio-system",
+	}); diff != "" {
+		t.Fatalf("unexpected labels (-got +want):\n%s", diff)
+	}
+
+	if original[meshPeerLabel] != "cluster-3" || len(original) != 2 {
+		t.Fatalf("original labels were changed: %v", original)
+	}
+}
+
+func TestDeleteSyncedObjects(t *testing.T) {
+	t.Parallel()
+
+	peer := newTestMeshPeer()
+	otherPeer := newTestMeshPeer()
+	otherPeer.Name = "cluster-3"
+
+	kept := newSyncedConfigMap(istioCARootCertConfigMapName, "default", peer)
+	stale := newSyncedConfigMap(istioCARootCertConfigMapName, "removed", peer)
+	ofOtherPeer := newSyncedConfigMap(istioCARootCertConfigMapName, "other", otherPeer)
+	notSynced := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: istioCARootCertConfigMapName, Namespace: "istio-system"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "istio-reader", Namespace: "istio-system", Labels: peerLabels(nil, peer)}}
+
+	c := newFakeClient(kept, stale, ofOtherPeer, notSynced, secret)
+	r := &MeshPeerReconciler{Client: c}
+
+	if err := r.deleteSyncedObjects(context.Background(), peer, &corev1.ConfigMapList{}, map[k8stypes.NamespacedName]bool{
+		client.ObjectKeyFromObject(kept): true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		object  client.Object
+		deleted bool
+	}{
+		{kept, false},
+		{stale, true},
+		{ofOtherPeer, false},
+		{notSynced, false},
+		// only the objects of the list type are deleted
+		{secret, false},
+	} {
+		err := c.Get(context.Background(), client.ObjectKeyFromObject(tc.object), tc.object)
+		if deleted := k8serrors.IsNotFound(err); deleted != tc.deleted {
+			t.Errorf("%s/%s: expected deleted to be %t, got error %v", tc.object.GetNamespace(), tc.object.GetName(), tc.deleted, err)
+		}
+	}
+}
+
+func TestMeshPeerReconcileWithPassiveLocalControlPlane(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	peer := newTestMeshPeer()
+	remoteICP := newRemoteTestControlPlane(servicemeshv1alpha1.ModeType_ACTIVE)
+
+	registryConfigMap := newRootCAConfigMap("registry", "registry-root")
+	registryConfigMap.Labels = nil
+	registryConfigMap.Annotations = map[string]string{clusterregistryv1alpha1.OwnershipAnnotation: "cluster-2"}
+
+	r, c, _ := newMeshPeerTest(
+		[]client.Object{
+			remoteICP,
+			newRootCAConfigMap("default", "remote-root"),
+			newRootCAConfigMap("registry", "remote-root"),
+			newRootCAConfigMap("missing", "remote-root"),
+		},
+		newLocalTestControlPlane(servicemeshv1alpha1.ModeType_PASSIVE),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "registry"}},
+		registryConfigMap,
+		newSyncedConfigMap(istioCARootCertConfigMapName, "removed", peer),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "stale-reader", Namespace: "istio-system", Labels: peerLabels(nil, peer)}},
+	)
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(peer)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != meshPeerResyncDuration {
+		t.Fatalf("unexpected requeue after: %s", result.RequeueAfter)
+	}
+
+	picp := &servicemeshv1alpha1.PeerIstioControlPlane{}
+	if err := c.Get(ctx, client.ObjectKey{Name: peer.PeerIstioControlPlaneName(), Namespace: "istio-system"}, picp); err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(picp.GetLabels(), remoteICP.GetLabels()); diff != "" {
+		t.Fatalf("unexpected labels of the peer control plane (-got +want):\n%s", diff)
+	}
+	if picp.GetSpec().GetClusterID() != "cluster-2" || picp.GetStatus().IstioControlPlaneName != "cp-v112x" {
+		t.Fatalf("peer control plane is not mirrored: %v %v", picp.GetSpec(), picp.GetStatus())
+	}
+	if owner := metav1.GetControllerOf(picp); owner == nil || owner.Name != peer.GetName() {
+		t.Fatal("peer control plane is not owned by the mesh peer")
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: istioCARootCertConfigMapName, Namespace: "default"}, cm); err != nil {
+		t.Fatal(err)
+	}
+	if cm.Data["root-cert.pem"] != "remote-root" || syncedByOther(cm, peer) || cm.GetLabels()[meshPeerLabel] != peer.GetName() {
+		t.Fatalf("root CA configmap is not synced: %v", cm)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(registryConfigMap), cm); err != nil {
+		t.Fatal(err)
+	}
+	if cm.Data["root-cert.pem"] != "registry-root" {
+		t.Fatalf("root CA configmap synced by the cluster registry is changed: %v", cm.Data)
+	}
+
+	for _, obj := range []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: istioCARootCertConfigMapName, Namespace: "missing"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: istioCARootCertConfigMapName, Namespace: "removed"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "stale-reader", Namespace: "istio-system"}},
+	} {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); !k8serrors.IsNotFound(err) {
+			t.Errorf("%s/%s should not exist: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(peer), peer); err != nil {
+		t.Fatal(err)
+	}
+	if peer.GetStatus().Status != servicemeshv1alpha1.ConfigState_Available || peer.GetStatus().ClusterID != "cluster-2" || peer.GetStatus().PeerIstioControlPlane != picp.GetName() {
+		t.Fatalf("unexpected status of the mesh peer: %v", peer.GetStatus())
+	}
+	if len(peer.GetFinalizers()) != 1 || peer.GetFinalizers()[0] != meshPeerFinalizerID {
+		t.Fatalf("unexpected finalizers of the mesh peer: %v", peer.GetFinalizers())
+	}
+}
+
+func TestMeshPeerReconcileWithActiveLocalControlPlane(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	peer := newTestMeshPeer()
+	remoteICP := newRemoteTestControlPlane(servicemeshv1alpha1.ModeType_ACTIVE)
+	localICP := newLocalTestControlPlane(servicemeshv1alpha1.ModeType_ACTIVE)
+
+	readerSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      remoteICP.WithRevision(strings.ToLower(remoteICP.GetSpec().GetClusterID())),
+			Namespace: "istio-system",
+			Labels:    map[string]string{"app": "istio-reader"},
+		},
+		Type: readerSecretType,
+		Data: map[string][]byte{"cluster-2": []byte("reader kubeconfig")},
+	}
+
+	r, c, recorder := newMeshPeerTest(
+		[]client.Object{remoteICP, readerSecret, newRootCAConfigMap("default", "remote-root")},
+		localICP,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		newSyncedConfigMap(istioCARootCertConfigMapName, "default", peer),
+	)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(peer)}); err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(readerSecret), secret); err != nil {
+		t.Fatal(err)
+	}
+	if secret.Type != corev1.SecretTypeOpaque || string(secret.Data["cluster-2"]) != "reader kubeconfig" {
+		t.Fatalf("reader secret is not synced: %v", secret)
+	}
+	expectedLabels := peerLabels(map[string]string{"app": "istio-reader", multiClusterSecretLabel: "true"}, peer)
+	for k, v := range localICP.RevisionLabels() {
+		expectedLabels[k] = v
+	}
+	if diff := pretty.Compare(secret.GetLabels(), expectedLabels); diff != "" {
+		t.Fatalf("unexpected labels of the reader secret (-got +want):\n%s", diff)
+	}
+
+	if event := <-recorder.Events; !strings.HasPrefix(event, fmt.Sprintf("%s %s", corev1.EventTypeNormal, eventReasonReaderSecretSynced)) {
+		t.Fatalf("unexpected event: %s", event)
+	}
+
+	// the root CA configmaps are created by istiod of the active local control plane
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: istioCARootCertConfigMapName, Namespace: "default"}, cm); !k8serrors.IsNotFound(err) {
+		t.Fatalf("root CA configmap synced earlier should be deleted: %v", err)
+	}
+}
+
+func TestMeshPeerRemovesReaderSecretWhenRemoteReaderSecretIsGone(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	peer := newTestMeshPeer()
+	remoteICP := newRemoteTestControlPlane(servicemeshv1alpha1.ModeType_ACTIVE)
+
+	synced := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      remoteICP.WithRevision("cluster-2"),
+			Namespace: "istio-system",
+			Labels:    peerLabels(map[string]string{multiClusterSecretLabel: "true"}, peer),
+		},
+		Data: map[string][]byte{"cluster-2": []byte("reader kubeconfig")},
+	}
+
+	r, c, _ := newMeshPeerTest(
+		[]client.Object{remoteICP},
+		newLocalTestControlPlane(servicemeshv1alpha1.ModeType_ACTIVE),
+		synced,
+	)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(peer)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(synced), &corev1.Secret{}); !k8serrors.IsNotFound(err) {
+		t.Fatalf("synced reader secret should be deleted: %v", err)
+	}
+}
+
+func TestMeshPeerRemovesSyncedObjectsWhenRemoteControlPlaneIsGone(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	peer := newTestMeshPeer()
+
+	r, c, _ := newMeshPeerTest(
+		nil,
+		newLocalTestControlPlane(servicemeshv1alpha1.ModeType_PASSIVE),
+		&servicemeshv1alpha1.PeerIstioControlPlane{ObjectMeta: metav1.ObjectMeta{Name: peer.PeerIstioControlPlaneName(), Namespace: "istio-system"}},
+		newSyncedConfigMap(istioCARootCertConfigMapName, "default", peer),
+	)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(peer)}); err == nil {
+		t.Fatal("missing remote control plane should be reported")
+	}
+
+	for _, obj := range []client.Object{
+		&servicemeshv1alpha1.PeerIstioControlPlane{ObjectMeta: metav1.ObjectMeta{Name: peer.PeerIstioControlPlaneName(), Namespace: "istio-system"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: istioCARootCertConfigMapName, Namespace: "default"}},
+	} {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); !k8serrors.IsNotFound(err) {
+			t.Errorf("%s/%s should be deleted: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(peer), peer); err != nil {
+		t.Fatal(err)
+	}
+	if peer.GetStatus().Status != servicemeshv1alpha1.ConfigState_ReconcileFailed {
+		t.Fatalf("unexpected status of the mesh peer: %v", peer.GetStatus())
+	}
+}
+
+func TestMeshPeerReconnectsWhenKubeconfigChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	peer := newTestMeshPeer()
+
+	r, c, _ := newMeshPeerTest(nil)
+	key := client.ObjectKeyFromObject(peer)
+
+	cancelled := false
+	connected := r.remotes[key]
+	connected.cancel = func() {
+		cancelled = true
+	}
+
+	remote, err := r.getRemoteCluster(ctx, peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remote != connected || cancelled {
+		t.Fatal("connection should be kept while the kubeconfig is the same")
+	}
+
+	secret := newTestKubeconfigSecret("")
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatal(err)
+	}
+	secret.Data["kubeconfig"] = []byte("changed kubeconfig")
+	if err := c.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+
+	// the changed kubeconfig is not valid, so the new connection fails, but the old one is stopped anyway
+	if _, err := r.getRemoteCluster(ctx, peer); err == nil {
+		t.Fatal("invalid kubeconfig should be reported")
+	}
+	if !cancelled {
+		t.Fatal("connection with the previous kubeconfig should be stopped")
+	}
+	if _, ok := r.remotes[key]; ok {
+		t.Fatal("connection with the previous kubeconfig should be removed")
+	}
+}
+
+func TestGetKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		key      string
+		data     map[string][]byte
+		expected string
+		err      bool
+	}{
+		{
+			name:     "single key",
+			data:     map[string][]byte{"cluster-2": []byte("kubeconfig")},
+			expected: "kubeconfig",
+		},
+		{
+			name:     "configured key",
+			key:      "kubeconfig",
+			data:     map[string][]byte{"kubeconfig": []byte("kubeconfig"), "token": []byte("token")},
+			expected: "kubeconfig",
+		},
+		{
+			name: "multiple keys without configured key",
+			data: map[string][]byte{"kubeconfig": []byte("kubeconfig"), "token": []byte("token")},
+			err:  true,
+		},
+		{
+			name: "missing key",
+			key:  "kubeconfig",
+			data: map[string][]byte{"token": []byte("token")},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			peer := newTestMeshPeer()
+			peer.Spec.KubeconfigSecretKey = tc.key
+			secret := newTestKubeconfigSecret("")
+			secret.Data = tc.data
+
+			r := &MeshPeerReconciler{Client: newFakeClient(secret)}
+
+			kubeconfig, err := r.getKubeconfig(context.Background(), peer)
+			if tc.err {
+				if err == nil {
+					t.Fatal("error expected")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(kubeconfig) != tc.expected {
+				t.Fatalf("unexpected kubeconfig: %s", kubeconfig)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"sort"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	// multiClusterSecretLabel marks the secrets istiod reads the kubeconfigs of the remote clusters from
+	multiClusterSecretLabel = "istio/multiCluster"
+
+	remoteClusterCheckTimeout = time.Second * 5
+	// the API server of a remote cluster is checked again with the same kubeconfig only after this interval
+	remoteClusterCheckInterval = time.Minute
+	// the control plane is reconciled again while a remote cluster is not reachable to keep its status up to date
+	remoteClusterRequeueDuration = time.Minute
+)
+
+// remoteClusterChecker checks whether the API servers of remote clusters are reachable and caches the results
+// by kubeconfig, so the remote clusters are not called on every reconcile
+type remoteClusterChecker struct {
+	// check is checkRemoteCluster unless overridden in tests
+	check func(kubeconfig []byte) (servicemeshv1alpha1.RemoteClusterState, string)
+	// now is time.Now unless overridden in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	results map[[sha256.Size]byte]remoteClusterCheckResult
+}
+
+type remoteClusterCheckResult struct {
+	state     servicemeshv1alpha1.RemoteClusterState
+	message   string
+	checkedAt time.Time
+}
+
+// Check returns the states of the remote clusters of the kubeconfigs, the ones which were not checked
+// within remoteClusterCheckInterval are checked in parallel
+func (c *remoteClusterChecker) Check(kubeconfigs [][]byte) []remoteClusterCheckResult {
+	check := c.check
+	if check == nil {
+		check = checkRemoteCluster
+	}
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+
+	c.mu.Lock()
+	if c.results == nil {
+		c.results = make(map[[sha256.Size]byte]remoteClusterCheckResult)
+	}
+	for key, result := range c.results {
+		if now().Sub(result.checkedAt) >= remoteClusterCheckInterval {
+			delete(c.results, key)
+		}
+	}
+	keys := make([][sha256.Size]byte, len(kubeconfigs))
+	results := make([]remoteClusterCheckResult, len(kubeconfigs))
+	stale := make([]int, 0)
+	for i, kubeconfig := range kubeconfigs {
+		keys[i] = sha256.Sum256(kubeconfig)
+		if result, ok := c.results[keys[i]]; ok {
+			results[i] = result
+		} else {
+			stale = append(stale, i)
+		}
+	}
+	c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, i := range stale {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			state, message := check(kubeconfigs[i])
+			results[i] = remoteClusterCheckResult{
+				state:     state,
+				message:   message,
+				checkedAt: now(),
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	for _, i := range stale {
+		c.results[keys[i]] = results[i]
+	}
+	c.mu.Unlock()
+
+	return results
+}
+
+// setRemoteClustersToStatus sets the remote clusters configured for istiod through the multi-cluster secrets of its
+// revision to the status, along with whether their API server is reachable from the operator with the kubeconfig of the secret
+func (r *IstioControlPlaneReconciler) setRemoteClustersToStatus(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (bool, error) {
+	if icp.GetSpec().GetMode() != servicemeshv1alpha1.ModeType_ACTIVE {
+		icp.Status.ConfiguredRemoteClusters = nil
+
+		return false, nil
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.GetClient().List(ctx, secrets, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(multiClusterSecretLabels(icp))); err != nil {
+		return false, errors.WrapIf(err, "could not list multi-cluster secrets")
+	}
+
+	sort.Slice(secrets.Items, func(i, j int) bool {
+		return secrets.Items[i].GetName() < secrets.Items[j].GetName()
+	})
+
+	statuses := make([]servicemeshv1alpha1.RemoteClusterStatus, 0)
+	kubeconfigs := make([][]byte, 0)
+	for _, secret := range secrets.Items {
+		clusterIDs := make([]string, 0, len(secret.Data))
+		for clusterID := range secret.Data {
+			// istiod ignores the kubeconfig of its own cluster
+			if clusterID != icp.GetSpec().GetClusterID() {
+				clusterIDs = append(clusterIDs, clusterID)
+			}
+		}
+		sort.Strings(clusterIDs)
+
+		for _, clusterID := range clusterIDs {
+			statuses = append(statuses, servicemeshv1alpha1.RemoteClusterStatus{
+				ClusterID:  clusterID,
+				SecretName: secret.GetName(),
+			})
+			kubeconfigs = append(kubeconfigs, secret.Data[clusterID])
+		}
+	}
+
+	for i, result := range r.remoteClusterChecker.Check(kubeconfigs) {
+		statuses[i].State = result.state
+		statuses[i].Message = result.message
+	}
+
+	previous := make(map[string]servicemeshv1alpha1.RemoteClusterState)
+	for _, status := range icp.Status.ConfiguredRemoteClusters {
+		previous[status.ClusterID] = status.State
+	}
+
+	unreachable := false
+	for _, status := range statuses {
+		if state, ok := previous[status.ClusterID]; ok && state != status.State {
+			r.Recorder.Eventf(icp, corev1.EventTypeNormal, eventReasonRemoteClusterStateChanged, "remote cluster %s changed from %s to %s", status.ClusterID, state, status.State)
+		}
+
+		if status.State != servicemeshv1alpha1.RemoteClusterState_Reachable {
+			unreachable = true
+		}
+	}
+
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+	icp.Status.ConfiguredRemoteClusters = statuses
+
+	return unreachable, nil
+}
+
+// multiClusterSecretLabels returns the labels of the multi-cluster secrets of the revision of the control plane
+func multiClusterSecretLabels(icp *servicemeshv1alpha1.IstioControlPlane) map[string]string {
+	return utils.MergeLabels(icp.RevisionLabels(), map[string]string{
+		multiClusterSecretLabel: "true",
+	})
+}
+
+// checkRemoteCluster checks whether the API server of a remote cluster is reachable with the kubeconfig
+func checkRemoteCluster(kubeconfig []byte) (servicemeshv1alpha1.RemoteClusterState, string) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return servicemeshv1alpha1.RemoteClusterState_InvalidKubeconfig, err.Error()
+	}
+	config.Timeout = remoteClusterCheckTimeout
+
+	d, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return servicemeshv1alpha1.RemoteClusterState_InvalidKubeconfig, err.Error()
+	}
+
+	if _, err := d.ServerVersion(); err != nil {
+		return servicemeshv1alpha1.RemoteClusterState_Unreachable, err.Error()
+	}
+
+	return servicemeshv1alpha1.RemoteClusterState_Reachable, ""
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// fakeRemoteClusterCheck returns the state of the remote clusters by kubeconfig and counts the checks
+type fakeRemoteClusterCheck struct {
+	mu     sync.Mutex
+	states map[string]servicemeshv1alpha1.RemoteClusterState
+	checks map[string]int
+}
+
+func (c *fakeRemoteClusterCheck) check(kubeconfig []byte) (servicemeshv1alpha1.RemoteClusterState, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks[string(kubeconfig)]++
+	state := c.states[string(kubeconfig)]
+	if state != servicemeshv1alpha1.RemoteClusterState_Reachable {
+		return state, "connection refused"
+	}
+
+	return state, ""
+}
+
+func newMultiClusterSecret(name string, labels map[string]string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+			Labels:    labels,
+		},
+		Data: make(map[string][]byte),
+	}
+	for clusterID, kubeconfig := range data {
+		secret.Data[clusterID] = []byte(kubeconfig)
+	}
+
+	return secret
+}
+
+func TestSetRemoteClustersToStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Spec.ClusterID = "cluster-1"
+	otherICP := newUpgradeTestControlPlane("cp-v113x")
+
+	fake := &fakeRemoteClusterCheck{
+		states: map[string]servicemeshv1alpha1.RemoteClusterState{
+			"kubeconfig of cluster-2": servicemeshv1alpha1.RemoteClusterState_Reachable,
+			"kubeconfig of cluster-3": servicemeshv1alpha1.RemoteClusterState_Unreachable,
+		},
+		checks: make(map[string]int),
+	}
+	now := time.Now()
+	recorder := record.NewFakeRecorder(10)
+	r := &IstioControlPlaneReconciler{
+		Client: newFakeClient(
+			newMultiClusterSecret("istio-remote-secret-cluster-3", multiClusterSecretLabels(icp), map[string]string{"cluster-3": "kubeconfig of cluster-3"}),
+			newMultiClusterSecret("istio-remote-secret-cluster-2", multiClusterSecretLabels(icp), map[string]string{
+				"cluster-2": "kubeconfig of cluster-2",
+				// istiod ignores the kubeconfig of its own cluster
+				"cluster-1": "kubeconfig of cluster-1",
+			}),
+			// secrets of other revisions and secrets which are not multi-cluster secrets are ignored
+			newMultiClusterSecret("istio-remote-secret-cluster-4", multiClusterSecretLabels(otherICP), map[string]string{"cluster-4": "kubeconfig of cluster-4"}),
+			newMultiClusterSecret("istio-reader-cluster-5", icp.RevisionLabels(), map[string]string{"cluster-5": "kubeconfig of cluster-5"}),
+		),
+		Recorder: recorder,
+		remoteClusterChecker: remoteClusterChecker{
+			check: fake.check,
+			now:   func() time.Time { return now },
+		},
+	}
+
+	unreachable, err := r.setRemoteClustersToStatus(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !unreachable {
+		t.Fatal("unreachable remote cluster should be reported")
+	}
+
+	expected := []servicemeshv1alpha1.RemoteClusterStatus{
+		{
+			ClusterID:  "cluster-2",
+			SecretName: "istio-remote-secret-cluster-2",
+			State:      servicemeshv1alpha1.RemoteClusterState_Reachable,
+		},
+		{
+			ClusterID:  "cluster-3",
+			SecretName: "istio-remote-secret-cluster-3",
+			State:      servicemeshv1alpha1.RemoteClusterState_Unreachable,
+			Message:    "connection refused",
+		},
+	}
+	if diff := pretty.Compare(icp.Status.ConfiguredRemoteClusters, expected); diff != "" {
+		t.Fatalf("unexpected remote clusters (-got +want):\n%s", diff)
+	}
+	if len(recorder.Events) != 0 {
+		t.Fatalf("no event should be recorded for newly configured remote clusters: %s", <-recorder.Events)
+	}
+
+	// the remote clusters are not checked again within the check interval
+	fake.states["kubeconfig of cluster-3"] = servicemeshv1alpha1.RemoteClusterState_Reachable
+	if _, err := r.setRemoteClustersToStatus(ctx, icp); err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(icp.Status.ConfiguredRemoteClusters, expected); diff != "" {
+		t.Fatalf("unexpected remote clusters (-got +want):\n%s", diff)
+	}
+	if diff := pretty.Compare(fake.checks, map[string]int{"kubeconfig of cluster-2": 1, "kubeconfig of cluster-3": 1}); diff != "" {
+		t.Fatalf("unexpected checks (-got +want):\n%s", diff)
+	}
+
+	now = now.Add(remoteClusterCheckInterval)
+	unreachable, err = r.setRemoteClustersToStatus(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unreachable {
+		t.Fatal("every remote cluster should be reachable")
+	}
+	if state := icp.Status.ConfiguredRemoteClusters[1].State; state != servicemeshv1alpha1.RemoteClusterState_Reachable {
+		t.Fatalf("unexpected state of cluster-3: %s", state)
+	}
+	if diff := pretty.Compare(fake.checks, map[string]int{"kubeconfig of cluster-2": 2, "kubeconfig of cluster-3": 2}); diff != "" {
+		t.Fatalf("unexpected checks (-got +want):\n%s", diff)
+	}
+
+	expectedEvent := fmt.Sprintf("%s %s remote cluster cluster-3 changed from Unreachable to Reachable", corev1.EventTypeNormal, eventReasonRemoteClusterStateChanged)
+	if event := <-recorder.Events; event != expectedEvent {
+		t.Fatalf("unexpected event: %s", event)
+	}
+	if len(recorder.Events) != 0 {
+		t.Fatalf("unexpected event: %s", <-recorder.Events)
+	}
+}
+
+func TestSetRemoteClustersToStatusOfPassiveControlPlane(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Spec.Mode = servicemeshv1alpha1.ModeType_PASSIVE
+	icp.Status.ConfiguredRemoteClusters = []servicemeshv1alpha1.RemoteClusterStatus{{ClusterID: "cluster-2"}}
+
+	r := &IstioControlPlaneReconciler{
+		Client: newFakeClient(newMultiClusterSecret("istio-remote-secret-cluster-2", multiClusterSecretLabels(icp), map[string]string{"cluster-2": "kubeconfig of cluster-2"})),
+		remoteClusterChecker: remoteClusterChecker{
+			check: func(kubeconfig []byte) (servicemeshv1alpha1.RemoteClusterState, string) {
+				t.Fatalf("remote cluster should not be checked: %s", kubeconfig)
+
+				return servicemeshv1alpha1.RemoteClusterState_Reachable, ""
+			},
+		},
+	}
+
+	unreachable, err := r.setRemoteClustersToStatus(context.Background(), icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unreachable || icp.Status.ConfiguredRemoteClusters != nil {
+		t.Fatalf("remote clusters of a passive control plane should not be reported: %v", icp.Status.ConfiguredRemoteClusters)
+	}
+}
+
+func TestCheckRemoteClusterWithInvalidKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	state, message := checkRemoteCluster([]byte("invalid kubeconfig"))
+	if state != servicemeshv1alpha1.RemoteClusterState_InvalidKubeconfig || message == "" {
+		t.Fatalf("unexpected result: %s %s", state, message)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+// defaultNetworkGatewayPort is the port of the east-west gateways for cross-network traffic, the mesh expansion
+// gateway always exposes it
+const defaultNetworkGatewayPort = 15443
+
+// getNetworks returns the east-west gateways of the networks of the control plane, the network of the cluster
+// is served by the mesh expansion gateway and the gateways of the networks of the spec add up. The networks are
+// computed from the live gateways, so the mesh networks of istiod follow the changes of the gateways right away.
+func (r *IstioControlPlaneReconciler) getNetworks(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]servicemeshv1alpha1.NetworkStatus, error) {
+	gateways := make(map[string][]servicemeshv1alpha1.NetworkGatewayStatus)
+
+	if utils.PointerToBool(icp.GetSpec().GetMeshExpansion().GetEnabled()) {
+		imgws, err := r.getMeshExpansionGateways(ctx, icp)
+		if err != nil {
+			return nil, err
+		}
+
+		// a pending mesh expansion gateway is reported by setMeshExpansionGWAddressToStatus
+		if len(imgws) == 1 && imgws[0].GetStatus().Status == servicemeshv1alpha1.ConfigState_Available {
+			network := getControlPlaneNetwork(icp, icp)
+			for _, address := range imgws[0].GetStatus().GatewayAddress {
+				gateways[network] = append(gateways[network], servicemeshv1alpha1.NetworkGatewayStatus{
+					Address: address,
+					Port:    defaultNetworkGatewayPort,
+				})
+			}
+		}
+	}
+
+	for _, network := range icp.GetSpec().GetNetworks() {
+		port := network.GetGatewayPort()
+		if port == 0 {
+			port = defaultNetworkGatewayPort
+		}
+
+		addresses, err := r.getNetworkGatewayAddresses(ctx, icp, network)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, address := range addresses {
+			gateways[network.GetName()] = append(gateways[network.GetName()], servicemeshv1alpha1.NetworkGatewayStatus{
+				Address: address,
+				Port:    port,
+			})
+		}
+	}
+
+	networks := make([]servicemeshv1alpha1.NetworkStatus, 0, len(gateways))
+	for name, networkGateways := range gateways {
+		networks = append(networks, servicemeshv1alpha1.NetworkStatus{
+			Name:     name,
+			Gateways: uniqueNetworkGateways(networkGateways),
+		})
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+
+	if len(networks) == 0 {
+		return nil, nil
+	}
+
+	return networks, nil
+}
+
+// getControlPlaneNetwork returns the network of the cluster of a control plane, the first network of the spec
+// of the local control plane whose cluster selector matches the labels of the control plane takes precedence
+// over its network name
+func getControlPlaneNetwork(icp *servicemeshv1alpha1.IstioControlPlane, cp ControlPlane) string {
+	for _, network := range icp.GetSpec().GetNetworks() {
+		if len(network.GetClusterSelector()) > 0 && labels.SelectorFromSet(network.GetClusterSelector()).Matches(labels.Set(cp.GetLabels())) {
+			return network.GetName()
+		}
+	}
+
+	return cp.GetSpec().GetNetworkName()
+}
+
+// getNetworkGatewayAddresses returns the addresses of the east-west gateways of the network, which are either
+// advertised explicitly or taken from the status of the selected mesh gateways of the control plane
+func (r *IstioControlPlaneReconciler) getNetworkGatewayAddresses(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, network *servicemeshv1alpha1.NetworkConfiguration) ([]string, error) {
+	if len(network.GetGatewayAddresses()) > 0 {
+		return network.GetGatewayAddresses(), nil
+	}
+
+	if len(network.GetGatewaySelector()) == 0 {
+		return nil, nil
+	}
+
+	imgws := &servicemeshv1alpha1.IstioMeshGatewayList{}
+	if err := r.Client.List(ctx, imgws, client.InNamespace(icp.GetNamespace()), client.MatchingLabels(network.GetGatewaySelector())); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list gateways of network", "network", network.GetName())
+	}
+
+	addresses := make([]string, 0)
+	for _, imgw := range imgws.Items {
+		imgw := imgw
+		if !isGatewayOfControlPlane(&imgw, icp) || imgw.GetStatus().Status != servicemeshv1alpha1.ConfigState_Available {
+			continue
+		}
+
+		addresses = append(addresses, imgw.GetStatus().GatewayAddress...)
+	}
+
+	return addresses, nil
+}
+
+// getNetworksFromStatus returns the networks of the control plane from its status, the networks of peers which
+// only report the addresses of their mesh expansion gateway are derived from those for the network of their cluster
+func getNetworksFromStatus(cp ControlPlane, network string) []servicemeshv1alpha1.NetworkStatus {
+	if len(cp.GetStatus().Networks) > 0 || len(cp.GetStatus().GatewayAddress) == 0 {
+		return cp.GetStatus().Networks
+	}
+
+	status := servicemeshv1alpha1.NetworkStatus{
+		Name: network,
+	}
+	addresses := append([]string{}, cp.GetStatus().GatewayAddress...)
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		status.Gateways = append(status.Gateways, servicemeshv1alpha1.NetworkGatewayStatus{
+			Address: address,
+			Port:    defaultNetworkGatewayPort,
+		})
+	}
+
+	return []servicemeshv1alpha1.NetworkStatus{status}
+}
+
+// isNetworkGateway returns whether the mesh gateway is an east-west gateway of one of the networks of the control plane
+func isNetworkGateway(imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	if !isGatewayOfControlPlane(imgw, icp) {
+		return false
+	}
+
+	for _, network := range icp.GetSpec().GetNetworks() {
+		if len(network.GetGatewaySelector()) > 0 && labels.SelectorFromSet(network.GetGatewaySelector()).Matches(labels.Set(imgw.GetLabels())) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isGatewayOfControlPlane(imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	ref := imgw.GetSpec().GetIstioControlPlane()
+
+	return ref != nil && ref.GetName() == icp.GetName() && ref.GetNamespace() == icp.GetNamespace()
+}
+
+// uniqueNetworkGateways returns the gateways sorted by their address and port without duplicates
+func uniqueNetworkGateways(gateways []servicemeshv1alpha1.NetworkGatewayStatus) []servicemeshv1alpha1.NetworkGatewayStatus {
+	sort.Slice(gateways, func(i, j int) bool {
+		if gateways[i].Address != gateways[j].Address {
+			return gateways[i].Address < gateways[j].Address
+		}
+
+		return gateways[i].Port < gateways[j].Port
+	})
+
+	result := make([]servicemeshv1alpha1.NetworkGatewayStatus, 0, len(gateways))
+	for i, gateway := range gateways {
+		if i > 0 && gateway.Address == gateways[i-1].Address && gateway.Port == gateways[i-1].Port {
+			continue
+		}
+		result = append(result, gateway)
+	}
+
+	return result
+}
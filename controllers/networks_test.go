@@ -0,0 +1,352 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"istio.io/api/mesh/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func newNetworkTestControlPlane(networks ...*servicemeshv1alpha1.NetworkConfiguration) *servicemeshv1alpha1.IstioControlPlane {
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Labels = map[string]string{"region": "eu-west-1"}
+	icp.Spec.NetworkName = "network1"
+	icp.Spec.MeshExpansion = &servicemeshv1alpha1.MeshExpansionConfiguration{Enabled: boolPtr(true)}
+	icp.Spec.Networks = networks
+	icp.Status.ClusterID = "cluster-1"
+
+	return icp
+}
+
+func newTestMeshGateway(name string, icp *servicemeshv1alpha1.IstioControlPlane, labels map[string]string, state servicemeshv1alpha1.ConfigState, addresses ...string) *servicemeshv1alpha1.IstioMeshGateway {
+	return &servicemeshv1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: icp.GetNamespace(), Labels: labels},
+		Spec: &servicemeshv1alpha1.IstioMeshGatewaySpec{
+			IstioControlPlane: &servicemeshv1alpha1.NamespacedName{Name: icp.GetName(), Namespace: icp.GetNamespace()},
+		},
+		Status: servicemeshv1alpha1.IstioMeshGatewayStatus{
+			Status:         state,
+			GatewayAddress: addresses,
+		},
+	}
+}
+
+func newTestMeshExpansionGateway(icp *servicemeshv1alpha1.IstioControlPlane, addresses ...string) *servicemeshv1alpha1.IstioMeshGateway {
+	return newTestMeshGateway("istio-meshexpansion-"+icp.GetName(), icp, icp.MeshExpansionGatewayLabels(), servicemeshv1alpha1.ConfigState_Available, addresses...)
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestGetNetworks(t *testing.T) {
+	t.Parallel()
+
+	eastWest := map[string]string{"gateway": "east-west"}
+
+	testCases := []struct {
+		name     string
+		icp      *servicemeshv1alpha1.IstioControlPlane
+		objects  func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object
+		expected []servicemeshv1alpha1.NetworkStatus
+	}{
+		{
+			name: "network of the cluster is served by the mesh expansion gateway",
+			icp:  newNetworkTestControlPlane(),
+			objects: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				return []client.Object{newTestMeshExpansionGateway(icp, "10.0.0.2", "10.0.0.1")}
+			},
+			expected: []servicemeshv1alpha1.NetworkStatus{
+				{Name: "network1", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.0.1", Port: 15443}, {Address: "10.0.0.2", Port: 15443}}},
+			},
+		},
+		{
+			name: "pending mesh expansion gateway has no addresses",
+			icp:  newNetworkTestControlPlane(),
+			objects: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				imgw := newTestMeshExpansionGateway(icp, "10.0.0.1")
+				imgw.Status.Status = servicemeshv1alpha1.ConfigState_Reconciling
+
+				return []client.Object{imgw}
+			},
+			expected: nil,
+		},
+		{
+			name: "cluster selected by a network is served by the mesh expansion gateway in that network",
+			icp: newNetworkTestControlPlane(&servicemeshv1alpha1.NetworkConfiguration{
+				Name:            "eu",
+				ClusterSelector: map[string]string{"region": "eu-west-1"},
+			}),
+			objects: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				return []client.Object{newTestMeshExpansionGateway(icp, "10.0.0.1")}
+			},
+			expected: []servicemeshv1alpha1.NetworkStatus{
+				{Name: "eu", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.0.1", Port: 15443}}},
+			},
+		},
+		{
+			name: "multiple east-west gateways and advertised addresses per network",
+			icp: newNetworkTestControlPlane(
+				&servicemeshv1alpha1.NetworkConfiguration{
+					Name:            "network1",
+					GatewaySelector: eastWest,
+				},
+				&servicemeshv1alpha1.NetworkConfiguration{
+					Name:             "network2",
+					GatewayPort:      443,
+					GatewayAddresses: []string{"east-west.network2.example.com"},
+				},
+			),
+			objects: func(icp *servicemeshv1alpha1.IstioControlPlane) []client.Object {
+				other := newNetworkTestControlPlane()
+				other.Name = "cp-v113x"
+
+				return []client.Object{
+					newTestMeshExpansionGateway(icp, "10.0.0.1"),
+					newTestMeshGateway("east-west-1", icp, eastWest, servicemeshv1alpha1.ConfigState_Available, "10.0.0.1", "10.0.0.3"),
+					newTestMeshGateway("east-west-2", icp, eastWest, servicemeshv1alpha1.ConfigState_Available, "east-west.example.com"),
+					newTestMeshGateway("east-west-pending", icp, eastWest, servicemeshv1alpha1.ConfigState_Reconciling, "10.0.0.4"),
+					newTestMeshGateway("east-west-of-other-control-plane", other, eastWest, servicemeshv1alpha1.ConfigState_Available, "10.0.0.5"),
+				}
+			},
+			expected: []servicemeshv1alpha1.NetworkStatus{
+				{Name: "network1", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{
+					{Address: "10.0.0.1", Port: 15443},
+					{Address: "10.0.0.3", Port: 15443},
+					{Address: "east-west.example.com", Port: 15443},
+				}},
+				{Name: "network2", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "east-west.network2.example.com", Port: 443}}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &IstioControlPlaneReconciler{
+				Client: newFakeClient(tc.objects(tc.icp)...),
+			}
+
+			networks, err := r.getNetworks(context.Background(), tc.icp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := pretty.Compare(networks, tc.expected); diff != "" {
+				t.Fatalf("unexpected networks (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNetworkGatewayAddresses(t *testing.T) {
+	t.Parallel()
+
+	icp := newNetworkTestControlPlane()
+	r := &IstioControlPlaneReconciler{
+		Client: newFakeClient(
+			newTestMeshGateway("east-west", icp, map[string]string{"gateway": "east-west"}, servicemeshv1alpha1.ConfigState_Available, "10.0.0.1"),
+		),
+	}
+
+	testCases := []struct {
+		name     string
+		network  *servicemeshv1alpha1.NetworkConfiguration
+		expected []string
+	}{
+		{
+			name:     "addresses of the selected gateways",
+			network:  &servicemeshv1alpha1.NetworkConfiguration{Name: "network1", GatewaySelector: map[string]string{"gateway": "east-west"}},
+			expected: []string{"10.0.0.1"},
+		},
+		{
+			name: "advertised addresses take precedence",
+			network: &servicemeshv1alpha1.NetworkConfiguration{
+				Name:             "network1",
+				GatewaySelector:  map[string]string{"gateway": "east-west"},
+				GatewayAddresses: []string{"east-west.example.com"},
+			},
+			expected: []string{"east-west.example.com"},
+		},
+		{
+			name:     "no gateway is selected",
+			network:  &servicemeshv1alpha1.NetworkConfiguration{Name: "network1", GatewaySelector: map[string]string{"gateway": "ingress"}},
+			expected: []string{},
+		},
+		{
+			name:     "network without gateways",
+			network:  &servicemeshv1alpha1.NetworkConfiguration{Name: "network1", ClusterSelector: map[string]string{"region": "eu-west-1"}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			addresses, err := r.getNetworkGatewayAddresses(context.Background(), icp, tc.network)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := pretty.Compare(addresses, tc.expected); diff != "" {
+				t.Fatalf("unexpected addresses (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNetworksFromStatus(t *testing.T) {
+	t.Parallel()
+
+	networks := []servicemeshv1alpha1.NetworkStatus{
+		{Name: "network2", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.1.1", Port: 443}}},
+	}
+
+	testCases := []struct {
+		name     string
+		status   servicemeshv1alpha1.IstioControlPlaneStatus
+		expected []servicemeshv1alpha1.NetworkStatus
+	}{
+		{
+			name:     "networks of the status",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{Networks: networks, GatewayAddress: []string{"10.0.1.2"}},
+			expected: networks,
+		},
+		{
+			name:   "networks derived from the mesh expansion gateway of a peer with an older operator",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{GatewayAddress: []string{"10.0.1.2", "10.0.1.1"}},
+			expected: []servicemeshv1alpha1.NetworkStatus{
+				{Name: "network2", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.1.1", Port: 15443}, {Address: "10.0.1.2", Port: 15443}}},
+			},
+		},
+		{
+			name:     "no networks",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			peer := newTestPeer("cluster-2", tc.status)
+
+			if diff := pretty.Compare(getNetworksFromStatus(peer, "network2"), tc.expected); diff != "" {
+				t.Fatalf("unexpected networks (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUniqueNetworkGateways(t *testing.T) {
+	t.Parallel()
+
+	gateways := uniqueNetworkGateways([]servicemeshv1alpha1.NetworkGatewayStatus{
+		{Address: "10.0.0.2", Port: 15443},
+		{Address: "10.0.0.1", Port: 443},
+		{Address: "10.0.0.1", Port: 15443},
+		{Address: "10.0.0.2", Port: 15443},
+		{Address: "10.0.0.1", Port: 443},
+	})
+
+	if diff := pretty.Compare(gateways, []servicemeshv1alpha1.NetworkGatewayStatus{
+		{Address: "10.0.0.1", Port: 443},
+		{Address: "10.0.0.1", Port: 15443},
+		{Address: "10.0.0.2", Port: 15443},
+	}); diff != "" {
+		t.Fatalf("unexpected gateways (-got +want):\n%s", diff)
+	}
+}
+
+func TestGetMeshNetworks(t *testing.T) {
+	t.Parallel()
+
+	icp := newNetworkTestControlPlane(&servicemeshv1alpha1.NetworkConfiguration{
+		Name:            "us",
+		ClusterSelector: map[string]string{"region": "us-east-1"},
+	})
+
+	peerWithNetworks := newTestPeer("cluster-2", servicemeshv1alpha1.IstioControlPlaneStatus{
+		Networks: []servicemeshv1alpha1.NetworkStatus{
+			{Name: "network2", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "east-west.network2.example.com", Port: 443}}},
+		},
+	})
+	peerWithNetworks.Spec.NetworkName = "network2"
+
+	selectedPeer := newTestPeer("cluster-3", servicemeshv1alpha1.IstioControlPlaneStatus{GatewayAddress: []string{"10.0.2.1"}})
+	selectedPeer.Labels = map[string]string{"region": "us-east-1"}
+	selectedPeer.Spec.NetworkName = "network3"
+
+	otherControlPlanesPeer := newTestPeer("cluster-4", servicemeshv1alpha1.IstioControlPlaneStatus{GatewayAddress: []string{"10.0.3.1"}})
+	otherControlPlanesPeer.Status.IstioControlPlaneName = "cp-v113x"
+
+	r := &IstioControlPlaneReconciler{
+		Client: newFakeClient(peerWithNetworks, selectedPeer, otherControlPlanesPeer),
+	}
+
+	// the status of the control plane is not up to date yet, the networks passed are used instead
+	icp.Status.Networks = []servicemeshv1alpha1.NetworkStatus{
+		{Name: "network1", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.0.9", Port: 15443}}},
+	}
+	localNetworks := []servicemeshv1alpha1.NetworkStatus{
+		{Name: "network1", Gateways: []servicemeshv1alpha1.NetworkGatewayStatus{{Address: "10.0.0.1", Port: 15443}}},
+	}
+
+	meshNetworks, err := r.getMeshNetworks(context.Background(), icp, localNetworks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := func(clusterID string) *v1alpha1.Network_NetworkEndpoints {
+		return &v1alpha1.Network_NetworkEndpoints{Ne: &v1alpha1.Network_NetworkEndpoints_FromRegistry{FromRegistry: clusterID}}
+	}
+	gateway := func(address string, port uint32) *v1alpha1.Network_IstioNetworkGateway {
+		return &v1alpha1.Network_IstioNetworkGateway{Gw: &v1alpha1.Network_IstioNetworkGateway_Address{Address: address}, Port: port}
+	}
+
+	if diff := pretty.Compare(meshNetworks, &v1alpha1.MeshNetworks{
+		Networks: map[string]*v1alpha1.Network{
+			"network1": {
+				Endpoints: []*v1alpha1.Network_NetworkEndpoints{registry("cluster-1")},
+				Gateways:  []*v1alpha1.Network_IstioNetworkGateway{gateway("10.0.0.1", 15443)},
+			},
+			"network2": {
+				Endpoints: []*v1alpha1.Network_NetworkEndpoints{registry("cluster-2")},
+				Gateways:  []*v1alpha1.Network_IstioNetworkGateway{gateway("east-west.network2.example.com", 443)},
+			},
+			"us": {
+				Endpoints: []*v1alpha1.Network_NetworkEndpoints{registry("cluster-3")},
+				Gateways:  []*v1alpha1.Network_IstioNetworkGateway{gateway("10.0.2.1", 15443)},
+			},
+		},
+	}); diff != "" {
+		t.Fatalf("unexpected mesh networks (-got +want):\n%s", diff)
+	}
+}
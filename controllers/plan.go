@@ -0,0 +1,217 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/reconciler"
+)
+
+const (
+	planConfigMapIDKey   = "id"
+	planConfigMapDiffKey = "diff"
+	// keep the diff well below the size limit of configmaps
+	maxPlanDiffSize = 900 * 1024
+)
+
+type planPendingError struct {
+	id string
+}
+
+func (e *planPendingError) Error() string {
+	return fmt.Sprintf("plan %s is waiting for approval", e.id)
+}
+
+// planEnabled returns whether the changes of the reconciliation have to be approved first
+func (r *IstioControlPlaneReconciler) planEnabled(icp *servicemeshv1alpha1.IstioControlPlane) bool {
+	return icp.PlanModeEnabled() && icp.DeletionTimestamp.IsZero()
+}
+
+// reconcilePlan holds back the reconciliation of the components in plan mode until the plan of the changes gets approved,
+// the planned changes of the plug-in CA are the first changes of the plan
+func (r *IstioControlPlaneReconciler) reconcilePlan(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, properties servicemeshv1alpha1.IstioControlPlaneProperties, pluginCAChanges []components.Change) error {
+	if !r.planEnabled(icp) {
+		servicemeshv1alpha1.RemoveCondition(&icp.Status.Conditions, servicemeshv1alpha1.ConditionTypePlanApproved)
+
+		return r.clearPlan(icp)
+	}
+
+	componentChanges, err := r.planChanges(ctx, icp, properties)
+	if err != nil {
+		return errors.WrapIf(err, "could not plan changes")
+	}
+	changes := append(append([]components.Change{}, pluginCAChanges...), componentChanges...)
+
+	id := components.PlanID(changes)
+	if id == "" || id == icp.ApprovedPlan() {
+		if id != "" {
+			r.Recorder.Eventf(icp, corev1.EventTypeNormal, "PlanApproved", "applying plan %s", id)
+		}
+
+		icp.SetCondition(servicemeshv1alpha1.Condition{
+			Type:               servicemeshv1alpha1.ConditionTypePlanApproved,
+			Status:             servicemeshv1alpha1.ConditionStatus_True,
+			ObservedGeneration: icp.GetGeneration(),
+			Reason:             servicemeshv1alpha1.ConditionReasonPlanApproved,
+		})
+
+		return r.clearPlan(icp)
+	}
+
+	diff, err := components.PlanDiff(changes)
+	if err != nil {
+		return err
+	}
+	if len(diff) > maxPlanDiffSize {
+		diff = append(diff[:maxPlanDiffSize], []byte("\n... diff is truncated\n")...)
+	}
+
+	if icp.Status.Plan.GetId() != id {
+		r.Recorder.Eventf(icp, corev1.EventTypeNormal, "PlanCreated", "plan %s is waiting for approval", id)
+	}
+
+	configMap := r.planConfigMap(icp)
+	configMap.Data = map[string]string{
+		planConfigMapIDKey:   id,
+		planConfigMapDiffKey: string(diff),
+	}
+	if _, err := r.ResourceReconciler.ReconcileResource(configMap, reconciler.StatePresent); err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	plan := &servicemeshv1alpha1.PlanStatus{
+		Id:            id,
+		ConfigMapName: configMap.GetName(),
+	}
+	for _, change := range changes {
+		switch change.Action {
+		case components.ChangeActionCreate:
+			plan.Creations++
+		case components.ChangeActionDelete:
+			plan.Deletions++
+		default:
+			plan.Updates++
+		}
+	}
+	icp.Status.Plan = plan
+
+	icp.SetCondition(servicemeshv1alpha1.Condition{
+		Type:               servicemeshv1alpha1.ConditionTypePlanApproved,
+		Status:             servicemeshv1alpha1.ConditionStatus_False,
+		ObservedGeneration: icp.GetGeneration(),
+		Reason:             servicemeshv1alpha1.ConditionReasonPlanPending,
+		Message: fmt.Sprintf("plan %s would create %d, update %d and delete %d objects, see configmap %s",
+			id, plan.Creations, plan.Updates, plan.Deletions, configMap.GetName()),
+	})
+
+	return &planPendingError{
+		id: id,
+	}
+}
+
+// planChanges runs the reconciliation of the components without applying the changes
+func (r *IstioControlPlaneReconciler) planChanges(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, properties servicemeshv1alpha1.IstioControlPlaneProperties) ([]components.Change, error) {
+	planner := components.NewPlanner(r.Client)
+
+	// the status of the control plane is updated during the reconciliation
+	icp = icp.DeepCopy()
+
+	componentReconcilers, err := r.newComponentReconcilers(planner, icp, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, componentReconciler := range componentReconcilers {
+		if _, err := componentReconciler.Reconcile(icp); err != nil {
+			return nil, err
+		}
+	}
+
+	return planner.Changes(), nil
+}
+
+// planPluginCA runs the reconciliation of the plug-in CA and the root CA rotation without applying the changes.
+// The returned checksum is the checksum of the current plug-in CA secret, as istiod keeps running with it
+// until the plan gets approved.
+func (r *IstioControlPlaneReconciler) planPluginCA(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (pluginca.Result, []components.Change, error) {
+	planner := components.NewPlanner(r.Client)
+
+	result, err := r.reconcilePluginCA(ctx, planner, discardRecorder{}, icp.DeepCopy(), logger)
+	if err != nil {
+		return result, nil, errors.WrapIf(err, "could not plan plug-in CA changes")
+	}
+
+	secret := &corev1.Secret{}
+	err = r.Get(ctx, client.ObjectKey{Name: pluginca.SecretName, Namespace: icp.GetNamespace()}, secret)
+	switch {
+	case k8serrors.IsNotFound(err):
+		result.Checksum = ""
+	case err != nil:
+		return result, nil, errors.WrapIf(err, "could not get plug-in CA secret")
+	default:
+		result.Checksum = pluginca.Checksum(secret)
+	}
+
+	return result, planner.Changes(), nil
+}
+
+func (r *IstioControlPlaneReconciler) clearPlan(icp *servicemeshv1alpha1.IstioControlPlane) error {
+	icp.Status.Plan = nil
+
+	if _, err := r.ResourceReconciler.ReconcileResource(r.planConfigMap(icp), reconciler.StateAbsent); err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	return nil
+}
+
+// discardRecorder drops the events of the reconciliations which are only planned
+type discardRecorder struct{}
+
+func (discardRecorder) Event(object runtime.Object, eventtype, reason, message string) {}
+
+func (discardRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (discardRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (r *IstioControlPlaneReconciler) planConfigMap(icp *servicemeshv1alpha1.IstioControlPlane) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-plan", icp.GetName()),
+			Namespace: icp.GetNamespace(),
+		},
+	}
+	k8sutil.SetICPMetadataOnObject(configMap, icp)
+
+	return configMap
+}
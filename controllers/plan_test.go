@@ -0,0 +1,129 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+)
+
+func TestPlanPluginCA(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	now := time.Now()
+	certPEM, keyPEM, err := pki.SelfSigned(&x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"Istio"}, CommonName: "Root CA"},
+		NotBefore:             now,
+		NotAfter:              now.Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "root-ca", Namespace: "istio-system"},
+		Data: map[string][]byte{
+			pluginca.RootCACertKey: certPEM,
+			pluginca.RootCAKeyKey:  keyPEM,
+		},
+	}
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	icp.Spec.Ca = &servicemeshv1alpha1.CAConfiguration{
+		RootCASecret: &servicemeshv1alpha1.NamespacedName{Name: "root-ca"},
+	}
+
+	c := newFakeClient(icp, root)
+	recorder := record.NewFakeRecorder(10)
+	r := &IstioControlPlaneReconciler{
+		Client:   c,
+		Scheme:   c.Scheme(),
+		Recorder: recorder,
+	}
+
+	plan := func() (pluginca.Result, []components.Change) {
+		t.Helper()
+
+		result, changes, err := r.planPluginCA(ctx, icp, newTestLogger())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return result, changes
+	}
+
+	result, changes := plan()
+
+	actions := []string{}
+	for _, change := range changes {
+		actions = append(actions, change.String())
+	}
+	if diff := pretty.Compare(actions, []string{"create secret istio-system/cacerts"}); diff != "" {
+		t.Fatalf("unexpected changes (-got +want):\n%s", diff)
+	}
+
+	// istiod keeps running without the plug-in CA until the plan is approved
+	if result.Checksum != "" {
+		t.Fatalf("unexpected checksum of the plug-in CA: %s", result.Checksum)
+	}
+	if icp.Status.Ca != nil {
+		t.Fatalf("CA status is changed by the plan: %v", icp.Status.Ca)
+	}
+	if len(recorder.Events) > 0 {
+		t.Fatalf("event is recorded by the plan: %s", <-recorder.Events)
+	}
+	err = c.Get(ctx, client.ObjectKey{Name: pluginca.SecretName, Namespace: icp.GetNamespace()}, &corev1.Secret{})
+	if err == nil {
+		t.Fatal("plug-in CA secret is created by the plan")
+	}
+
+	// the new intermediate CA is issued again, but the plan stays the same
+	if _, again := plan(); components.PlanID(again) != components.PlanID(changes) {
+		t.Fatal("plan ID of the plug-in CA is not stable")
+	}
+
+	applied, err := r.reconcilePluginCA(ctx, r.Client, r.Recorder, icp, newTestLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, changes = plan()
+	if len(changes) > 0 {
+		t.Fatalf("unexpected changes after the plug-in CA is applied: %v", changes)
+	}
+	if result.Checksum != applied.Checksum {
+		t.Fatalf("checksum of the plug-in CA %s does not match the applied one %s", result.Checksum, applied.Checksum)
+	}
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	rootCARotationRequeueDuration = time.Second * 30
+	// annotation on the pod template of istiod which holds the checksum of the plug-in CA it was started with
+	caCertificatesChecksumAnnotation = "servicemesh.cisco.com/cacerts-checksum"
+)
+
+// reconcilePluginCA reconciles the plug-in CA of the control plane and drives the root CA rotation in progress,
+// the phases which do not have to wait for anything are passed through within a single reconciliation.
+// The changes are made through the given client, so the reconciliation can be planned as well.
+func (r *IstioControlPlaneReconciler) reconcilePluginCA(ctx context.Context, c client.Client, recorder record.EventRecorder, icp *servicemeshv1alpha1.IstioControlPlane, logger logger.Logger) (pluginca.Result, error) {
+	reconciler := pluginca.NewReconciler(c, r.Scheme)
+
+	for {
+		result, err := reconciler.Reconcile(ctx, icp)
+		if err != nil {
+			return result, err
+		}
+
+		recordIntermediateCAChange(recorder, icp, icp.Status.Ca, result.Status)
+		icp.Status.Ca = result.Status
+
+		rotation := result.Status.GetRotation()
+		if !result.Managed || rotation == nil || rotation.GetPhase() == servicemeshv1alpha1.RootCARotationPhase_RotationCompleted {
+			return result, nil
+		}
+
+		phase, err := r.advanceRootCARotation(ctx, c, recorder, icp, rotation, result, logger)
+		if err != nil {
+			return result, err
+		}
+
+		if phase == rotation.GetPhase() {
+			if result.RequeueAfter == 0 || rootCARotationRequeueDuration < result.RequeueAfter {
+				result.RequeueAfter = rootCARotationRequeueDuration
+			}
+
+			return result, nil
+		}
+
+		recorder.Eventf(icp, corev1.EventTypeNormal, eventReasonRootCARotationPhaseChanged, "rotation to root CA %s: phase changed from %s to %s", rotation.GetNextRootCASecret(), rotation.GetPhase(), phase)
+		rotation.Phase = phase
+		rotation.PendingPeers = nil
+		rotation.LastTransitionTime, _ = types.TimestampProto(time.Now().Truncate(time.Second))
+	}
+}
+
+// advanceRootCARotation returns the phase the root CA rotation can continue with, it is the current phase
+// as long as the rotation has to wait for the cluster or its peers
+func (r *IstioControlPlaneReconciler) advanceRootCARotation(ctx context.Context, c client.Client, recorder record.EventRecorder, icp *servicemeshv1alpha1.IstioControlPlane, rotation *servicemeshv1alpha1.RootCARotationStatus, result pluginca.Result, logger logger.Logger) (servicemeshv1alpha1.RootCARotationPhase, error) {
+	phase := rotation.GetPhase()
+
+	switch phase {
+	case servicemeshv1alpha1.RootCARotationPhase_AddingTrust:
+		// istiod distributes the trust bundle of the plug-in CA to the workloads once it is restarted with it
+		ready, err := r.isIstiodRunningWithPluginCA(ctx, icp, result.Checksum)
+		if err != nil || !ready {
+			return phase, err
+		}
+
+		return servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers, nil
+	case servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers:
+		pending, err := r.getPendingPeers(ctx, icp, func(peer ControlPlane) bool {
+			return peerTrustsRootCA(peer, result.NextRootCertificate)
+		})
+		if err != nil {
+			return phase, err
+		}
+
+		rotation.PendingPeers = pending
+		if len(pending) > 0 {
+			logger.Info("waiting for the peer control planes to trust the new root CA", "peers", pending)
+
+			return phase, nil
+		}
+
+		return servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner, nil
+	case servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner:
+		ready, err := r.isIstiodRunningWithPluginCA(ctx, icp, result.Checksum)
+		if err != nil || !ready {
+			return phase, err
+		}
+
+		namespaces, err := r.getInjectionNamespaces(ctx, icp)
+		if err != nil {
+			return phase, err
+		}
+		rotation.PendingNamespaces = namespaces
+		rotation.CurrentBatch = nil
+		rotation.RestartedNamespaces = nil
+
+		return servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads, nil
+	case servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads:
+		done, err := r.restartWorkloadsForRootCARotation(ctx, c, recorder, icp, rotation, logger)
+		if err != nil || !done {
+			return phase, err
+		}
+
+		// the old root CA is trusted until the workloads of the peers got certificates from the new one as well
+		pending, err := r.getPendingPeers(ctx, icp, func(peer ControlPlane) bool {
+			return peerRotatedFromRootCA(peer, result.RootCertificate)
+		})
+		if err != nil {
+			return phase, err
+		}
+
+		rotation.PendingPeers = pending
+		if len(pending) > 0 {
+			logger.Info("waiting for the peer control planes to stop using the old root CA", "peers", pending)
+
+			return phase, nil
+		}
+
+		return servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot, nil
+	case servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot:
+		ready, err := r.isIstiodRunningWithPluginCA(ctx, icp, result.Checksum)
+		if err != nil || !ready {
+			return phase, err
+		}
+
+		return servicemeshv1alpha1.RootCARotationPhase_RotationCompleted, nil
+	}
+
+	return phase, nil
+}
+
+// restartWorkloadsForRootCARotation restarts the workloads of the injection namespaces of the control plane in batches,
+// the next batch starts when the workloads of the current batch are ready, it returns true when every batch is done
+func (r *IstioControlPlaneReconciler) restartWorkloadsForRootCARotation(ctx context.Context, c client.Client, recorder record.EventRecorder, icp *servicemeshv1alpha1.IstioControlPlane, rotation *servicemeshv1alpha1.RootCARotationStatus, logger logger.Logger) (bool, error) {
+	filter := isWorkloadInjectedByRevision(icp.NamespacedRevision())
+
+	if len(rotation.CurrentBatch) > 0 {
+		for _, namespace := range rotation.CurrentBatch {
+			ready, err := k8sutil.AreWorkloadsReady(ctx, c, namespace, filter)
+			if err != nil {
+				return false, err
+			}
+
+			if !ready {
+				logger.Info("waiting for the restarted workloads to become ready", "namespaces", rotation.CurrentBatch)
+
+				return false, nil
+			}
+		}
+
+		rotation.RestartedNamespaces = append(rotation.RestartedNamespaces, rotation.CurrentBatch...)
+		rotation.CurrentBatch = nil
+	}
+
+	if len(rotation.PendingNamespaces) == 0 {
+		return true, nil
+	}
+
+	var batch []string
+	batch, rotation.PendingNamespaces = nextBatch(rotation.PendingNamespaces, icp.GetSpec().GetCa().RestartBatchSizeOrDefault())
+
+	// every namespace is recorded in the current batch as soon as its workloads are restarted, so the rotation
+	// can not move on while the namespace which failed and the rest of the batch are not restarted yet
+	for i, namespace := range batch {
+		restarted, err := k8sutil.RestartWorkloads(ctx, c, namespace, filter)
+		if err != nil {
+			rotation.PendingNamespaces = append(append([]string{}, batch[i:]...), rotation.PendingNamespaces...)
+
+			return false, errors.WrapIfWithDetails(err, "could not restart workloads for root CA rotation", "namespace", namespace)
+		}
+		logger.Info("workloads restarted for root CA rotation", "namespace", namespace, "count", restarted)
+		rotation.CurrentBatch = append(rotation.CurrentBatch, namespace)
+	}
+
+	recorder.Eventf(icp, corev1.EventTypeNormal, eventReasonRootCARotationBatchStarted, "restarting the workloads of namespaces %s", strings.Join(batch, ", "))
+
+	return false, nil
+}
+
+// isIstiodRunningWithPluginCA returns whether the istiod deployment of the control plane finished its rollout
+// with the current content of the plug-in CA secret
+func (r *IstioControlPlaneReconciler) isIstiodRunningWithPluginCA(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, checksum string) (bool, error) {
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, client.ObjectKey{
+		Name:      icp.WithRevision("istiod"),
+		Namespace: icp.GetNamespace(),
+	}, deployment)
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapIfWithDetails(err, "could not get istiod deployment", "revision", icp.NamespacedRevision())
+	}
+
+	if deployment.Spec.Template.GetAnnotations()[caCertificatesChecksumAnnotation] != checksum {
+		return false, nil
+	}
+
+	return k8sutil.IsWorkloadReady(deployment) && deployment.Status.AvailableReplicas > 0, nil
+}
+
+// getPendingPeers returns the cluster IDs of the active peer control planes which are not done yet
+func (r *IstioControlPlaneReconciler) getPendingPeers(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane, done func(peer ControlPlane) bool) ([]string, error) {
+	peers, err := r.getActivePeers(ctx, icp)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]string, 0)
+	for _, peer := range peers {
+		if done(peer) {
+			continue
+		}
+
+		id := peer.GetStatus().ClusterID
+		if id == "" {
+			id = peer.GetName()
+		}
+		pending = append(pending, id)
+	}
+
+	return pending, nil
+}
+
+func (r *IstioControlPlaneReconciler) getInjectionNamespaces(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) ([]string, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces, client.MatchingLabels(icp.RevisionLabels())); err != nil {
+		return nil, errors.WrapIf(err, "could not list namespaces")
+	}
+
+	names := make([]string, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		names = append(names, ns.GetName())
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// peerTrustsRootCA returns whether the root CA is in the trust bundle of the peer, either as its own root CA
+// or among the CA certificates of its mesh config
+func peerTrustsRootCA(peer ControlPlane, root *x509.Certificate) bool {
+	status := peer.GetStatus()
+	if pki.ContainsCertificate([]byte(status.CaRootCertificate), root) {
+		return true
+	}
+
+	for _, ca := range status.MeshConfig.GetCaCertificates() {
+		if pki.ContainsCertificate([]byte(ca.GetPem()), root) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerRotatedFromRootCA returns whether the workloads of the peer do not get certificates issued by the root CA anymore.
+// A peer which is rotating as well counts as rotated once its workloads are restarted, even if it waits for this
+// control plane in turn, otherwise control planes which are rotated at the same time would wait for each other forever.
+func peerRotatedFromRootCA(peer ControlPlane, root *x509.Certificate) bool {
+	status := peer.GetStatus()
+	rotation := status.GetCa().GetRotation()
+
+	switch rotation.GetPhase() {
+	case servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot, servicemeshv1alpha1.RootCARotationPhase_RotationCompleted:
+		return true
+	case servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads:
+		return len(rotation.GetPendingNamespaces()) == 0 && len(rotation.GetCurrentBatch()) == 0
+	}
+
+	if rotation != nil {
+		return false
+	}
+
+	// istiod signs with its own root CA when it has no plug-in CA
+	chain := status.GetCa().GetCertificateChain()
+	if chain == "" {
+		chain = status.CaRootCertificate
+	}
+
+	return !pki.ContainsCertificate([]byte(chain), root)
+}
+
+// isWorkloadInjectedByRevision returns a filter for the workloads which get their proxy from the given revision,
+// that is they did not opt out of injection and they are not pinned to another revision through their own label
+func isWorkloadInjectedByRevision(revision string) k8sutil.WorkloadFilter {
+	return func(template *corev1.PodTemplateSpec) bool {
+		if v, ok := template.GetLabels()[servicemeshv1alpha1.RevisionedAutoInjectionLabel]; ok && v != revision {
+			return false
+		}
+		if v, ok := template.GetAnnotations()[sidecarInjectAnnotation]; ok && v == "false" {
+			return false
+		}
+		if v, ok := template.GetLabels()[sidecarInjectAnnotation]; ok && v == "false" {
+			return false
+		}
+
+		return true
+	}
+}
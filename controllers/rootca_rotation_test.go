@@ -0,0 +1,530 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/kylelemons/godebug/pretty"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+)
+
+const testPluginCAChecksum = "checksum"
+
+func newTestRootCA(t *testing.T, name string) (string, *x509.Certificate) {
+	t.Helper()
+
+	now := time.Now()
+	certPEM, _, err := pki.SelfSigned(&x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"Istio"}, CommonName: name},
+		NotBefore:             now,
+		NotAfter:              now.Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := pki.ParseCertificate(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(certPEM), cert
+}
+
+func newTestPeer(name string, status servicemeshv1alpha1.IstioControlPlaneStatus) *servicemeshv1alpha1.PeerIstioControlPlane {
+	status.IstioControlPlaneName = "cp-v112x"
+	status.ClusterID = name
+
+	return &servicemeshv1alpha1.PeerIstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system"},
+		Spec:       &servicemeshv1alpha1.IstioControlPlaneSpec{Mode: servicemeshv1alpha1.ModeType_ACTIVE},
+		Status:     status,
+	}
+}
+
+func newIstiodWithPluginCA(icp *servicemeshv1alpha1.IstioControlPlane, checksum string) *appsv1.Deployment {
+	deployment := newReadyIstiod(icp)
+	deployment.Spec.Template.Annotations = map[string]string{caCertificatesChecksumAnnotation: checksum}
+
+	return deployment
+}
+
+func TestAdvanceRootCARotation(t *testing.T) {
+	t.Parallel()
+
+	oldRootPEM, oldRoot := newTestRootCA(t, "Old Root CA")
+	nextRootPEM, nextRoot := newTestRootCA(t, "Next Root CA")
+	icp := newUpgradeTestControlPlane("cp-v112x")
+
+	namespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: icp.RevisionLabels()}}
+	}
+	peerOnOldRoot := newTestPeer("cluster-2", servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: oldRootPEM})
+	peerTrustingNextRoot := newTestPeer("cluster-2", servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: oldRootPEM + nextRootPEM})
+	peerOnNextRoot := newTestPeer("cluster-2", servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: nextRootPEM})
+
+	testCases := []struct {
+		name             string
+		objects          []client.Object
+		rotation         servicemeshv1alpha1.RootCARotationStatus
+		expectedPhase    servicemeshv1alpha1.RootCARotationPhase
+		expectedRotation servicemeshv1alpha1.RootCARotationStatus
+	}{
+		{
+			name:             "adding trust waits for istiod to load the trust bundle",
+			objects:          []client.Object{newIstiodWithPluginCA(icp, "previous")},
+			rotation:         servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_AddingTrust},
+			expectedPhase:    servicemeshv1alpha1.RootCARotationPhase_AddingTrust,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_AddingTrust},
+		},
+		{
+			name:             "adding trust is done when istiod runs with the trust bundle",
+			objects:          []client.Object{newIstiodWithPluginCA(icp, testPluginCAChecksum)},
+			rotation:         servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_AddingTrust},
+			expectedPhase:    servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_AddingTrust},
+		},
+		{
+			name:          "waiting for peers which do not trust the new root CA yet",
+			objects:       []client.Object{peerOnOldRoot},
+			rotation:      servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers},
+			expectedPhase: servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:        servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers,
+				PendingPeers: []string{"cluster-2"},
+			},
+		},
+		{
+			name:             "signer is switched when the peers trust the new root CA",
+			objects:          []client.Object{peerTrustingNextRoot},
+			rotation:         servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers},
+			expectedPhase:    servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers, PendingPeers: []string{}},
+		},
+		{
+			name:          "workloads of the injection namespaces are restarted once istiod signs with the new root CA",
+			objects:       []client.Object{newIstiodWithPluginCA(icp, testPluginCAChecksum), namespace("b"), namespace("a")},
+			rotation:      servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner},
+			expectedPhase: servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:             servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner,
+				PendingNamespaces: []string{"a", "b"},
+			},
+		},
+		{
+			name:    "workloads are restarted in batches",
+			objects: []client.Object{namespace("a"), namespace("b")},
+			rotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:             servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				PendingNamespaces: []string{"a", "b"},
+			},
+			expectedPhase: servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:             servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				PendingNamespaces: []string{"b"},
+				CurrentBatch:      []string{"a"},
+			},
+		},
+		{
+			name:    "old root CA is kept while the peers still use it",
+			objects: []client.Object{namespace("a"), peerTrustingNextRoot},
+			rotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:        servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				CurrentBatch: []string{"a"},
+			},
+			expectedPhase: servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:               servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				RestartedNamespaces: []string{"a"},
+				PendingPeers:        []string{"cluster-2"},
+			},
+		},
+		{
+			name:    "old root CA is removed when the workloads and the peers are rotated",
+			objects: []client.Object{namespace("a"), peerOnNextRoot},
+			rotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:               servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				RestartedNamespaces: []string{"a"},
+			},
+			expectedPhase: servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{
+				Phase:               servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+				RestartedNamespaces: []string{"a"},
+				PendingPeers:        []string{},
+			},
+		},
+		{
+			name:             "rotation is completed when istiod runs without the old root CA",
+			objects:          []client.Object{newIstiodWithPluginCA(icp, testPluginCAChecksum)},
+			rotation:         servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot},
+			expectedPhase:    servicemeshv1alpha1.RootCARotationPhase_RotationCompleted,
+			expectedRotation: servicemeshv1alpha1.RootCARotationStatus{Phase: servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &IstioControlPlaneReconciler{
+				Client:   newFakeClient(tc.objects...),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			rotation := tc.rotation
+			result := pluginca.Result{
+				Checksum:            testPluginCAChecksum,
+				RootCertificate:     oldRoot,
+				NextRootCertificate: nextRoot,
+			}
+
+			phase, err := r.advanceRootCARotation(context.Background(), r.Client, r.Recorder, icp.DeepCopy(), &rotation, result, newTestLogger())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if phase != tc.expectedPhase {
+				t.Errorf("expected phase %s, got %s", tc.expectedPhase, phase)
+			}
+			if diff := pretty.Compare(rotation, tc.expectedRotation); diff != "" {
+				t.Errorf("unexpected rotation status (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestConcurrentRootCARotationOfPeers rotates the root CA of two peer clusters at the same time,
+// the clusters must not wait for each other to remove the old root CA
+func TestConcurrentRootCARotationOfPeers(t *testing.T) {
+	t.Parallel()
+
+	_, oldRoot := newTestRootCA(t, "Old Root CA")
+	nextRootPEM, nextRoot := newTestRootCA(t, "Next Root CA")
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	result := pluginca.Result{
+		Checksum:            testPluginCAChecksum,
+		RootCertificate:     oldRoot,
+		NextRootCertificate: nextRoot,
+	}
+
+	type cluster struct {
+		name     string
+		client   client.Client
+		rotation *servicemeshv1alpha1.RootCARotationStatus
+	}
+
+	// every cluster sees the other one as its peer
+	clusters := []*cluster{{name: "cluster-1"}, {name: "cluster-2"}}
+	for i, c := range clusters {
+		c.client = newFakeClient(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: icp.RevisionLabels()}},
+			newTestPeer(clusters[1-i].name, servicemeshv1alpha1.IstioControlPlaneStatus{}),
+		)
+		c.rotation = &servicemeshv1alpha1.RootCARotationStatus{
+			Phase:             servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+			PendingNamespaces: []string{"a"},
+		}
+	}
+
+	for round := 0; round < 5; round++ {
+		for i, c := range clusters {
+			other := clusters[1-i]
+
+			peer := &servicemeshv1alpha1.PeerIstioControlPlane{}
+			if err := c.client.Get(context.Background(), client.ObjectKey{Name: other.name, Namespace: icp.GetNamespace()}, peer); err != nil {
+				t.Fatal(err)
+			}
+			peer.Status.Ca = &servicemeshv1alpha1.CAStatus{
+				CertificateChain: nextRootPEM,
+				Rotation:         other.rotation.DeepCopy(),
+			}
+			if err := c.client.Status().Update(context.Background(), peer); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &IstioControlPlaneReconciler{
+				Client:   c.client,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			phase, err := r.advanceRootCARotation(context.Background(), r.Client, r.Recorder, icp.DeepCopy(), c.rotation, result, newTestLogger())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if phase != c.rotation.GetPhase() {
+				c.rotation.Phase = phase
+				c.rotation.PendingPeers = nil
+			}
+		}
+	}
+
+	for _, c := range clusters {
+		if phase := c.rotation.GetPhase(); phase != servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot {
+			t.Errorf("expected phase %s of %s, got %s", servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot, c.name, phase)
+		}
+	}
+}
+
+// failingWorkloadPatchClient fails the patches of the objects in the given namespace
+type failingWorkloadPatchClient struct {
+	client.Client
+	namespace string
+}
+
+func (c failingWorkloadPatchClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if obj.GetNamespace() == c.namespace {
+		return errors.New("patch failed")
+	}
+
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestRestartWorkloadsForRootCARotationKeepsPartialBatchOnError(t *testing.T) {
+	t.Parallel()
+
+	icp := newUpgradeTestControlPlane("cp-v112x")
+	batchSize := int32(2)
+	icp.Spec.Ca = &servicemeshv1alpha1.CAConfiguration{RestartBatchSize: &batchSize}
+
+	var objects []client.Object
+	for _, name := range []string{"a", "b", "c"} {
+		objects = append(objects,
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: icp.RevisionLabels()}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: name}},
+		)
+	}
+
+	c := newFakeClient(objects...)
+	r := &IstioControlPlaneReconciler{
+		Client:   failingWorkloadPatchClient{Client: c, namespace: "b"},
+		Recorder: record.NewFakeRecorder(10),
+	}
+	rotation := &servicemeshv1alpha1.RootCARotationStatus{
+		Phase:             servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads,
+		PendingNamespaces: []string{"a", "b", "c"},
+	}
+
+	if _, err := r.restartWorkloadsForRootCARotation(context.Background(), r.Client, r.Recorder, icp, rotation, newTestLogger()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	// the restarted namespace is tracked in the current batch, the rest is retried
+	if diff := pretty.Compare(rotation.CurrentBatch, []string{"a"}); diff != "" {
+		t.Errorf("unexpected current batch (-got +want):\n%s", diff)
+	}
+	if diff := pretty.Compare(rotation.PendingNamespaces, []string{"b", "c"}); diff != "" {
+		t.Errorf("unexpected pending namespaces (-got +want):\n%s", diff)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "a"}, deployment); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := deployment.Spec.Template.GetAnnotations()["kubectl.kubernetes.io/restartedAt"]; !ok {
+		t.Error("expected the workload of the batch to be restarted")
+	}
+}
+
+func TestPeerTrustsRootCA(t *testing.T) {
+	t.Parallel()
+
+	oldRootPEM, _ := newTestRootCA(t, "Old Root CA")
+	nextRootPEM, nextRoot := newTestRootCA(t, "Next Root CA")
+
+	testCases := []struct {
+		name     string
+		status   servicemeshv1alpha1.IstioControlPlaneStatus
+		expected bool
+	}{
+		{
+			name:     "root CA of the peer",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: oldRootPEM + nextRootPEM},
+			expected: true,
+		},
+		{
+			name: "CA certificate of the mesh config of the peer",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{
+				CaRootCertificate: oldRootPEM,
+				MeshConfig: &meshv1alpha1.MeshConfig{
+					CaCertificates: []*meshv1alpha1.MeshConfig_CertificateData{
+						{CertificateData: &meshv1alpha1.MeshConfig_CertificateData_Pem{Pem: nextRootPEM}},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:   "root CA is not trusted",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: oldRootPEM},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := peerTrustsRootCA(newTestPeer("cluster-2", tc.status), nextRoot); actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPeerRotatedFromRootCA(t *testing.T) {
+	t.Parallel()
+
+	oldRootPEM, oldRoot := newTestRootCA(t, "Old Root CA")
+	nextRootPEM, _ := newTestRootCA(t, "Next Root CA")
+
+	rotation := func(phase servicemeshv1alpha1.RootCARotationPhase) *servicemeshv1alpha1.CAStatus {
+		return &servicemeshv1alpha1.CAStatus{
+			CertificateChain: oldRootPEM,
+			Rotation:         &servicemeshv1alpha1.RootCARotationStatus{Phase: phase},
+		}
+	}
+	restarting := func(pendingNamespaces, currentBatch []string) *servicemeshv1alpha1.CAStatus {
+		status := rotation(servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads)
+		status.Rotation.PendingNamespaces = pendingNamespaces
+		status.Rotation.CurrentBatch = currentBatch
+		status.Rotation.RestartedNamespaces = []string{"a"}
+
+		return status
+	}
+
+	testCases := []struct {
+		name     string
+		status   servicemeshv1alpha1.IstioControlPlaneStatus
+		expected bool
+	}{
+		{
+			name:     "peer removing the old root CA",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{Ca: rotation(servicemeshv1alpha1.RootCARotationPhase_RemovingOldRoot)},
+			expected: true,
+		},
+		{
+			name:     "peer completed its rotation",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{Ca: rotation(servicemeshv1alpha1.RootCARotationPhase_RotationCompleted)},
+			expected: true,
+		},
+		{
+			name:   "peer with pending namespaces to restart",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{Ca: restarting([]string{"b"}, nil)},
+		},
+		{
+			name:   "peer restarting its workloads",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{Ca: restarting(nil, []string{"b"})},
+		},
+		{
+			name:     "peer restarted its workloads",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{Ca: restarting(nil, nil)},
+			expected: true,
+		},
+		{
+			name:   "plug-in CA of the peer issued by the old root CA",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{Ca: &servicemeshv1alpha1.CAStatus{CertificateChain: oldRootPEM}},
+		},
+		{
+			name:     "plug-in CA of the peer issued by the new root CA",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{Ca: &servicemeshv1alpha1.CAStatus{CertificateChain: nextRootPEM}},
+			expected: true,
+		},
+		{
+			name:   "peer signing with the old root CA itself",
+			status: servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: oldRootPEM},
+		},
+		{
+			name:     "peer signing with another root CA",
+			status:   servicemeshv1alpha1.IstioControlPlaneStatus{CaRootCertificate: nextRootPEM},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := peerRotatedFromRootCA(newTestPeer("cluster-2", tc.status), oldRoot); actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsWorkloadInjectedByRevision(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "workload injected through its namespace",
+			expected: true,
+		},
+		{
+			name:     "workload pinned to the revision",
+			labels:   map[string]string{servicemeshv1alpha1.RevisionedAutoInjectionLabel: "cp-v112x.istio-system"},
+			expected: true,
+		},
+		{
+			name:   "workload pinned to another revision",
+			labels: map[string]string{servicemeshv1alpha1.RevisionedAutoInjectionLabel: "cp-v111x.istio-system"},
+		},
+		{
+			name:        "workload opted out of injection by annotation",
+			annotations: map[string]string{sidecarInjectAnnotation: "false"},
+		},
+		{
+			name:   "workload opted out of injection by label",
+			labels: map[string]string{sidecarInjectAnnotation: "false"},
+		},
+	}
+
+	filter := isWorkloadInjectedByRevision("cp-v112x.istio-system")
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			template := &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: tc.labels, Annotations: tc.annotations},
+			}
+			if actual := filter(template); actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -57,7 +57,11 @@ var _ = BeforeSuite(func(done Done) {
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths: []string{filepath.Join("..", "config", "crd", "bases")},
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "config", "crd", "bases"),
+			// stubs of the CRDs of optional third party components like cert-manager
+			filepath.Join("testdata", "crds"),
+		},
 	}
 
 	var err error
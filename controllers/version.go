@@ -16,14 +16,11 @@ limitations under the License.
 
 package controllers
 
-import "regexp"
-
-const (
-	supportedIstioMinorVersionRegex = "^1\\.12(\\.[0-9]+)?(-.+)?$"
-)
+import "github.com/banzaicloud/istio-operator/v2/internal/assets"
 
+// IsIstioVersionSupported reports whether a chart bundle is available for the minor version of the given Istio version
 func IsIstioVersionSupported(version string) bool {
-	re, _ := regexp.Compile(supportedIstioMinorVersionRegex)
+	_, err := assets.GetChartBundle(version)
 
-	return re.Match([]byte(version))
+	return err == nil
 }
@@ -22,42 +22,27 @@ import (
 )
 
 var (
-	//go:embed manifests/base
-	//go:embed manifests/base/templates/_helpers.tpl
-	baseChart embed.FS
-	BaseChart = GetSubFS(baseChart, "manifests/base")
-
-	//go:embed manifests/istio-discovery
-	//go:embed manifests/istio-discovery/templates/_helpers.tpl
-	discoveryChart embed.FS
-	DiscoveryChart = GetSubFS(discoveryChart, "manifests/istio-discovery")
-
-	//go:embed manifests/istio-cni
-	//go:embed manifests/istio-cni/templates/_helpers.tpl
-	cniChart embed.FS
-	CNIChart = GetSubFS(cniChart, "manifests/istio-cni")
-
-	//go:embed manifests/istio-meshexpansion
-	//go:embed manifests/istio-meshexpansion/templates/_helpers.tpl
-	meshExpansionChart embed.FS
-	MeshExpansionChart = GetSubFS(meshExpansionChart, "manifests/istio-meshexpansion")
-
-	//go:embed manifests/istio-meshgateway
-	//go:embed manifests/istio-meshgateway/templates/_helpers.tpl
-	istioMeshGateway embed.FS
-	IstioMeshGateway = GetSubFS(istioMeshGateway, "manifests/istio-meshgateway")
-
-	//go:embed manifests/istio-sidecar-injector
-	//go:embed manifests/istio-sidecar-injector/templates/_helpers.tpl
-	istioSidecarInjector embed.FS
-	IstioSidecarInjector = GetSubFS(istioSidecarInjector, "manifests/istio-sidecar-injector")
-
-	//go:embed manifests/resource-sync-rule
-	//go:embed manifests/resource-sync-rule/templates/_helpers.tpl
-	resourceSyncRule embed.FS
-	ResourceSyncRule = GetSubFS(resourceSyncRule, "manifests/resource-sync-rule")
+	//go:embed manifests/1.12/base
+	//go:embed manifests/1.12/base/templates/_helpers.tpl
+	//go:embed manifests/1.12/istio-discovery
+	//go:embed manifests/1.12/istio-discovery/templates/_helpers.tpl
+	//go:embed manifests/1.12/istio-cni
+	//go:embed manifests/1.12/istio-cni/templates/_helpers.tpl
+	//go:embed manifests/1.12/istio-meshexpansion
+	//go:embed manifests/1.12/istio-meshexpansion/templates/_helpers.tpl
+	//go:embed manifests/1.12/istio-meshgateway
+	//go:embed manifests/1.12/istio-meshgateway/templates/_helpers.tpl
+	//go:embed manifests/1.12/istio-sidecar-injector
+	//go:embed manifests/1.12/istio-sidecar-injector/templates/_helpers.tpl
+	//go:embed manifests/1.12/resource-sync-rule
+	//go:embed manifests/1.12/resource-sync-rule/templates/_helpers.tpl
+	v112Charts embed.FS
 )
 
+func init() {
+	RegisterChartBundle(NewChartBundle("1.12", GetSubFS(v112Charts, "manifests/1.12")))
+}
+
 func GetSubFS(fsys fs.FS, dir string) (subFS fs.FS) {
 	subFS, err := fs.Sub(fsys, dir)
 	if err != nil {
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assets
+
+import (
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"sync"
+
+	"emperror.dev/errors"
+	"github.com/Masterminds/semver/v3"
+)
+
+var istioVersionRegex = regexp.MustCompile(`^([0-9]+\.[0-9]+)(\.[0-9]+)?(-.+)?$`)
+
+// ChartBundle holds the charts which are used to render the components of an Istio control plane
+// of a specific Istio minor version
+type ChartBundle struct {
+	// Istio minor version of the bundle, e.g. 1.12
+	Version string
+
+	BaseChart            fs.FS
+	DiscoveryChart       fs.FS
+	CNIChart             fs.FS
+	MeshExpansionChart   fs.FS
+	IstioMeshGateway     fs.FS
+	IstioSidecarInjector fs.FS
+	// NodeProxyChart is only set for Istio versions whose proxies support the sidecarless (ambient) data plane
+	NodeProxyChart   fs.FS
+	ResourceSyncRule fs.FS
+}
+
+// NewChartBundle creates a chart bundle from a filesystem which contains the charts in the standard layout,
+// the optional charts are only part of the bundle if they are present in the filesystem
+func NewChartBundle(version string, fsys fs.FS) *ChartBundle {
+	return &ChartBundle{
+		Version:              version,
+		BaseChart:            GetSubFS(fsys, "base"),
+		DiscoveryChart:       GetSubFS(fsys, "istio-discovery"),
+		CNIChart:             GetSubFS(fsys, "istio-cni"),
+		MeshExpansionChart:   GetSubFS(fsys, "istio-meshexpansion"),
+		IstioMeshGateway:     GetSubFS(fsys, "istio-meshgateway"),
+		IstioSidecarInjector: GetSubFS(fsys, "istio-sidecar-injector"),
+		NodeProxyChart:       getOptionalSubFS(fsys, "istio-node-proxy"),
+		ResourceSyncRule:     GetSubFS(fsys, "resource-sync-rule"),
+	}
+}
+
+// SupportsNodeProxy returns whether the node proxies of the sidecarless data plane can be deployed with the bundle
+func (b *ChartBundle) SupportsNodeProxy() bool {
+	return b.NodeProxyChart != nil
+}
+
+func getOptionalSubFS(fsys fs.FS, dir string) fs.FS {
+	if _, err := fs.Stat(fsys, path.Join(dir, "Chart.yaml")); err != nil {
+		return nil
+	}
+
+	return GetSubFS(fsys, dir)
+}
+
+var (
+	chartBundles   = map[string]*ChartBundle{}
+	chartBundlesMu sync.RWMutex
+)
+
+// RegisterChartBundle makes a chart bundle available for Istio control planes of the bundle's minor version,
+// a previously registered bundle of the same version is replaced
+func RegisterChartBundle(bundle *ChartBundle) {
+	chartBundlesMu.Lock()
+	defer chartBundlesMu.Unlock()
+
+	chartBundles[bundle.Version] = bundle
+}
+
+// GetChartBundle returns the chart bundle which belongs to the minor version of the given Istio version
+func GetChartBundle(version string) (*ChartBundle, error) {
+	minor, ok := IstioMinorVersion(version)
+	if !ok {
+		return nil, errors.NewWithDetails("invalid Istio version", "version", version)
+	}
+
+	chartBundlesMu.RLock()
+	defer chartBundlesMu.RUnlock()
+
+	if bundle, ok := chartBundles[minor]; ok {
+		return bundle, nil
+	}
+
+	return nil, errors.NewWithDetails("no chart bundle is available for Istio version", "version", version, "supportedVersions", supportedVersions())
+}
+
+// SupportedVersions returns the Istio minor versions which have a registered chart bundle in ascending order
+func SupportedVersions() []string {
+	chartBundlesMu.RLock()
+	defer chartBundlesMu.RUnlock()
+
+	return supportedVersions()
+}
+
+func supportedVersions() []string {
+	versions := make([]string, 0, len(chartBundles))
+	for version := range chartBundles {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		vi, erri := semver.NewVersion(versions[i])
+		vj, errj := semver.NewVersion(versions[j])
+		if erri != nil || errj != nil {
+			return versions[i] < versions[j]
+		}
+
+		return vi.LessThan(vj)
+	})
+
+	return versions
+}
+
+// IstioMinorVersion returns the minor version part of an Istio version, e.g. 1.12 for 1.12.5 or 1.12-dev
+func IstioMinorVersion(version string) (string, bool) {
+	match := istioVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		return "", false
+	}
+
+	return match[1], true
+}
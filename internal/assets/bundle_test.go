@@ -0,0 +1,128 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assets_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/kylelemons/godebug/pretty"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/assets"
+)
+
+func TestGetChartBundle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version         string
+		expectedVersion string
+		expectedError   bool
+	}{
+		{version: "1.12", expectedVersion: "1.12"},
+		{version: "1.12-dev", expectedVersion: "1.12"},
+		{version: "1.12.5", expectedVersion: "1.12"},
+		{version: "1.12.5-distroless", expectedVersion: "1.12"},
+		{version: "1.11.4", expectedError: true},
+		{version: "1.120", expectedError: true},
+		{version: "1", expectedError: true},
+		{version: "", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+
+			bundle, err := assets.GetChartBundle(tt.version)
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected error for version %q", tt.version)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := pretty.Compare(bundle.Version, tt.expectedVersion); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+
+			for _, chart := range []fs.FS{
+				bundle.BaseChart,
+				bundle.DiscoveryChart,
+				bundle.CNIChart,
+				bundle.MeshExpansionChart,
+				bundle.IstioMeshGateway,
+				bundle.IstioSidecarInjector,
+				bundle.ResourceSyncRule,
+			} {
+				if _, err := fs.Stat(chart, "Chart.yaml"); err != nil {
+					t.Error(err)
+				}
+				if _, err := fs.Stat(chart, "templates/_helpers.tpl"); err != nil {
+					t.Error(err)
+				}
+			}
+
+			// the proxies of Istio 1.12 cannot run as node proxies of the sidecarless data plane
+			if bundle.SupportsNodeProxy() {
+				t.Error("the node proxy must not be supported")
+			}
+		})
+	}
+}
+
+func TestRegisterChartBundle(t *testing.T) {
+	assets.RegisterChartBundle(assets.NewChartBundle("1.9", fstest.MapFS{
+		"istio-discovery/Chart.yaml": &fstest.MapFile{Data: []byte("name: istio-discovery")},
+	}))
+
+	bundle, err := assets.GetChartBundle("1.9.9")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fs.Stat(bundle.DiscoveryChart, "Chart.yaml"); err != nil {
+		t.Error(err)
+	}
+
+	if bundle.SupportsNodeProxy() {
+		t.Error("the node proxy must not be supported without its chart")
+	}
+
+	assets.RegisterChartBundle(assets.NewChartBundle("1.9", fstest.MapFS{
+		"istio-discovery/Chart.yaml":  &fstest.MapFile{Data: []byte("name: istio-discovery")},
+		"istio-node-proxy/Chart.yaml": &fstest.MapFile{Data: []byte("name: istio-node-proxy")},
+	}))
+
+	bundle, err = assets.GetChartBundle("1.9.9")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bundle.SupportsNodeProxy() {
+		t.Error("the node proxy must be supported with its chart")
+	}
+
+	if diff := pretty.Compare(assets.SupportedVersions(), []string{"1.9", "1.12"}); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+}
@@ -6,6 +6,9 @@
 {{ end }}
 {{ with .GetSpec.GetMeshExpansion.GetGateway }}
 {{ valueIf (dict "key" "runAsRoot" "value" .GetRunAsRoot) }}
+{{- if .GetAddressResolution }}
+addressResolution: {{ .GetAddressResolution | toString }}
+{{- end }}
 {{ toYamlIf (dict "value" .GetMetadata "key" "metadata") }}
 {{ toYamlIf (dict "value" .GetDeployment "key" "deployment") }}
 {{ toYamlIf (dict "value" .GetService "key" "service") }}
@@ -60,3 +60,11 @@ externalService:
 {{ toYamlIf (dict "value" . "key" "addresses") | indent 2 }}
 {{- end }}
 {{- end }}
+
+{{- if eq (.GetSpec.GetType | toString) "egress" }}
+{{- with .GetSpec.GetEgress }}
+egress:
+{{ toYamlIf (dict "value" .GetHosts "key" "hosts") | indent 2 }}
+{{ valueIf (dict "key" "clusterDomain" "value" $.Properties.GetIstioControlPlane.GetSpec.GetProxy.GetClusterDomain) | indent 2 }}
+{{- end }}
+{{- end }}
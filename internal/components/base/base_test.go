@@ -97,7 +97,12 @@ func TestICPBaseValuesTemplateTransform(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.BaseChart, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.BaseChart, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
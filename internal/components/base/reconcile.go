@@ -68,6 +68,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) ConditionType() string {
+	return v1alpha1.ConditionTypeBaseReady
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero()
@@ -82,6 +86,11 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	values, err := rec.values(object)
 	if err != nil {
 		return nil, err
@@ -97,7 +106,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 	}
 
 	return &templatereconciler.ReleaseData{
-		Chart:       http.FS(assets.BaseChart),
+		Chart:       http.FS(bundle.BaseChart),
 		Values:      values,
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
@@ -156,7 +165,12 @@ func (rec *Component) values(object runtime.Object) (helm.Strimap, error) {
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.BaseChart, valuesTemplateFileName)
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.BaseChart, valuesTemplateFileName)
 	if err != nil {
 		return nil, errors.WrapIff(err, "IstioControlPlane spec cannot be converted into a map[string]interface{}")
 	}
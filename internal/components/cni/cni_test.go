@@ -94,7 +94,12 @@ func TestICPCNIValuesTemplateTransform(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.CNIChart, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.CNIChart, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
@@ -19,6 +19,7 @@ package components
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"emperror.dev/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -28,6 +29,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/metrics"
 	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 	"github.com/banzaicloud/operator-tools/pkg/types"
@@ -61,14 +63,46 @@ type ObjectWithStatus interface {
 	SetStatus(status v1alpha1.ConfigState, errorMessage string)
 }
 
+type ObjectWithConditions interface {
+	client.Object
+	SetCondition(condition v1alpha1.Condition)
+	GetCondition(conditionType string) *v1alpha1.Condition
+	SetObservedGeneration(generation int64)
+}
+
+type ObjectWithComponentStatus interface {
+	client.Object
+	SetComponentStatus(status v1alpha1.ComponentStatus)
+	GetComponentStatus(name string) *v1alpha1.ComponentStatus
+}
+
 type Base struct {
 	HelmReconciler *HelmReconciler
 	Component      MinimalComponent
+
+	inventory *inventory
 }
 
 func (rec *Base) Reconcile(object runtime.Object) (reconcile.Result, error) {
+	rec.inventory = newInventory(rec.GetHelmReconciler().GetClient().Scheme())
+	inventories.Store(object, rec.inventory)
+	defer func() {
+		inventories.Delete(object)
+		rec.inventory = nil
+	}()
+
+	start := time.Now()
 	result, err := rec.GetHelmReconciler().Reconcile(object, rec)
+	metrics.ObserveComponentReconcile(rec.Name(), start, err)
 	if err != nil {
+		// the results of the objects are only known once the reconciliation returned
+		if len(rec.inventory.resources) > 0 {
+			rec.inventory.fail(err)
+			if uerr := rec.UpdateStatus(object, types.ReconcileStatusFailed, err.Error()); uerr != nil {
+				return reconcile.Result{}, errors.Combine(err, uerr)
+			}
+		}
+
 		return reconcile.Result{}, err
 	}
 
@@ -76,7 +110,12 @@ func (rec *Base) Reconcile(object runtime.Object) (reconcile.Result, error) {
 }
 
 func (rec *Base) ReleaseData(object runtime.Object) (*templatereconciler.ReleaseData, error) {
-	return rec.Component.ReleaseData(object)
+	releaseData, err := rec.Component.ReleaseData(object)
+	if err != nil || rec.inventory == nil {
+		return releaseData, err
+	}
+
+	return rec.inventory.instrument(releaseData)
 }
 
 func (rec *Base) Name() string {
@@ -137,16 +176,62 @@ func (rec *Base) UpdateStatus(object runtime.Object, status types.ReconcileStatu
 		return nil
 	}
 
+	rec.setComponentStatus(object, status)
+
 	if c, ok := rec.Component.(interface {
 		UpdateStatus(object runtime.Object, status types.ReconcileStatus, message string) error
 	}); ok {
 		return c.UpdateStatus(object, status, message)
 	}
 
-	return UpdateStatus(context.Background(), rec.GetHelmReconciler().GetClient(), object, status, message)
+	return UpdateComponentStatus(context.Background(), rec.GetHelmReconciler().GetClient(), object, rec.ConditionType(), status, message)
+}
+
+// setComponentStatus sets the status of the component on the reconciled object, the inventory of the objects
+// is only replaced once the result of the reconciliation is known
+func (rec *Base) setComponentStatus(object runtime.Object, status types.ReconcileStatus) {
+	obj, ok := object.(ObjectWithComponentStatus)
+	if !ok || rec.inventory == nil {
+		return
+	}
+
+	state := ConvertReconcileStatusToConfigState(status)
+
+	switch {
+	case status == types.ReconcileStatusAvailable, status == types.ReconcileStatusSucceeded, status == types.ReconcileStatusRemoved,
+		status == types.ReconcileStatusFailed && rec.inventory.err != nil:
+		obj.SetComponentStatus(rec.inventory.componentStatus(rec.Name(), state))
+	default:
+		componentStatus := v1alpha1.ComponentStatus{
+			Name: rec.Name(),
+		}
+		if current := obj.GetComponentStatus(rec.Name()); current != nil {
+			componentStatus = *current
+		}
+		componentStatus.Status = state
+		obj.SetComponentStatus(componentStatus)
+	}
+}
+
+// ConditionType returns the type of the condition which reflects the state of the component on the status of the
+// reconciled object, components without their own condition return an empty string
+func (rec *Base) ConditionType() string {
+	if c, ok := rec.Component.(interface {
+		ConditionType() string
+	}); ok {
+		return c.ConditionType()
+	}
+
+	return ""
 }
 
 func (rec *Base) Skipped(object runtime.Object) bool {
+	if obj, ok := object.(interface {
+		ComponentUnmanaged(name string) bool
+	}); ok && obj.ComponentUnmanaged(rec.Name()) {
+		return true
+	}
+
 	if c, ok := rec.Component.(interface {
 		Skipped(object runtime.Object) bool
 	}); ok {
@@ -212,7 +297,70 @@ func ConvertConfigStateToReconcileStatus(state v1alpha1.ConfigState) types.Recon
 	return types.ReconcileStatus(v1alpha1.ConfigState_Unspecified.String())
 }
 
+// NewCondition returns a condition of the given type which reflects the reconcile status
+func NewCondition(conditionType string, generation int64, status types.ReconcileStatus, message string) v1alpha1.Condition {
+	condition := v1alpha1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: generation,
+		Message:            message,
+	}
+
+	switch status {
+	case types.ReconcileStatusSucceeded, types.ReconcileStatusAvailable:
+		condition.Status = v1alpha1.ConditionStatus_True
+		condition.Reason = v1alpha1.ConditionReasonReconciled
+	case types.ReconcileStatusReconciling:
+		condition.Status = v1alpha1.ConditionStatus_False
+		condition.Reason = v1alpha1.ConditionReasonReconciling
+	case types.ReconcileStatusFailed:
+		condition.Status = v1alpha1.ConditionStatus_False
+		condition.Reason = v1alpha1.ConditionReasonReconcileFailed
+	case types.ReconcileStatusRemoved:
+		condition.Status = v1alpha1.ConditionStatus_False
+		condition.Reason = v1alpha1.ConditionReasonDisabled
+	case types.ReconcileStatusUnmanaged:
+		condition.Status = v1alpha1.ConditionStatus_Unknown
+		condition.Reason = v1alpha1.ConditionReasonUnmanaged
+	default:
+		condition.Status = v1alpha1.ConditionStatus_Unknown
+		condition.Reason = v1alpha1.ConditionReasonPending
+	}
+
+	return condition
+}
+
+// UpdateStatus updates the overall status of the object, including its Ready condition
+// and its observed generation once the reconciliation of the generation finished
 func UpdateStatus(ctx context.Context, c client.Client, object runtime.Object, status types.ReconcileStatus, message string) error {
+	if obj, ok := object.(ObjectWithConditions); ok {
+		obj.SetCondition(NewCondition(v1alpha1.ConditionTypeReady, obj.GetGeneration(), status, message))
+
+		switch status {
+		case types.ReconcileStatusAvailable, types.ReconcileStatusSucceeded, types.ReconcileStatusFailed, types.ReconcileStatusUnmanaged:
+			obj.SetObservedGeneration(obj.GetGeneration())
+		}
+	}
+
+	return patchStatus(ctx, c, object, status, message)
+}
+
+// UpdateComponentStatus updates the condition of a component on the status of the object,
+// the Ready condition is only touched to mark a new generation as not ready yet
+func UpdateComponentStatus(ctx context.Context, c client.Client, object runtime.Object, conditionType string, status types.ReconcileStatus, message string) error {
+	if obj, ok := object.(ObjectWithConditions); ok {
+		if conditionType != "" {
+			obj.SetCondition(NewCondition(conditionType, obj.GetGeneration(), status, message))
+		}
+
+		if ready := obj.GetCondition(v1alpha1.ConditionTypeReady); ready == nil || ready.GetObservedGeneration() != obj.GetGeneration() {
+			obj.SetCondition(NewCondition(v1alpha1.ConditionTypeReady, obj.GetGeneration(), types.ReconcileStatusReconciling, ""))
+		}
+	}
+
+	return patchStatus(ctx, c, object, status, message)
+}
+
+func patchStatus(ctx context.Context, c client.Client, object runtime.Object, status types.ReconcileStatus, message string) error {
 	tmpObject := object.DeepCopyObject()
 	if imgw, ok := tmpObject.(ObjectWithStatus); ok {
 		current := &unstructured.Unstructured{}
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/types"
+)
+
+type conditionSummary struct {
+	Type               string
+	Status             string
+	Reason             string
+	ObservedGeneration int64
+}
+
+func summarize(conditions []v1alpha1.Condition) []conditionSummary {
+	summary := make([]conditionSummary, 0, len(conditions))
+	for _, condition := range conditions {
+		summary = append(summary, conditionSummary{
+			Type:               condition.Type,
+			Status:             condition.Status.String(),
+			Reason:             condition.Reason,
+			ObservedGeneration: condition.ObservedGeneration,
+		})
+	}
+
+	return summary
+}
+
+func TestUpdateStatusConditions(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	icp := &v1alpha1.IstioControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "IstioControlPlane",
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "icp-v112x",
+			Namespace:  "istio-system",
+			Generation: 2,
+		},
+		Spec: &v1alpha1.IstioControlPlaneSpec{},
+		Status: v1alpha1.IstioControlPlaneStatus{
+			ObservedGeneration: 1,
+			Conditions: []v1alpha1.Condition{
+				components.NewCondition(v1alpha1.ConditionTypeReady, 1, types.ReconcileStatusAvailable, ""),
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(icp.DeepCopy()).Build()
+	ctx := context.Background()
+
+	// a new generation is not ready until it is fully reconciled
+	if err := components.UpdateComponentStatus(ctx, c, icp, v1alpha1.ConditionTypeDiscoveryReady, types.ReconcileStatusAvailable, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := components.UpdateComponentStatus(ctx, c, icp, v1alpha1.ConditionTypeCNIReady, types.ReconcileStatusFailed, "cni failed"); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := &v1alpha1.IstioControlPlane{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(icp), stored); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []conditionSummary{
+		{Type: v1alpha1.ConditionTypeReady, Status: "False", Reason: v1alpha1.ConditionReasonReconciling, ObservedGeneration: 2},
+		{Type: v1alpha1.ConditionTypeDiscoveryReady, Status: "True", Reason: v1alpha1.ConditionReasonReconciled, ObservedGeneration: 2},
+		{Type: v1alpha1.ConditionTypeCNIReady, Status: "False", Reason: v1alpha1.ConditionReasonReconcileFailed, ObservedGeneration: 2},
+	}
+	if diff := pretty.Compare(summarize(stored.Status.Conditions), expected); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if diff := pretty.Compare(stored.Status.ObservedGeneration, int64(1)); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	if err := components.UpdateStatus(ctx, c, icp, types.ReconcileStatusAvailable, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(icp), stored); err != nil {
+		t.Fatal(err)
+	}
+
+	ready := stored.GetCondition(v1alpha1.ConditionTypeReady)
+	if diff := pretty.Compare(summarize([]v1alpha1.Condition{*ready}), []conditionSummary{
+		{Type: v1alpha1.ConditionTypeReady, Status: "True", Reason: v1alpha1.ConditionReasonReconciled, ObservedGeneration: 2},
+	}); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if diff := pretty.Compare(stored.Status.ObservedGeneration, int64(2)); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+}
+
+func TestSetConditionKeepsTransitionTime(t *testing.T) {
+	t.Parallel()
+
+	conditions := []v1alpha1.Condition{}
+	v1alpha1.SetCondition(&conditions, components.NewCondition(v1alpha1.ConditionTypeReady, 1, types.ReconcileStatusReconciling, ""))
+	transitionTime := conditions[0].LastTransitionTime
+
+	v1alpha1.SetCondition(&conditions, components.NewCondition(v1alpha1.ConditionTypeReady, 2, types.ReconcileStatusFailed, "failed"))
+	if conditions[0].LastTransitionTime != transitionTime {
+		t.Error("transition time must not change when the status of the condition is the same")
+	}
+	if diff := pretty.Compare(conditions[0].Message, "failed"); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	v1alpha1.SetCondition(&conditions, components.NewCondition(v1alpha1.ConditionTypeReady, 2, types.ReconcileStatusAvailable, ""))
+	if diff := pretty.Compare(len(conditions), 1); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if conditions[0].Status != v1alpha1.ConditionStatus_True {
+		t.Errorf("unexpected status: %s", conditions[0].Status)
+	}
+}
+
+type minimalComponent struct {
+	name string
+}
+
+func (c minimalComponent) Name() string {
+	return c.name
+}
+
+func (c minimalComponent) Enabled(runtime.Object) bool {
+	return true
+}
+
+func (c minimalComponent) ReleaseData(runtime.Object) (*templatereconciler.ReleaseData, error) {
+	return nil, nil
+}
+
+func TestUnmanagedComponentsAreSkipped(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+
+	for _, tc := range []struct {
+		name       string
+		annotation string
+		deleted    bool
+		skipped    map[string]bool
+	}{
+		{
+			name:    "no annotation",
+			skipped: map[string]bool{"istio-discovery": false, "istio-cni": false},
+		},
+		{
+			name:       "every component",
+			annotation: "true",
+			skipped:    map[string]bool{"istio-discovery": true, "istio-cni": true},
+		},
+		{
+			name:       "listed components",
+			annotation: "istio-discovery, istio-sidecar-injector",
+			skipped:    map[string]bool{"istio-discovery": true, "istio-sidecar-injector": true, "istio-cni": false},
+		},
+		{
+			name:       "object is being deleted",
+			annotation: "true",
+			deleted:    true,
+			skipped:    map[string]bool{"istio-discovery": false},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			icp := &v1alpha1.IstioControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cp-v112x",
+					Namespace:   "istio-system",
+					Annotations: map[string]string{},
+				},
+			}
+			if tc.annotation != "" {
+				icp.Annotations[v1alpha1.UnmanagedAnnotation] = tc.annotation
+			}
+			if tc.deleted {
+				icp.DeletionTimestamp = &now
+			}
+
+			skipped := map[string]bool{}
+			for name := range tc.skipped {
+				skipped[name] = (&components.Base{Component: minimalComponent{name: name}}).Skipped(icp)
+			}
+
+			if diff := pretty.Compare(skipped, tc.skipped); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
@@ -155,7 +155,12 @@ func TestICPDiscoveryValuesTemplateTransform(t *testing.T) {
 		},
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(obj, assets.DiscoveryChart, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(obj, bundle.DiscoveryChart, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
@@ -260,7 +265,12 @@ func TestPassiveICPDiscoveryValuesTemplateTransform(t *testing.T) {
 		},
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(obj, assets.DiscoveryChart, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(obj, bundle.DiscoveryChart, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
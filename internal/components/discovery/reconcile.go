@@ -67,6 +67,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) ConditionType() string {
+	return v1alpha1.ConditionTypeDiscoveryReady
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero()
@@ -81,6 +85,11 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	values, err := rec.values(object)
 	if err != nil {
 		return nil, errors.WithStackIf(err)
@@ -92,7 +101,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 	}
 
 	return &templatereconciler.ReleaseData{
-		Chart:       http.FS(assets.DiscoveryChart),
+		Chart:       http.FS(bundle.DiscoveryChart),
 		Values:      values,
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
@@ -179,12 +188,17 @@ func (rec *Component) values(object runtime.Object) (helm.Strimap, error) {
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	obj := &v1alpha1.IstioControlPlaneWithProperties{
 		IstioControlPlane: icp,
 		Properties:        rec.properties,
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(obj, assets.DiscoveryChart, valuesTemplateFileName)
+	values, err := util.TransformStructToStriMapWithTemplate(obj, bundle.DiscoveryChart, valuesTemplateFileName)
 	if err != nil {
 		return nil, errors.WrapIff(err, "IstioControlPlane spec cannot be converted into a map[string]interface{}: %+v", icp.Spec)
 	}
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"emperror.dev/errors"
+	"emperror.dev/errors/utils/keyval"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/resources"
+)
+
+// inventories holds the inventories of the components being reconciled by the object they are reconciled for,
+// the components of an object are reconciled one after the other
+var inventories sync.Map
+
+// RecordApply is a modifier of the native reconciler which marks the objects the reconciliation gets to apply
+// in the inventory of the component, the ones failing to be applied are marked by the errors of the reconciliation
+func RecordApply(object, parent runtime.Object) (runtime.Object, error) {
+	if i, ok := inventories.Load(parent); ok {
+		if err := i.(*inventory).applied(object); err != nil {
+			return nil, err
+		}
+	}
+
+	return object, nil
+}
+
+// inventory collects the objects rendered by a component during a reconciliation
+// together with the result of applying them
+type inventory struct {
+	scheme    *runtime.Scheme
+	resources []v1alpha1.ResourceStatus
+	err       error
+}
+
+func newInventory(scheme *runtime.Scheme) *inventory {
+	return &inventory{
+		scheme:    scheme,
+		resources: []v1alpha1.ResourceStatus{},
+	}
+}
+
+// instrument returns a copy of the release data which records every object once its desired state is built,
+// the layers are turned into modifiers to have the recorded state include the overlays as well
+func (i *inventory) instrument(releaseData *templatereconciler.ReleaseData) (*templatereconciler.ReleaseData, error) {
+	if releaseData == nil {
+		return nil, nil
+	}
+
+	instrumented := *releaseData
+
+	parser := resources.NewObjectParser(i.scheme)
+	modifiers := make([]resources.ObjectModifierFunc, 0, len(releaseData.Modifiers)+len(releaseData.Layers)+1)
+	modifiers = append(modifiers, releaseData.Modifiers...)
+	for _, layer := range releaseData.Layers {
+		modifier, err := resources.PatchYAMLModifier(layer, parser)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to create modifier from layer")
+		}
+		modifiers = append(modifiers, modifier)
+	}
+
+	instrumented.Modifiers = append(modifiers, i.record)
+	instrumented.Layers = nil
+
+	return &instrumented, nil
+}
+
+// record records the desired state of an object once it is built, it is only marked as applied
+// when the reconciliation gets to apply it
+func (i *inventory) record(object runtime.Object) (runtime.Object, error) {
+	resource, err := i.resourceStatus(object)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not marshal object")
+	}
+	resource.Hash = fmt.Sprintf("%x", sha256.Sum256(desired))
+	resource.LastApplyResult = v1alpha1.ApplyResult_NotApplied
+
+	if existing := i.find(resource.Kind, resource.Namespace, resource.Name); existing != nil {
+		*existing = resource
+	} else {
+		i.resources = append(i.resources, resource)
+	}
+
+	return object, nil
+}
+
+// applied marks the recorded object as applied
+func (i *inventory) applied(object runtime.Object) error {
+	resource, err := i.resourceStatus(object)
+	if err != nil {
+		return err
+	}
+
+	if existing := i.find(resource.Kind, resource.Namespace, resource.Name); existing != nil {
+		existing.LastApplyResult = v1alpha1.ApplyResult_Applied
+		existing.Message = ""
+	}
+
+	return nil
+}
+
+// resourceStatus returns the status of the object without its hash and apply result
+func (i *inventory) resourceStatus(object runtime.Object) (v1alpha1.ResourceStatus, error) {
+	objectMeta, err := meta.Accessor(object)
+	if err != nil {
+		return v1alpha1.ResourceStatus{}, errors.WrapIf(err, "could not access object metadata")
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		gvk, err = apiutil.GVKForObject(object, i.scheme)
+		if err != nil {
+			return v1alpha1.ResourceStatus{}, errors.WrapIf(err, "could not get GVK for object")
+		}
+	}
+
+	return v1alpha1.ResourceStatus{
+		ApiVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  objectMeta.GetNamespace(),
+		Name:       objectMeta.GetName(),
+	}, nil
+}
+
+// fail marks the objects referenced by the details of the reconcile errors as failed
+func (i *inventory) fail(err error) {
+	i.err = err
+
+	for _, e := range errors.GetErrors(err) {
+		details := keyval.ToMap(errors.GetDetails(e))
+
+		name, _ := details["name"].(string)
+		if name == "" {
+			continue
+		}
+		namespace, _ := details["namespace"].(string)
+		kind, _ := details["kind"].(string)
+
+		if resource := i.find(kind, namespace, name); resource != nil {
+			resource.LastApplyResult = v1alpha1.ApplyResult_ApplyFailed
+			resource.Message = e.Error()
+		}
+	}
+}
+
+func (i *inventory) find(kind, namespace, name string) *v1alpha1.ResourceStatus {
+	for j := range i.resources {
+		resource := &i.resources[j]
+		if resource.Name == name && resource.Namespace == namespace && (kind == "" || resource.Kind == kind) {
+			return resource
+		}
+	}
+
+	return nil
+}
+
+// componentStatus returns the status of the component including the recorded objects
+func (i *inventory) componentStatus(name string, status v1alpha1.ConfigState) v1alpha1.ComponentStatus {
+	resources := make([]v1alpha1.ResourceStatus, len(i.resources))
+	copy(resources, i.resources)
+
+	return v1alpha1.ComponentStatus{
+		Name:      name,
+		Status:    status,
+		Resources: resources,
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	inventory := newInventory(scheme)
+	parent := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "istio-system"}}
+	inventories.Store(parent, inventory)
+	defer inventories.Delete(parent)
+
+	objects := []runtime.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio", Namespace: "istio-system"},
+			Data:       map[string]string{"mesh": "{}"},
+		},
+		&corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"},
+		},
+	}
+	for _, object := range objects {
+		if _, err := inventory.record(object); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the reconciliation fails to apply the service account and does not get to the service
+	for _, object := range objects[:2] {
+		if _, err := RecordApply(object, parent); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// objects of other reconciliations are not recorded
+	if _, err := RecordApply(objects[2], &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "istio-system"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	inventory.fail(errors.Combine(
+		errors.WithDetails(errors.NewPlain("forbidden"), "name", "istiod", "namespace", "istio-system", "apiVersion", "v1", "kind", "ServiceAccount"),
+		errors.NewPlain("failed to purge"),
+	))
+
+	status := inventory.componentStatus("discovery", v1alpha1.ConfigState_ReconcileFailed)
+	for i := range status.Resources {
+		if len(status.Resources[i].Hash) != 64 {
+			t.Errorf("invalid hash: %s", status.Resources[i].Hash)
+		}
+		status.Resources[i].Hash = ""
+	}
+
+	expected := v1alpha1.ComponentStatus{
+		Name:   "discovery",
+		Status: v1alpha1.ConfigState_ReconcileFailed,
+		Resources: []v1alpha1.ResourceStatus{
+			{
+				ApiVersion:      "v1",
+				Kind:            "ConfigMap",
+				Namespace:       "istio-system",
+				Name:            "istio",
+				LastApplyResult: v1alpha1.ApplyResult_Applied,
+			},
+			{
+				ApiVersion:      "v1",
+				Kind:            "ServiceAccount",
+				Namespace:       "istio-system",
+				Name:            "istiod",
+				LastApplyResult: v1alpha1.ApplyResult_ApplyFailed,
+				Message:         "forbidden",
+			},
+			{
+				ApiVersion:      "v1",
+				Kind:            "Service",
+				Namespace:       "istio-system",
+				Name:            "istiod",
+				LastApplyResult: v1alpha1.ApplyResult_NotApplied,
+			},
+		},
+	}
+
+	if diff := pretty.Compare(status, expected); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+}
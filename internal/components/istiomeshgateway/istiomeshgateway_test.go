@@ -50,11 +50,29 @@ var imgwExpectedValues []byte
 //go:embed testdata/imgw-expected-resource-dump.yaml
 var imgwExpectedResourceDump []byte
 
+//go:embed testdata/imgw-egress-test-cr.yaml
+var imgwEgressTestCR []byte
+
+//go:embed testdata/imgw-egress-expected-resource-dump.yaml
+var imgwEgressExpectedResourceDump []byte
+
 func TestIMGWResourceDump(t *testing.T) {
 	t.Parallel()
 
+	testIMGWResourceDump(t, imgwTestCR, imgwExpectedResourceDump)
+}
+
+func TestIMGWEgressResourceDump(t *testing.T) {
+	t.Parallel()
+
+	testIMGWResourceDump(t, imgwEgressTestCR, imgwEgressExpectedResourceDump)
+}
+
+func testIMGWResourceDump(t *testing.T, cr []byte, expectedResourceDump []byte) {
+	t.Helper()
+
 	var imgw *v1alpha1.IstioMeshGateway
-	if err := yaml.Unmarshal(imgwTestCR, &imgw); err != nil {
+	if err := yaml.Unmarshal(cr, &imgw); err != nil {
 		t.Fatal(err)
 	}
 
@@ -84,7 +102,7 @@ func TestIMGWResourceDump(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	report, err := util.CompareYAMLs(imgwExpectedResourceDump, dd)
+	report, err := util.CompareYAMLs(expectedResourceDump, dd)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -133,7 +151,12 @@ func TestIMGWTemplateTransform(t *testing.T) {
 	}
 	obj.SetDefaults()
 
-	values, err := util.TransformStructToStriMapWithTemplate(obj, assets.IstioMeshGateway, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(obj, bundle.IstioMeshGateway, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
@@ -74,6 +74,11 @@ func (rec *Component) Enabled(object runtime.Object) bool {
 
 func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.ReleaseData, error) {
 	if imgw, ok := object.(*v1alpha1.IstioMeshGateway); ok {
+		bundle, err := rec.chartBundle()
+		if err != nil {
+			return nil, err
+		}
+
 		values, err := rec.values(object)
 		if err != nil {
 			return nil, err
@@ -85,7 +90,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		}
 
 		return &templatereconciler.ReleaseData{
-			Chart:       http.FS(assets.IstioMeshGateway),
+			Chart:       http.FS(bundle.IstioMeshGateway),
 			Values:      values,
 			Namespace:   imgw.Namespace,
 			ChartName:   chartName,
@@ -125,16 +130,32 @@ func (rec *Component) values(object runtime.Object) (helm.Strimap, error) {
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to a IstioMeshGateway"), "%+v", object)
 	}
 
+	bundle, err := rec.chartBundle()
+	if err != nil {
+		return nil, err
+	}
+
 	obj := &v1alpha1.IstioMeshGatewayWithProperties{
 		IstioMeshGateway: imgw,
 		Properties:       rec.properties,
 	}
 	obj.SetDefaults()
 
-	values, err := util.TransformStructToStriMapWithTemplate(obj, assets.IstioMeshGateway, valuesTemplateFileName)
+	values, err := util.TransformStructToStriMapWithTemplate(obj, bundle.IstioMeshGateway, valuesTemplateFileName)
 	if err != nil {
 		return nil, errors.WrapIff(err, "IstioMeshGateway cannot be converted into a map[string]interface{}")
 	}
 
 	return values, nil
 }
+
+// chartBundle returns the chart bundle of the related Istio control plane, so that gateways are rendered
+// with the same Istio version as their control plane
+func (rec *Component) chartBundle() (*assets.ChartBundle, error) {
+	bundle, err := assets.GetChartBundle(rec.properties.GetIstioControlPlane().GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	return bundle, nil
+}
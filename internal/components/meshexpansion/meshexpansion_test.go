@@ -94,7 +94,12 @@ func TestICPMeshExpansionValuesTemplateTransform(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.MeshExpansionChart, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.MeshExpansionChart, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
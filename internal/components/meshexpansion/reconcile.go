@@ -54,6 +54,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) ConditionType() string {
+	return v1alpha1.ConditionTypeMeshExpansionReady
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero() && utils.PointerToBool(controlPlane.GetSpec().GetMeshExpansion().GetEnabled())
@@ -68,6 +72,11 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	values, err := rec.values(object)
 	if err != nil {
 		return nil, errors.WithStackIf(err)
@@ -79,7 +88,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 	}
 
 	return &templatereconciler.ReleaseData{
-		Chart:       http.FS(assets.MeshExpansionChart),
+		Chart:       http.FS(bundle.MeshExpansionChart),
 		Values:      values,
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
@@ -94,7 +103,12 @@ func (rec *Component) values(object runtime.Object) (helm.Strimap, error) {
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.MeshExpansionChart, valuesTemplateFileName)
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.MeshExpansionChart, valuesTemplateFileName)
 	if err != nil {
 		return nil, errors.WrapIff(err, "IstioControlPlane spec cannot be converted into a map[string]interface{}: %+v", icp.Spec)
 	}
@@ -0,0 +1,62 @@
+{{- define "nodeProxy" }}
+{{- with .GetSpec.GetNodeProxy }}
+
+{{- if .GetLogLevel }}
+logLevel: {{ .GetLogLevel | toString | lower }}
+{{- end }}
+{{ toYamlIf (dict "value" .GetExcludeNamespaces "key" "excludeNamespaces") }}
+{{ with .GetDaemonset }}
+{{ valueIf (dict "key" "image" "value" .GetImage ) }}
+{{ toYamlIf (dict "value" .GetMetadata "key" "metadata") }}
+{{ toYamlIf (dict "value" .GetPodMetadata "key" "podMetadata") }}
+{{ toYamlIf (dict "value" .GetDeploymentStrategy "key" "deploymentStrategy") }}
+{{ toYamlIf (dict "value" .GetEnv "key" "env") }}
+{{ toYamlIf (dict "value" .GetNodeSelector "key" "nodeSelector") }}
+{{ toYamlIf (dict "value" .GetAffinity "key" "affinity") }}
+{{ toYamlIf (dict "value" .GetTolerations "key" "tolerations") }}
+{{ toYamlIf (dict "value" .GetVolumes "key" "volumes") }}
+{{ toYamlIf (dict "value" .GetVolumeMounts "key" "volumeMounts") }}
+{{ toYamlIf (dict "value" .GetResources "key" "resources") }}
+{{ toYamlIf (dict "value" .GetSecurityContext "key" "securityContext") }}
+{{ valueIf (dict "key" "priorityClassName" "value" .GetPriorityClassName ) }}
+{{ end }}
+
+{{- end }}
+{{- end }}
+
+{{- define "global" }}
+{{ valueIf (dict "key" "hub" "value" .GetSpec.GetContainerImageConfiguration.GetHub) }}
+{{ valueIf (dict "key" "tag" "value" .GetSpec.GetContainerImageConfiguration.GetTag) }}
+{{- with .GetSpec.GetNodeProxy }}
+{{ valueIf (dict "key" "imagePullPolicy" "value" (default $.GetSpec.GetContainerImageConfiguration.GetImagePullPolicy .GetDaemonset.GetImagePullPolicy) ) }}
+{{ toYamlIf (dict "value" (default $.GetSpec.GetContainerImageConfiguration.GetImagePullSecrets .GetDaemonset.GetImagePullSecrets) "key" "imagePullSecrets") }}
+{{ end }}
+{{ valueIf (dict "key" "caAddress" "value" .GetSpec.GetCaAddress) }}
+{{- if .GetSpec.GetJwtPolicy }}
+jwtPolicy: {{ .GetSpec.GetJwtPolicy | toString | lower | replace "_" "-" }}
+{{- end }}
+{{- if .GetSpec.GetSds.GetTokenAudience }}
+sds:
+  token:
+    aud: {{ .GetSpec.GetSds.GetTokenAudience }}
+{{- end }}
+{{ if .GetSpec.GetClusterID }}
+multiCluster:
+{{ valueIf (dict "key" "clusterName" "value" .GetSpec.GetClusterID) | indent 2 }}
+{{ end }}
+{{ valueIf (dict "key" "network" "value" .GetSpec.GetNetworkName) }}
+{{ end }}
+
+{{- $x := (include "nodeProxy" .) | reformatYaml }}
+{{- if ne $x "" }}
+nodeProxy:
+{{ $x | indent 2 }}
+{{- end }}
+
+{{- $x := (include "global" .) | reformatYaml }}
+{{- if ne $x "" }}
+global:
+{{ $x | indent 2 }}
+{{- end }}
+
+{{ valueIf (dict "key" "revision" "value" .Name) }}
@@ -0,0 +1,357 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/homeport/dyff/pkg/dyff"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/util"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+)
+
+type ChangeAction string
+
+const (
+	ChangeActionCreate   ChangeAction = "create"
+	ChangeActionUpdate   ChangeAction = "update"
+	ChangeActionRecreate ChangeAction = "recreate"
+	ChangeActionDelete   ChangeAction = "delete"
+)
+
+const redactedValue = "<redacted>"
+
+var secretGroupKind = schema.GroupKind{Kind: "Secret"}
+
+// Change is a change of a single object the reconciliation would make, the current and the planned
+// states are normalized YAML documents
+type Change struct {
+	Action  ChangeAction
+	GVK     schema.GroupVersionKind
+	Key     client.ObjectKey
+	Current []byte
+	Planned []byte
+
+	// desired is the normalized object the reconciler wanted to write, unlike the planned state
+	// it does not contain values generated by the API server, so it is stable across reconciliations
+	desired []byte
+}
+
+func (c Change) String() string {
+	name := c.Key.Name
+	if c.Key.Namespace != "" {
+		name = c.Key.Namespace + "/" + name
+	}
+
+	return fmt.Sprintf("%s %s %s", c.Action, strings.ToLower(c.GVK.GroupKind().String()), name)
+}
+
+// Planner is a client which reads the live objects from the cluster, but only records the changes
+// the reconcilers would make instead of applying them. The changes are sent to the API server in dry-run mode
+// to have the planned states include the defaults, and the reconcilers compute the patches against the live objects
+// exactly as they would during an actual reconciliation.
+type Planner struct {
+	client.Client
+
+	changes []Change
+}
+
+func NewPlanner(c client.Client) *Planner {
+	return &Planner{
+		Client:  c,
+		changes: []Change{},
+	}
+}
+
+func (p *Planner) GetClient() client.Client {
+	return p
+}
+
+func (p *Planner) GetScheme() *runtime.Scheme {
+	return p.Client.Scheme()
+}
+
+func (p *Planner) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	desired := obj.DeepCopyObject().(client.Object)
+
+	planned := obj.DeepCopyObject().(client.Object)
+	if err := p.Client.Create(ctx, planned, append(opts, client.DryRunAll)...); err != nil {
+		// the namespace or the type of the object might be created by an earlier change of the plan
+		if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) && !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+		planned = desired
+	}
+
+	return p.record(ChangeActionCreate, nil, desired, planned)
+}
+
+func (p *Planner) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	current, err := p.current(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	desired := obj.DeepCopyObject().(client.Object)
+
+	action := ChangeActionUpdate
+	planned := obj.DeepCopyObject().(client.Object)
+	if err := p.Client.Update(ctx, planned, append(opts, client.DryRunAll)...); err != nil {
+		// immutable fields are changed, the object gets recreated
+		if !k8serrors.IsInvalid(err) {
+			return err
+		}
+		action = ChangeActionRecreate
+		planned = desired
+	}
+
+	return p.record(action, current, desired, planned)
+}
+
+func (p *Planner) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	current, err := p.current(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	planned := obj.DeepCopyObject().(client.Object)
+	if err := p.Client.Patch(ctx, planned, patch, append(opts, client.DryRunAll)...); err != nil {
+		return err
+	}
+
+	return p.record(ChangeActionUpdate, current, planned, planned)
+}
+
+func (p *Planner) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return p.record(ChangeActionDelete, obj, nil, nil)
+}
+
+func (p *Planner) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return nil
+}
+
+func (p *Planner) Status() client.StatusWriter {
+	return noopStatusWriter{}
+}
+
+// Changes returns the recorded changes in the order the reconcilers would make them
+func (p *Planner) Changes() []Change {
+	return p.changes
+}
+
+func (p *Planner) current(ctx context.Context, obj client.Object) (client.Object, error) {
+	current := obj.DeepCopyObject().(client.Object)
+	if err := p.Client.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		return nil, errors.WrapIf(err, "could not get current state of the object")
+	}
+
+	return current, nil
+}
+
+func (p *Planner) record(action ChangeAction, current, desired, planned client.Object) error {
+	object := desired
+	if object == nil {
+		object = current
+	}
+
+	gvk := object.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		var err error
+		gvk, err = apiutil.GVKForObject(object, p.Client.Scheme())
+		if err != nil {
+			return errors.WrapIf(err, "could not get GVK for object")
+		}
+	}
+
+	change := Change{
+		Action: action,
+		GVK:    gvk,
+		Key:    client.ObjectKeyFromObject(object),
+	}
+
+	var err error
+	for _, s := range []struct {
+		object   client.Object
+		target   *[]byte
+		identity bool
+	}{
+		{current, &change.Current, false},
+		{desired, &change.desired, true},
+		{planned, &change.Planned, false},
+	} {
+		if s.object == nil {
+			continue
+		}
+		if *s.target, err = normalizeObject(s.object, gvk, s.identity); err != nil {
+			return err
+		}
+	}
+
+	// secrets might hold generated values like private keys which are different in every reconciliation,
+	// so the changes of secrets are identified by the state they are made from
+	if gvk.GroupKind() == secretGroupKind {
+		change.desired = append(change.desired, change.Current...)
+	}
+
+	// the patch might have only contained changes which are normalized away
+	if action == ChangeActionUpdate && bytes.Equal(change.Current, change.Planned) {
+		return nil
+	}
+
+	p.changes = append(p.changes, change)
+
+	return nil
+}
+
+// normalizeObject returns the object as a YAML document without the fields maintained by the API server.
+// The values of secrets are replaced with their digests, so plans do not reveal them. The values which are different
+// in every reconciliation are removed as well when the document is used as the identity of the object.
+func normalizeObject(object client.Object, gvk schema.GroupVersionKind, identity bool) ([]byte, error) {
+	j, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not marshal object")
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(j, &content); err != nil {
+		return nil, errors.WrapIf(err, "could not unmarshal object")
+	}
+
+	content["apiVersion"] = gvk.GroupVersion().String()
+	content["kind"] = gvk.Kind
+	delete(content, "status")
+
+	if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"resourceVersion", "uid", "generation", "creationTimestamp", "managedFields", "selfLink"} {
+			delete(metadata, field)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, patch.LastAppliedConfig)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+
+	if gvk.GroupKind() == secretGroupKind {
+		for _, field := range []string{"data", "stringData"} {
+			values, ok := content[field].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key, value := range values {
+				values[key] = redactedValue
+				if !identity {
+					values[key] = fmt.Sprintf("%s (sha256:%x)", redactedValue, sha256.Sum256([]byte(fmt.Sprint(value))))
+				}
+			}
+		}
+	}
+
+	if identity {
+		if spec, ok := content["spec"].(map[string]interface{}); ok {
+			if template, ok := spec["template"].(map[string]interface{}); ok {
+				if metadata, ok := template["metadata"].(map[string]interface{}); ok {
+					if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+						delete(annotations, k8sutil.RestartedAtAnnotation)
+					}
+				}
+			}
+		}
+	}
+
+	return yaml.Marshal(content)
+}
+
+// PlanID returns an identifier of the changes which only changes if the desired states change
+func PlanID(changes []Change) string {
+	if len(changes) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, change := range changes {
+		fmt.Fprintf(h, "%s\n%s\n", change.String(), change.desired)
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))[:16]
+}
+
+// PlanDiff returns a human readable summary and diff of the changes
+func PlanDiff(changes []Change) ([]byte, error) {
+	var out, current, planned bytes.Buffer
+
+	for _, change := range changes {
+		fmt.Fprintln(&out, change.String())
+
+		if change.Current != nil {
+			fmt.Fprintf(&current, "---\n%s", change.Current)
+		}
+		if change.Planned != nil {
+			fmt.Fprintf(&planned, "---\n%s", change.Planned)
+		}
+	}
+
+	if len(changes) == 0 {
+		return out.Bytes(), nil
+	}
+
+	report, err := util.CompareYAMLs(current.Bytes(), planned.Bytes())
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not compare current and planned states")
+	}
+
+	fmt.Fprintln(&out)
+	if err := (&dyff.HumanReport{
+		Report:       report,
+		OmitHeader:   true,
+		NoTableStyle: true,
+	}).WriteReport(&out); err != nil {
+		return nil, errors.WrapIf(err, "could not write diff report")
+	}
+
+	if err := util.DyffReportMultilineDiffOutput(report, &out); err != nil {
+		return nil, errors.WrapIf(err, "could not write multiline diffs")
+	}
+
+	return out.Bytes(), nil
+}
+
+type noopStatusWriter struct{}
+
+func (noopStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return nil
+}
+
+func (noopStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPlanner(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "istio", Namespace: "istio-system"},
+		Data:       map[string]string{"mesh": "defaultConfig: {}\n"},
+	}
+	obsolete := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "istio-reader", Namespace: "istio-system"},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing.DeepCopy(), obsolete.DeepCopy()).Build()
+
+	plan := func() []Change {
+		planner := NewPlanner(c)
+
+		updated := &corev1.ConfigMap{}
+		if err := planner.Get(ctx, client.ObjectKeyFromObject(existing), updated); err != nil {
+			t.Fatal(err)
+		}
+		updated.Data["mesh"] = "defaultConfig:\n  holdApplicationUntilProxyStarts: true\n"
+		if err := planner.Update(ctx, updated); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := planner.Create(ctx, &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := planner.Delete(ctx, obsolete.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+
+		return planner.Changes()
+	}
+
+	changes := plan()
+
+	actions := []string{}
+	for _, change := range changes {
+		actions = append(actions, change.String())
+	}
+	if diff := pretty.Compare(actions, []string{
+		"update configmap istio-system/istio",
+		"create serviceaccount istio-system/istiod",
+		"delete serviceaccount istio-system/istio-reader",
+	}); diff != "" {
+		t.Fatalf("unexpected changes (-got +want):\n%s", diff)
+	}
+
+	// nothing should have been changed
+	current := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(existing), current); err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(current.Data, existing.Data); diff != "" {
+		t.Fatalf("object was changed by the planner (-got +want):\n%s", diff)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: "istiod", Namespace: "istio-system"}, &corev1.ServiceAccount{}); err == nil {
+		t.Fatal("object was created by the planner")
+	}
+
+	id := PlanID(changes)
+	if id == "" {
+		t.Fatal("plan has no ID")
+	}
+	if again := PlanID(plan()); again != id {
+		t.Fatalf("plan ID is not stable: %s != %s", again, id)
+	}
+	if PlanID(nil) != "" {
+		t.Fatal("empty plan should not have an ID")
+	}
+
+	diff, err := PlanDiff(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range append(actions, "holdApplicationUntilProxyStarts: true") {
+		if !strings.Contains(string(diff), s) {
+			t.Fatalf("diff does not contain %q:\n%s", s, diff)
+		}
+	}
+}
+
+func TestPlannerRedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cacerts", Namespace: "istio-system"},
+		Data:       map[string][]byte{"ca-key.pem": []byte("current-key")},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing.DeepCopy()).Build()
+
+	// the key is generated in every reconciliation
+	plan := func(key string) []Change {
+		planner := NewPlanner(c)
+
+		updated := &corev1.Secret{}
+		if err := planner.Get(ctx, client.ObjectKeyFromObject(existing), updated); err != nil {
+			t.Fatal(err)
+		}
+		updated.Data["ca-key.pem"] = []byte(key)
+		if err := planner.Update(ctx, updated); err != nil {
+			t.Fatal(err)
+		}
+
+		return planner.Changes()
+	}
+
+	changes := plan("generated-key-1")
+	if len(changes) != 1 {
+		t.Fatalf("unexpected number of changes: %d", len(changes))
+	}
+
+	diff, err := PlanDiff(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{"current-key", "generated-key-1"} {
+		if strings.Contains(string(diff), value) {
+			t.Fatalf("diff contains the value of the secret %q:\n%s", value, diff)
+		}
+	}
+	if !strings.Contains(string(diff), redactedValue) {
+		t.Fatalf("diff does not contain the redacted value:\n%s", diff)
+	}
+
+	if id, again := PlanID(changes), PlanID(plan("generated-key-2")); id != again {
+		t.Fatalf("plan ID is not stable: %s != %s", again, id)
+	}
+
+	if len(plan("current-key")) != 0 {
+		t.Fatal("unchanged secret should not be planned")
+	}
+}
@@ -57,6 +57,10 @@ func (rec *Component) Name() string {
 	return componentName
 }
 
+func (rec *Component) ConditionType() string {
+	return v1alpha1.ConditionTypeResourceSyncRulesReady
+}
+
 func (rec *Component) Enabled(object runtime.Object) bool {
 	if controlPlane, ok := object.(*v1alpha1.IstioControlPlane); ok {
 		return controlPlane.DeletionTimestamp.IsZero() && rec.resourceSyncRulesEnabled
@@ -71,6 +75,11 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	values, err := rec.values(object)
 	if err != nil {
 		return nil, errors.WithStackIf(err)
@@ -82,7 +91,7 @@ func (rec *Component) ReleaseData(object runtime.Object) (*templatereconciler.Re
 	}
 
 	return &templatereconciler.ReleaseData{
-		Chart:       http.FS(assets.ResourceSyncRule),
+		Chart:       http.FS(bundle.ResourceSyncRule),
 		Values:      values,
 		Namespace:   icp.Namespace,
 		ChartName:   chartName,
@@ -97,7 +106,12 @@ func (rec *Component) values(object runtime.Object) (helm.Strimap, error) {
 		return nil, errors.WrapIff(errors.NewPlain("object cannot be converted to an IstioControlPlane"), "%+v", object)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.ResourceSyncRule, valuesTemplateFileName)
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.ResourceSyncRule, valuesTemplateFileName)
 	if err != nil {
 		return nil, errors.WrapIff(err, "IstioControlPlane spec cannot be converted into a map[string]interface{}: %+v", icp.Spec)
 	}
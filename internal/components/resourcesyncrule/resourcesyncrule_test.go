@@ -119,7 +119,12 @@ func testResourceSyncRuleValuesTemplateTransform(t *testing.T, icpTestCR, rsrExp
 		t.Fatal(err)
 	}
 
-	values, err := util.TransformStructToStriMapWithTemplate(icp, assets.ResourceSyncRule, "values.yaml.tpl")
+	bundle, err := assets.GetChartBundle(icp.GetSpec().GetVersion())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := util.TransformStructToStriMapWithTemplate(icp, bundle.ResourceSyncRule, "values.yaml.tpl")
 	if err != nil {
 		kv := keyval.ToMap(errors.GetDetails(err))
 		if t, ok := kv["template"]; ok {
@@ -0,0 +1,295 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayapi
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// ControllerName is the controller name of the gateway classes which are handled by the operator
+const ControllerName gatewayapiv1alpha2.GatewayController = "servicemesh.cisco.com/istio-operator"
+
+const (
+	defaultServiceType = string(corev1.ServiceTypeLoadBalancer)
+
+	// the gateway proxies run as an unprivileged user, so privileged ports are mapped to this offset on the pods
+	privilegedPortOffset = 8000
+	maxPrivilegedPort    = 1023
+
+	listenerNotProgrammedMessage = "routes are not programmed by the operator, the traffic of the listener has to be routed with Istio Gateway and VirtualService resources"
+)
+
+// IsManaged reports whether the gateway class is handled by the operator
+func IsManaged(gc *gatewayapiv1alpha2.GatewayClass) bool {
+	return gc != nil && gc.Spec.ControllerName == ControllerName
+}
+
+// ControlPlaneOf returns the key of the Istio control plane the gateway class refers to with its parameters
+func ControlPlaneOf(gc *gatewayapiv1alpha2.GatewayClass) (client.ObjectKey, error) {
+	ref := gc.Spec.ParametersRef
+	if ref == nil {
+		return client.ObjectKey{}, errors.NewPlain("parametersRef must refer to an IstioControlPlane")
+	}
+
+	if string(ref.Group) != servicemeshv1alpha1.SchemeBuilder.GroupVersion.Group || string(ref.Kind) != "IstioControlPlane" {
+		return client.ObjectKey{}, errors.Errorf("parametersRef must refer to an IstioControlPlane, got %s/%s", ref.Group, ref.Kind)
+	}
+
+	if ref.Namespace == nil || *ref.Namespace == "" {
+		return client.ObjectKey{}, errors.NewPlain("namespace of the IstioControlPlane must be set in parametersRef")
+	}
+
+	return client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: string(*ref.Namespace),
+	}, nil
+}
+
+// SetMeshGatewaySpec sets the fields of the mesh gateway spec which are derived from the gateway,
+// other fields like the deployment settings are kept so the generated mesh gateway can be tuned as usual
+func SetMeshGatewaySpec(imgw *servicemeshv1alpha1.IstioMeshGateway, gw *gatewayapiv1alpha2.Gateway, icp client.ObjectKey) {
+	if imgw.Spec == nil {
+		imgw.Spec = &servicemeshv1alpha1.IstioMeshGatewaySpec{}
+	}
+
+	if imgw.Spec.Service == nil {
+		imgw.Spec.Service = &servicemeshv1alpha1.Service{}
+	}
+
+	imgw.Spec.Type = servicemeshv1alpha1.GatewayType_ingress
+	imgw.Spec.IstioControlPlane = &servicemeshv1alpha1.NamespacedName{
+		Name:      icp.Name,
+		Namespace: icp.Namespace,
+	}
+
+	if imgw.Spec.Service.Type == "" {
+		imgw.Spec.Service.Type = defaultServiceType
+	}
+
+	imgw.Spec.Service.Ports = servicePorts(gw)
+}
+
+// HasSupportedListeners reports whether the gateway has any listeners which can be served by a mesh gateway
+func HasSupportedListeners(gw *gatewayapiv1alpha2.Gateway) bool {
+	return len(servicePorts(gw)) > 0
+}
+
+// servicePorts returns the service ports of the supported listeners of the gateway,
+// listeners which only differ in their hostnames share the same port.
+// Privileged ports are mapped to unprivileged target ports since the gateway proxies cannot bind them.
+func servicePorts(gw *gatewayapiv1alpha2.Gateway) []servicemeshv1alpha1.ServicePort {
+	ports := make([]servicemeshv1alpha1.ServicePort, 0, len(gw.Spec.Listeners))
+	seen := make(map[int32]bool)
+	for _, listener := range gw.Spec.Listeners {
+		if len(supportedKinds(listener.Protocol)) == 0 {
+			continue
+		}
+
+		port := int32(listener.Port)
+		if seen[port] {
+			continue
+		}
+		seen[port] = true
+
+		servicePort := servicemeshv1alpha1.ServicePort{
+			Name:     fmt.Sprintf("%s-%d", strings.ToLower(string(listener.Protocol)), port),
+			Protocol: string(corev1.ProtocolTCP),
+			Port:     port,
+		}
+		if port <= maxPrivilegedPort {
+			targetPort := servicemeshv1alpha1.FromInt(int(port) + privilegedPortOffset)
+			servicePort.TargetPort = &targetPort
+		}
+
+		ports = append(ports, servicePort)
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i].Port < ports[j].Port
+	})
+
+	return ports
+}
+
+// supportedKinds returns the route kinds which can be attached to a listener with the given protocol,
+// no kinds are returned for unsupported protocols
+func supportedKinds(protocol gatewayapiv1alpha2.ProtocolType) []gatewayapiv1alpha2.RouteGroupKind {
+	group := gatewayapiv1alpha2.Group(gatewayapiv1alpha2.GroupName)
+	kinds := func(names ...string) []gatewayapiv1alpha2.RouteGroupKind {
+		rgks := make([]gatewayapiv1alpha2.RouteGroupKind, 0, len(names))
+		for _, name := range names {
+			rgks = append(rgks, gatewayapiv1alpha2.RouteGroupKind{
+				Group: &group,
+				Kind:  gatewayapiv1alpha2.Kind(name),
+			})
+		}
+
+		return rgks
+	}
+
+	switch protocol {
+	case gatewayapiv1alpha2.HTTPProtocolType, gatewayapiv1alpha2.HTTPSProtocolType:
+		return kinds("HTTPRoute")
+	case gatewayapiv1alpha2.TLSProtocolType:
+		return kinds("TLSRoute", "TCPRoute")
+	case gatewayapiv1alpha2.TCPProtocolType:
+		return kinds("TCPRoute")
+	default:
+		return nil
+	}
+}
+
+// Addresses converts the address of a mesh gateway to gateway addresses
+func Addresses(addresses []string) []gatewayapiv1alpha2.GatewayAddress {
+	result := make([]gatewayapiv1alpha2.GatewayAddress, 0, len(addresses))
+	for _, address := range addresses {
+		addressType := gatewayapiv1alpha2.IPAddressType
+		if net.ParseIP(address) == nil {
+			addressType = gatewayapiv1alpha2.HostnameAddressType
+		}
+
+		result = append(result, gatewayapiv1alpha2.GatewayAddress{
+			Type:  &addressType,
+			Value: address,
+		})
+	}
+
+	return result
+}
+
+// SetGatewayStatus updates the status of the gateway from the status of its mesh gateway.
+// The operator only deploys the mesh gateway with the ports of the listeners, it does not program the routes
+// of the listeners, so they are never reported as ready.
+func SetGatewayStatus(gw *gatewayapiv1alpha2.Gateway, imgw *servicemeshv1alpha1.IstioMeshGateway) {
+	gw.Status.Addresses = Addresses(imgw.Status.GatewayAddress)
+
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gatewayapiv1alpha2.GatewayConditionScheduled),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gw.GetGeneration(),
+		Reason:             string(gatewayapiv1alpha2.GatewayReasonScheduled),
+		Message:            fmt.Sprintf("deployed by IstioMeshGateway %s", client.ObjectKeyFromObject(imgw)),
+	})
+
+	listeners := make([]gatewayapiv1alpha2.ListenerStatus, 0, len(gw.Spec.Listeners))
+	listenersValid := true
+	for _, listener := range gw.Spec.Listeners {
+		status := gatewayapiv1alpha2.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: supportedKinds(listener.Protocol),
+			Conditions:     currentListenerConditions(gw, listener.Name),
+		}
+
+		if len(status.SupportedKinds) == 0 {
+			listenersValid = false
+			status.SupportedKinds = []gatewayapiv1alpha2.RouteGroupKind{}
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:               string(gatewayapiv1alpha2.ListenerConditionDetached),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: gw.GetGeneration(),
+				Reason:             string(gatewayapiv1alpha2.ListenerReasonUnsupportedProtocol),
+				Message:            fmt.Sprintf("protocol %s is not supported", listener.Protocol),
+			})
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:               string(gatewayapiv1alpha2.ListenerConditionReady),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gw.GetGeneration(),
+				Reason:             string(gatewayapiv1alpha2.ListenerReasonInvalid),
+				Message:            fmt.Sprintf("protocol %s is not supported", listener.Protocol),
+			})
+		} else {
+			meta.RemoveStatusCondition(&status.Conditions, string(gatewayapiv1alpha2.ListenerConditionDetached))
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+				Type:               string(gatewayapiv1alpha2.ListenerConditionReady),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gw.GetGeneration(),
+				Reason:             string(gatewayapiv1alpha2.ListenerReasonPending),
+				Message:            listenerNotProgrammedMessage,
+			})
+		}
+
+		listeners = append(listeners, status)
+	}
+	gw.Status.Listeners = listeners
+
+	ready := metav1.Condition{
+		Type:               string(gatewayapiv1alpha2.GatewayConditionReady),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gw.GetGeneration(),
+		Reason:             string(gatewayapiv1alpha2.GatewayReasonListenersNotReady),
+		Message:            listenerNotProgrammedMessage,
+	}
+	switch {
+	case !listenersValid:
+		ready.Reason = string(gatewayapiv1alpha2.GatewayReasonListenersNotValid)
+		ready.Message = "some of the listeners use unsupported protocols"
+	case len(gw.Status.Addresses) == 0:
+		ready.Reason = string(gatewayapiv1alpha2.GatewayReasonAddressNotAssigned)
+		ready.Message = "address of the gateway is pending"
+	}
+	meta.SetStatusCondition(&gw.Status.Conditions, ready)
+}
+
+// SetGatewayNotScheduled marks the gateway as not scheduled for the given reason
+func SetGatewayNotScheduled(gw *gatewayapiv1alpha2.Gateway, reason gatewayapiv1alpha2.GatewayConditionReason, message string) {
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gatewayapiv1alpha2.GatewayConditionScheduled),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gw.GetGeneration(),
+		Reason:             string(reason),
+		Message:            message,
+	})
+}
+
+// SetGatewayClassAccepted sets the accepted condition of the gateway class according to the error of its validation
+func SetGatewayClassAccepted(gc *gatewayapiv1alpha2.GatewayClass, err error) {
+	condition := metav1.Condition{
+		Type:               string(gatewayapiv1alpha2.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gc.GetGeneration(),
+		Reason:             string(gatewayapiv1alpha2.GatewayClassReasonAccepted),
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(gatewayapiv1alpha2.GatewayClassReasonInvalidParameters)
+		condition.Message = err.Error()
+	}
+
+	meta.SetStatusCondition(&gc.Status.Conditions, condition)
+}
+
+func currentListenerConditions(gw *gatewayapiv1alpha2.Gateway, name gatewayapiv1alpha2.SectionName) []metav1.Condition {
+	for _, status := range gw.Status.Listeners {
+		if status.Name == name {
+			return status.Conditions
+		}
+	}
+
+	return []metav1.Condition{}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayapi
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestControlPlaneOf(t *testing.T) {
+	t.Parallel()
+
+	namespace := gatewayapiv1alpha2.Namespace("istio-system")
+
+	tests := map[string]struct {
+		ref     *gatewayapiv1alpha2.ParametersReference
+		want    client.ObjectKey
+		wantErr bool
+	}{
+		"valid reference": {
+			ref: &gatewayapiv1alpha2.ParametersReference{
+				Group:     "servicemesh.cisco.com",
+				Kind:      "IstioControlPlane",
+				Name:      "cp-v112x",
+				Namespace: &namespace,
+			},
+			want: client.ObjectKey{Name: "cp-v112x", Namespace: "istio-system"},
+		},
+		"missing reference": {
+			wantErr: true,
+		},
+		"other kind": {
+			ref: &gatewayapiv1alpha2.ParametersReference{
+				Group:     "",
+				Kind:      "ConfigMap",
+				Name:      "cp-v112x",
+				Namespace: &namespace,
+			},
+			wantErr: true,
+		},
+		"missing namespace": {
+			ref: &gatewayapiv1alpha2.ParametersReference{
+				Group: "servicemesh.cisco.com",
+				Kind:  "IstioControlPlane",
+				Name:  "cp-v112x",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gc := &gatewayapiv1alpha2.GatewayClass{
+				Spec: gatewayapiv1alpha2.GatewayClassSpec{
+					ControllerName: ControllerName,
+					ParametersRef:  test.ref,
+				},
+			}
+
+			got, err := ControlPlaneOf(gc)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSetMeshGatewaySpec(t *testing.T) {
+	t.Parallel()
+
+	gw := testGateway()
+	gw.Spec.Listeners = append(gw.Spec.Listeners, gatewayapiv1alpha2.Listener{Name: "tcp", Port: 9000, Protocol: gatewayapiv1alpha2.TCPProtocolType})
+
+	imgw := &v1alpha1.IstioMeshGateway{
+		Spec: &v1alpha1.IstioMeshGatewaySpec{
+			Deployment: &v1alpha1.BaseKubernetesResourceConfig{
+				PriorityClassName: "system-cluster-critical",
+			},
+			Service: &v1alpha1.Service{
+				Type: string(corev1.ServiceTypeNodePort),
+				Ports: []v1alpha1.ServicePort{
+					{Name: "tcp-stale", Port: 8080, Protocol: "TCP"},
+				},
+			},
+		},
+	}
+
+	SetMeshGatewaySpec(imgw, gw, client.ObjectKey{Name: "cp-v112x", Namespace: "istio-system"})
+
+	if imgw.Spec.GetType() != v1alpha1.GatewayType_ingress {
+		t.Errorf("unexpected gateway type: %s", imgw.Spec.GetType())
+	}
+	if ref := imgw.Spec.GetIstioControlPlane(); ref.GetName() != "cp-v112x" || ref.GetNamespace() != "istio-system" {
+		t.Errorf("unexpected control plane reference: %+v", ref)
+	}
+	if imgw.Spec.GetDeployment().GetPriorityClassName() != "system-cluster-critical" {
+		t.Error("deployment settings of the mesh gateway must be kept")
+	}
+	if imgw.Spec.GetService().GetType() != string(corev1.ServiceTypeNodePort) {
+		t.Error("service type of the mesh gateway must be kept")
+	}
+
+	ports := imgw.Spec.GetService().GetPorts()
+	http, https := v1alpha1.FromInt(8080), v1alpha1.FromInt(8443)
+	want := []v1alpha1.ServicePort{
+		{Name: "http-80", Port: 80, Protocol: "TCP", TargetPort: &http},
+		{Name: "https-443", Port: 443, Protocol: "TCP", TargetPort: &https},
+		{Name: "tcp-9000", Port: 9000, Protocol: "TCP"},
+	}
+	if len(ports) != len(want) {
+		t.Fatalf("got %d ports, want %d: %+v", len(ports), len(want), ports)
+	}
+	for i := range want {
+		if ports[i].Name != want[i].Name || ports[i].Port != want[i].Port || ports[i].Protocol != want[i].Protocol {
+			t.Errorf("got port %+v, want %+v", ports[i], want[i])
+		}
+		// the gateway proxies cannot bind privileged ports
+		if (ports[i].TargetPort == nil) != (want[i].TargetPort == nil) || ports[i].TargetPort != nil && ports[i].TargetPort.IntValue() != want[i].TargetPort.IntValue() {
+			t.Errorf("got target port %v of port %s, want %v", ports[i].TargetPort, ports[i].Name, want[i].TargetPort)
+		}
+	}
+
+	fresh := &v1alpha1.IstioMeshGateway{}
+	SetMeshGatewaySpec(fresh, gw, client.ObjectKey{Name: "cp-v112x", Namespace: "istio-system"})
+	if fresh.Spec.GetService().GetType() != string(corev1.ServiceTypeLoadBalancer) {
+		t.Errorf("unexpected default service type: %s", fresh.Spec.GetService().GetType())
+	}
+}
+
+func TestSetGatewayStatus(t *testing.T) {
+	t.Parallel()
+
+	gw := testGateway()
+	imgw := &v1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: gw.GetName(), Namespace: gw.GetNamespace()},
+	}
+
+	SetGatewayStatus(gw, imgw)
+
+	if !meta.IsStatusConditionTrue(gw.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionScheduled)) {
+		t.Error("gateway must be scheduled")
+	}
+	ready := meta.FindStatusCondition(gw.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionReady))
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != string(gatewayapiv1alpha2.GatewayReasonListenersNotValid) {
+		t.Errorf("unexpected ready condition: %+v", ready)
+	}
+	if len(gw.Status.Listeners) != 4 {
+		t.Fatalf("got %d listener statuses, want 4", len(gw.Status.Listeners))
+	}
+	if !meta.IsStatusConditionTrue(gw.Status.Listeners[3].Conditions, string(gatewayapiv1alpha2.ListenerConditionDetached)) {
+		t.Error("UDP listener must be detached")
+	}
+
+	gw.Spec.Listeners = gw.Spec.Listeners[:3]
+	imgw.Status.GatewayAddress = []string{"10.0.0.1", "ingress.example.com"}
+
+	SetGatewayStatus(gw, imgw)
+
+	// the routes of the listeners are not programmed by the operator
+	ready = meta.FindStatusCondition(gw.Status.Conditions, string(gatewayapiv1alpha2.GatewayConditionReady))
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != string(gatewayapiv1alpha2.GatewayReasonListenersNotReady) {
+		t.Errorf("unexpected ready condition: %+v", ready)
+	}
+	for _, listener := range gw.Status.Listeners {
+		ready := meta.FindStatusCondition(listener.Conditions, string(gatewayapiv1alpha2.ListenerConditionReady))
+		if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != string(gatewayapiv1alpha2.ListenerReasonPending) {
+			t.Errorf("unexpected ready condition of listener %s: %+v", listener.Name, ready)
+		}
+		if meta.FindStatusCondition(listener.Conditions, string(gatewayapiv1alpha2.ListenerConditionDetached)) != nil {
+			t.Errorf("listener %s must not be reported as attached", listener.Name)
+		}
+	}
+
+	if len(gw.Status.Addresses) != 2 {
+		t.Fatalf("got %d addresses, want 2", len(gw.Status.Addresses))
+	}
+	if *gw.Status.Addresses[0].Type != gatewayapiv1alpha2.IPAddressType || *gw.Status.Addresses[1].Type != gatewayapiv1alpha2.HostnameAddressType {
+		t.Errorf("unexpected address types: %s, %s", *gw.Status.Addresses[0].Type, *gw.Status.Addresses[1].Type)
+	}
+}
+
+func testGateway() *gatewayapiv1alpha2.Gateway {
+	hostname := gatewayapiv1alpha2.Hostname("bookinfo.example.com")
+
+	return &gatewayapiv1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "bookinfo",
+			Namespace:  "bookinfo",
+			Generation: 2,
+		},
+		Spec: gatewayapiv1alpha2.GatewaySpec{
+			GatewayClassName: "istio-operator",
+			Listeners: []gatewayapiv1alpha2.Listener{
+				{Name: "https", Port: 443, Protocol: gatewayapiv1alpha2.HTTPSProtocolType, Hostname: &hostname},
+				{Name: "http", Port: 80, Protocol: gatewayapiv1alpha2.HTTPProtocolType},
+				{Name: "http-bookinfo", Port: 80, Protocol: gatewayapiv1alpha2.HTTPProtocolType, Hostname: &hostname},
+				{Name: "dns", Port: 53, Protocol: gatewayapiv1alpha2.UDPProtocolType},
+			},
+		},
+	}
+}
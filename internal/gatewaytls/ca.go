@@ -0,0 +1,234 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaytls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+)
+
+const (
+	// CASecretName is the name of the secret in the namespace of the Istio control plane
+	// which holds the CA the certificates of its mesh gateways are issued by
+	CASecretName = "istio-meshgateway-ca"
+	// CACertKey is the key of the PEM encoded certificate of the CA in its secret
+	CACertKey = "ca.crt"
+	// CAKeyKey is the key of the PEM encoded private key of the CA in its secret
+	CAKeyKey = "ca.key"
+
+	caValidity = 10 * 365 * 24 * time.Hour
+)
+
+// reconcileSecret issues the certificate of the gateway from the CA of the operator into the configured secret
+// and renews it when it is about to expire or it does not match the configuration anymore
+func (r *Reconciler) reconcileSecret(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane, config *servicemeshv1alpha1.IstioMeshGatewayTLS, duration, renewBefore time.Duration) (Result, error) {
+	ca, err := r.getOrCreateCA(ctx, icp)
+	if err != nil {
+		return Result{}, err
+	}
+
+	now := r.now()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.GetSecretName(),
+			Namespace: imgw.GetNamespace(),
+		},
+	}
+
+	var cert *x509.Certificate
+	_, err = controllerutil.CreateOrUpdate(ctx, r.client, secret, func() error {
+		if secret.GetResourceVersion() != "" && !metav1.IsControlledBy(secret, imgw) {
+			return errors.Errorf("secret %s already exists and it is not managed by the mesh gateway", client.ObjectKeyFromObject(secret))
+		}
+
+		cert = validCertificate(secret, ca, config.GetDnsNames(), duration, now.Add(renewBefore))
+		if cert == nil {
+			var certPEM, keyPEM []byte
+			var err error
+			cert, certPEM, keyPEM, err = ca.Issue(certificateTemplate(config.GetDnsNames(), now, duration))
+			if err != nil {
+				return err
+			}
+
+			secret.Type = corev1.SecretTypeTLS
+			secret.Data = map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: keyPEM,
+				CACertKey:               ca.CertificatePEM,
+			}
+		}
+
+		return controllerutil.SetControllerReference(imgw, secret, r.scheme)
+	})
+	if err != nil {
+		return Result{}, errors.WrapIfWithDetails(err, "could not reconcile certificate secret of the mesh gateway", "name", secret.GetName(), "namespace", secret.GetNamespace())
+	}
+
+	renewalTime := cert.NotAfter.Add(-renewBefore)
+
+	return Result{
+		Status: &servicemeshv1alpha1.IstioMeshGatewayTLSStatus{
+			SecretName:  secret.GetName(),
+			Issuer:      IssuerOperatorCA,
+			NotBefore:   timestamp(cert.NotBefore),
+			NotAfter:    timestamp(cert.NotAfter),
+			RenewalTime: timestamp(renewalTime),
+		},
+		Ready:        true,
+		Message:      fmt.Sprintf("certificate is issued by the operator CA, it is going to be renewed at %s", renewalTime.UTC().Format(time.RFC3339)),
+		RequeueAfter: renewalTime.Sub(now),
+	}, nil
+}
+
+// validCertificate returns the certificate of the secret when it can be kept, that is it is issued by the CA
+// for the DNS names with the configured validity and it does not have to be renewed before the given time yet
+func validCertificate(secret *corev1.Secret, ca *pki.CA, dnsNames []string, duration time.Duration, renewAt time.Time) *x509.Certificate {
+	if !bytes.Equal(secret.Data[CACertKey], ca.CertificatePEM) {
+		return nil
+	}
+
+	if _, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+		return nil
+	}
+
+	cert, err := pki.ParseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil
+	}
+
+	if cert.CheckSignatureFrom(ca.Certificate) != nil || !sameNames(cert.DNSNames, dnsNames) {
+		return nil
+	}
+
+	if cert.NotAfter.Sub(cert.NotBefore) != duration || !renewAt.Before(cert.NotAfter) {
+		return nil
+	}
+
+	return cert
+}
+
+// getOrCreateCA returns the CA of the operator in the namespace of the control plane and generates it
+// when it does not exist yet, the secret can be replaced with a custom CA as well
+func (r *Reconciler) getOrCreateCA(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (*pki.CA, error) {
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, client.ObjectKey{
+		Name:      CASecretName,
+		Namespace: icp.GetNamespace(),
+	}, secret)
+	if err == nil {
+		ca, err := pki.ParseCA(secret.Data[CACertKey], secret.Data[CAKeyKey])
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "invalid CA secret", "name", secret.GetName(), "namespace", secret.GetNamespace())
+		}
+
+		return ca, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, errors.WrapIf(err, "could not get CA secret")
+	}
+
+	now := r.now().Truncate(time.Second)
+	certPEM, keyPEM, err := pki.SelfSigned(&x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: fmt.Sprintf("%s.%s", CASecretName, icp.GetNamespace()),
+		},
+		NotBefore:             now,
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not create CA")
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CASecretName,
+			Namespace: icp.GetNamespace(),
+		},
+		Data: map[string][]byte{
+			CACertKey: certPEM,
+			CAKeyKey:  keyPEM,
+		},
+	}
+
+	// the CA is shared by the gateways of the control plane, so it is only removed together with the control plane
+	if err := controllerutil.SetOwnerReference(icp, secret, r.scheme); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := r.client.Create(ctx, secret); err != nil {
+		return nil, errors.WrapIf(err, "could not create CA secret")
+	}
+
+	return pki.ParseCA(certPEM, keyPEM)
+}
+
+// certificateTemplate returns the template of the certificate of a gateway which is valid from now for the given duration
+func certificateTemplate(dnsNames []string, now time.Time, duration time.Duration) *x509.Certificate {
+	template := &x509.Certificate{
+		DNSNames:    dnsNames,
+		NotBefore:   now.Truncate(time.Second),
+		NotAfter:    now.Truncate(time.Second).Add(duration),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	// the common name is limited to 64 characters, the DNS names are used by the clients anyway
+	if len(dnsNames) > 0 && len(dnsNames[0]) <= 64 {
+		template.Subject.CommonName = dnsNames[0]
+	}
+
+	return template
+}
+
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
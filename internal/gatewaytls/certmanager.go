@@ -0,0 +1,147 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaytls
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// CertificateGVK is the kind of the cert-manager certificates, they are handled as unstructured objects
+// so cert-manager is only needed in the clusters where it is actually used
+var CertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+const (
+	defaultIssuerKind  = "Issuer"
+	defaultIssuerGroup = "cert-manager.io"
+)
+
+// reconcileCertificate requests the certificate of the gateway from cert-manager through a Certificate
+// named after the gateway, the renewal of the certificate is left to cert-manager
+func (r *Reconciler) reconcileCertificate(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, config *servicemeshv1alpha1.IstioMeshGatewayTLS, duration, renewBefore time.Duration) (Result, error) {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(CertificateGVK)
+	cert.SetName(imgw.GetName())
+	cert.SetNamespace(imgw.GetNamespace())
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.client, cert, func() error {
+		if cert.GetResourceVersion() != "" && !metav1.IsControlledBy(cert, imgw) {
+			return errors.Errorf("certificate %s already exists and it is not managed by the mesh gateway", client.ObjectKeyFromObject(cert))
+		}
+
+		if err := unstructured.SetNestedField(cert.Object, certificateSpec(config, duration, renewBefore), "spec"); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return controllerutil.SetControllerReference(imgw, cert, r.scheme)
+	})
+	if meta.IsNoMatchError(err) {
+		return Result{}, errors.WrapIf(err, "cert-manager is not installed in the cluster")
+	}
+	if err != nil {
+		return Result{}, errors.WrapIfWithDetails(err, "could not reconcile certificate of the mesh gateway", "name", cert.GetName(), "namespace", cert.GetNamespace())
+	}
+
+	return certificateResult(cert, config.GetSecretName()), nil
+}
+
+func certificateSpec(config *servicemeshv1alpha1.IstioMeshGatewayTLS, duration, renewBefore time.Duration) map[string]interface{} {
+	dnsNames := make([]interface{}, 0, len(config.GetDnsNames()))
+	for _, name := range config.GetDnsNames() {
+		dnsNames = append(dnsNames, name)
+	}
+
+	issuer := config.GetCertManagerIssuer()
+
+	kind := issuer.GetKind()
+	if kind == "" {
+		kind = defaultIssuerKind
+	}
+
+	group := issuer.GetGroup()
+	if group == "" {
+		group = defaultIssuerGroup
+	}
+
+	return map[string]interface{}{
+		"secretName":  config.GetSecretName(),
+		"dnsNames":    dnsNames,
+		"duration":    duration.String(),
+		"renewBefore": renewBefore.String(),
+		"issuerRef": map[string]interface{}{
+			"name":  issuer.GetName(),
+			"kind":  kind,
+			"group": group,
+		},
+	}
+}
+
+// certificateResult converts the status of the cert-manager certificate to the TLS status of the gateway
+func certificateResult(cert *unstructured.Unstructured, secretName string) Result {
+	result := Result{
+		Status: &servicemeshv1alpha1.IstioMeshGatewayTLSStatus{
+			SecretName:  secretName,
+			Issuer:      IssuerCertManager,
+			NotBefore:   timestamp(nestedTime(cert, "status", "notBefore")),
+			NotAfter:    timestamp(nestedTime(cert, "status", "notAfter")),
+			RenewalTime: timestamp(nestedTime(cert, "status", "renewalTime")),
+		},
+		Message: "waiting for cert-manager to issue the certificate",
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(cert.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+
+		result.Ready = condition["status"] == "True"
+		if message, ok := condition["message"].(string); ok && message != "" {
+			result.Message = message
+		} else if result.Ready {
+			result.Message = "certificate is issued by cert-manager"
+		}
+	}
+
+	return result
+}
+
+func nestedTime(obj *unstructured.Unstructured, fields ...string) time.Time {
+	value, _, _ := unstructured.NestedString(obj.Object, fields...)
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
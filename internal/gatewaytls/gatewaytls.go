@@ -0,0 +1,178 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaytls
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+)
+
+// issuers of the certificates reported in the status of the mesh gateways
+const (
+	IssuerCertManager = "CertManager"
+	IssuerOperatorCA  = "OperatorCA"
+)
+
+const (
+	// DefaultDuration is the validity of the certificates when it is not set
+	DefaultDuration = 2160 * time.Hour
+	// DefaultRenewBefore is the time before the expiry when the certificates are renewed when it is not set
+	DefaultRenewBefore = 720 * time.Hour
+)
+
+// Durations returns the validity of the certificate and the time before its expiry when it is renewed
+// with the defaults applied
+func Durations(config *servicemeshv1alpha1.IstioMeshGatewayTLS) (time.Duration, time.Duration, error) {
+	return pki.Validity(config.GetDuration(), config.GetRenewBefore(), DefaultDuration, DefaultRenewBefore)
+}
+
+// Result is the state of the certificate of a mesh gateway after its reconciliation
+type Result struct {
+	// Status is the TLS status of the mesh gateway, it is nil when TLS is not configured
+	Status *servicemeshv1alpha1.IstioMeshGatewayTLSStatus
+	// Ready is true when the secret of the gateway holds a valid certificate
+	Ready bool
+	// Message describes the state of the certificate
+	Message string
+	// RequeueAfter is the time after which the certificate has to be checked again for renewal,
+	// it is zero when the renewal is handled by cert-manager
+	RequeueAfter time.Duration
+}
+
+// Reconciler manages the TLS certificates of the mesh gateways either through cert-manager
+// or by issuing them from the self-signed CA of the operator
+type Reconciler struct {
+	client client.Client
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+func NewReconciler(c client.Client, scheme *runtime.Scheme) *Reconciler {
+	return &Reconciler{
+		client: c,
+		scheme: scheme,
+		now:    time.Now,
+	}
+}
+
+// Reconcile makes sure the secret configured for the mesh gateway holds a valid certificate,
+// the CA of the operator is kept in the namespace of the Istio control plane of the gateway
+func (r *Reconciler) Reconcile(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway, icp *servicemeshv1alpha1.IstioControlPlane) (Result, error) {
+	config := imgw.GetSpec().GetTls()
+
+	if err := r.removeStaleSecret(ctx, imgw); err != nil {
+		return Result{}, err
+	}
+
+	if config.GetCertManagerIssuer() == nil {
+		if err := r.removeCertificate(ctx, imgw); err != nil {
+			return Result{}, err
+		}
+	}
+
+	if config == nil {
+		return Result{}, nil
+	}
+
+	duration, renewBefore, err := Durations(config)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if config.GetCertManagerIssuer() != nil {
+		return r.reconcileCertificate(ctx, imgw, config, duration, renewBefore)
+	}
+
+	return r.reconcileSecret(ctx, imgw, icp, config, duration, renewBefore)
+}
+
+// removeStaleSecret removes the secret issued by the operator CA when the gateway does not use it anymore,
+// the secrets written by cert-manager are left intact
+func (r *Reconciler) removeStaleSecret(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway) error {
+	previous := imgw.Status.GetTls()
+	if previous.GetIssuer() != IssuerOperatorCA || previous.GetSecretName() == "" {
+		return nil
+	}
+
+	config := imgw.GetSpec().GetTls()
+	if config != nil && config.GetCertManagerIssuer() == nil && config.GetSecretName() == previous.GetSecretName() {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+
+	return errors.WrapIf(r.deleteIfControlled(ctx, imgw, client.ObjectKey{
+		Name:      previous.GetSecretName(),
+		Namespace: imgw.GetNamespace(),
+	}, secret), "could not remove the previous certificate secret of the mesh gateway")
+}
+
+// removeCertificate removes the cert-manager certificate of the gateway, it is a no-op when cert-manager is not installed
+func (r *Reconciler) removeCertificate(ctx context.Context, imgw *servicemeshv1alpha1.IstioMeshGateway) error {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(CertificateGVK)
+
+	err := r.deleteIfControlled(ctx, imgw, client.ObjectKeyFromObject(imgw), cert)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return errors.WrapIf(err, "could not remove the certificate of the mesh gateway")
+}
+
+func (r *Reconciler) deleteIfControlled(ctx context.Context, owner metav1.Object, key client.ObjectKey, obj client.Object) error {
+	err := r.client.Get(ctx, key, obj)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !metav1.IsControlledBy(obj, owner) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(r.client.Delete(ctx, obj))
+}
+
+func timestamp(t time.Time) *types.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	ts, err := types.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+
+	return ts
+}
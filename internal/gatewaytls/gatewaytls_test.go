@@ -0,0 +1,341 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaytls
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return scheme
+}
+
+func newMeshGateway(config *v1alpha1.IstioMeshGatewayTLS) (*v1alpha1.IstioMeshGateway, *v1alpha1.IstioControlPlane) {
+	icp := &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "cp-v112x", Namespace: "istio-system", UID: "icp-uid"},
+	}
+	imgw := &v1alpha1.IstioMeshGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "default", UID: "imgw-uid"},
+		Spec: &v1alpha1.IstioMeshGatewaySpec{
+			Type: v1alpha1.GatewayType_ingress,
+			IstioControlPlane: &v1alpha1.NamespacedName{
+				Name:      icp.GetName(),
+				Namespace: icp.GetNamespace(),
+			},
+			Tls: config,
+		},
+	}
+
+	return imgw, icp
+}
+
+func TestDurations(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config          *v1alpha1.IstioMeshGatewayTLS
+		wantDuration    time.Duration
+		wantRenewBefore time.Duration
+		wantErr         bool
+	}{
+		"defaults": {
+			config:          &v1alpha1.IstioMeshGatewayTLS{},
+			wantDuration:    DefaultDuration,
+			wantRenewBefore: DefaultRenewBefore,
+		},
+		"custom values": {
+			config:          &v1alpha1.IstioMeshGatewayTLS{Duration: "24h", RenewBefore: "8h"},
+			wantDuration:    24 * time.Hour,
+			wantRenewBefore: 8 * time.Hour,
+		},
+		"invalid duration": {
+			config:  &v1alpha1.IstioMeshGatewayTLS{Duration: "90 days"},
+			wantErr: true,
+		},
+		"negative renewBefore": {
+			config:  &v1alpha1.IstioMeshGatewayTLS{RenewBefore: "-1h"},
+			wantErr: true,
+		},
+		"renewBefore longer than duration": {
+			config:  &v1alpha1.IstioMeshGatewayTLS{Duration: "24h"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			duration, renewBefore, err := Durations(test.config)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if duration != test.wantDuration || renewBefore != test.wantRenewBefore {
+				t.Errorf("got %s/%s, want %s/%s", duration, renewBefore, test.wantDuration, test.wantRenewBefore)
+			}
+		})
+	}
+}
+
+func TestReconcileOperatorCA(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	imgw, icp := newMeshGateway(&v1alpha1.IstioMeshGatewayTLS{
+		SecretName:  "ingress-tls",
+		DnsNames:    []string{"example.com", "*.example.com"},
+		Duration:    "48h",
+		RenewBefore: "12h",
+	})
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp).Build()
+	r := NewReconciler(c, c.Scheme())
+
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	r.now = func() time.Time {
+		return now
+	}
+
+	result, err := r.Reconcile(ctx, imgw, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Ready || result.Status.GetIssuer() != IssuerOperatorCA || result.Status.GetSecretName() != "ingress-tls" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.RequeueAfter != 36*time.Hour {
+		t.Errorf("got requeue after %s, want 36h", result.RequeueAfter)
+	}
+
+	ca := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: CASecretName, Namespace: "istio-system"}, ca); err != nil {
+		t.Fatal(err)
+	}
+	authority, err := pki.ParseCA(ca.Data[CACertKey], ca.Data[CAKeyKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "ingress-tls", Namespace: "default"}, secret); err != nil {
+		t.Fatal(err)
+	}
+	if secret.Type != corev1.SecretTypeTLS || !metav1.IsControlledBy(secret, imgw) {
+		t.Fatalf("unexpected secret: type %s, owners %v", secret.Type, secret.GetOwnerReferences())
+	}
+
+	cert, err := pki.ParseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cert.CheckSignatureFrom(authority.Certificate); err != nil {
+		t.Errorf("certificate is not issued by the CA: %s", err)
+	}
+	if !sameNames(cert.DNSNames, []string{"*.example.com", "example.com"}) {
+		t.Errorf("unexpected DNS names: %v", cert.DNSNames)
+	}
+	if !cert.NotAfter.Equal(now.Add(48 * time.Hour)) {
+		t.Errorf("unexpected expiry: %s", cert.NotAfter)
+	}
+
+	// the certificate is kept until the renewal time
+	now = now.Add(24 * time.Hour)
+	imgw.Status.Tls = result.Status
+	if _, err := r.Reconcile(ctx, imgw, icp); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatal(err)
+	}
+	if renewed, _ := pki.ParseCertificate(secret.Data[corev1.TLSCertKey]); renewed.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		t.Error("certificate is renewed before its renewal time")
+	}
+
+	// and it is renewed after the renewal time
+	now = now.Add(13 * time.Hour)
+	result, err = r.Reconcile(ctx, imgw, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatal(err)
+	}
+	renewed, _ := pki.ParseCertificate(secret.Data[corev1.TLSCertKey])
+	if renewed.SerialNumber.Cmp(cert.SerialNumber) == 0 || !renewed.NotBefore.Equal(now) {
+		t.Error("certificate is not renewed after its renewal time")
+	}
+	if !result.Status.GetNotBefore().Equal(timestamp(now)) {
+		t.Errorf("unexpected notBefore in the status: %s", result.Status.GetNotBefore())
+	}
+
+	// changing the DNS names issues a new certificate as well
+	imgw.Spec.Tls.DnsNames = []string{"example.org"}
+	if _, err := r.Reconcile(ctx, imgw, icp); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatal(err)
+	}
+	if cert, _ := pki.ParseCertificate(secret.Data[corev1.TLSCertKey]); !sameNames(cert.DNSNames, []string{"example.org"}) {
+		t.Errorf("certificate is not reissued for the new DNS names: %v", cert.DNSNames)
+	}
+
+	// the secret is removed when TLS is not configured anymore
+	imgw.Status.Tls = result.Status
+	imgw.Spec.Tls = nil
+	result, err = r.Reconcile(ctx, imgw, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != nil {
+		t.Errorf("unexpected status: %v", result.Status)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); !k8serrors.IsNotFound(err) {
+		t.Errorf("expected the secret to be removed, got %v", err)
+	}
+}
+
+func TestReconcileUnmanagedSecret(t *testing.T) {
+	t.Parallel()
+
+	imgw, icp := newMeshGateway(&v1alpha1.IstioMeshGatewayTLS{
+		SecretName: "ingress-tls",
+		DnsNames:   []string{"example.com"},
+	})
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress-tls", Namespace: "default"},
+	}).Build()
+
+	if _, err := NewReconciler(c, c.Scheme()).Reconcile(context.Background(), imgw, icp); err == nil {
+		t.Fatal("expected an error for a secret which is not managed by the mesh gateway")
+	}
+}
+
+func TestReconcileCertManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	imgw, icp := newMeshGateway(&v1alpha1.IstioMeshGatewayTLS{
+		SecretName: "ingress-tls",
+		DnsNames:   []string{"example.com"},
+		CertManagerIssuer: &v1alpha1.CertManagerIssuerReference{
+			Name: "letsencrypt",
+			Kind: "ClusterIssuer",
+		},
+	})
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp).Build()
+	r := NewReconciler(c, c.Scheme())
+
+	result, err := r.Reconcile(ctx, imgw, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Ready || result.Status.GetIssuer() != IssuerCertManager || result.RequeueAfter != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(CertificateGVK)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(imgw), cert); err != nil {
+		t.Fatal(err)
+	}
+	if !metav1.IsControlledBy(cert, imgw) {
+		t.Errorf("certificate is not controlled by the mesh gateway: %v", cert.GetOwnerReferences())
+	}
+
+	for field, want := range map[string]string{
+		"secretName":  "ingress-tls",
+		"duration":    DefaultDuration.String(),
+		"renewBefore": DefaultRenewBefore.String(),
+	} {
+		if got, _, _ := unstructured.NestedString(cert.Object, "spec", field); got != want {
+			t.Errorf("spec.%s: got %q, want %q", field, got, want)
+		}
+	}
+	issuerRef, _, _ := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+	if issuerRef["name"] != "letsencrypt" || issuerRef["kind"] != "ClusterIssuer" || issuerRef["group"] != "cert-manager.io" {
+		t.Errorf("unexpected issuerRef: %v", issuerRef)
+	}
+
+	// the status of the certificate is reported once cert-manager has issued it
+	if err := unstructured.SetNestedField(cert.Object, map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True", "message": "Certificate is up to date and has not expired"},
+		},
+		"notBefore":   "2022-03-01T12:00:00Z",
+		"notAfter":    "2022-05-30T12:00:00Z",
+		"renewalTime": "2022-04-30T12:00:00Z",
+	}, "status"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Update(ctx, cert); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = r.Reconcile(ctx, imgw, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Ready || !result.Status.GetRenewalTime().Equal(timestamp(time.Date(2022, 4, 30, 12, 0, 0, 0, time.UTC))) {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	// the certificate is removed when the operator CA is used instead
+	imgw.Spec.Tls.CertManagerIssuer = nil
+	if _, err := r.Reconcile(ctx, imgw, icp); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(imgw), cert); !k8serrors.IsNotFound(err) {
+		t.Errorf("expected the certificate to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const checkTimeout = time.Second * 5
+
+// CacheSynced returns a checker which fails until the informers of the cache have synced
+func CacheSynced(c cache.Cache) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), time.Second)
+		defer cancel()
+
+		if !c.WaitForCacheSync(ctx) {
+			return errors.New("informer caches are not synced")
+		}
+
+		return nil
+	}
+}
+
+// LeaderElected returns a checker which fails until the manager is elected as the leader,
+// the channel is closed right away when leader election is disabled
+func LeaderElected(elected <-chan struct{}) healthz.Checker {
+	return func(_ *http.Request) error {
+		select {
+		case <-elected:
+			return nil
+		default:
+			return errors.New("leader is not elected")
+		}
+	}
+}
+
+// ControlPlaneChecker checks the health of the control planes managed by the operator,
+// unmanaged control planes and control planes being deleted are ignored
+type ControlPlaneChecker struct {
+	client client.Client
+	now    func() time.Time
+}
+
+func NewControlPlaneChecker(c client.Client) *ControlPlaneChecker {
+	return &ControlPlaneChecker{
+		client: c,
+		now:    time.Now,
+	}
+}
+
+// IstiodEndpoints fails when an active control plane has no ready istiod endpoints
+func (c *ControlPlaneChecker) IstiodEndpoints(req *http.Request) error {
+	return c.check(req, func(ctx context.Context, icp *v1alpha1.IstioControlPlane) error {
+		if icp.GetSpec().GetMode() != v1alpha1.ModeType_ACTIVE {
+			return nil
+		}
+
+		endpoints, err := k8sutil.GetEndpoints(ctx, c.client, icp.WithRevision("istiod"), icp.GetNamespace())
+		if k8serrors.IsNotFound(errors.Cause(err)) {
+			return errors.New("istiod endpoints not found")
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(k8sutil.GetIPsForEndpoints(endpoints)) == 0 {
+			return errors.New("istiod has no ready endpoints")
+		}
+
+		return nil
+	})
+}
+
+// InjectionWebhookCABundle fails when the CA bundle of the sidecar injector webhook of an active control plane
+// is missing, cannot be parsed or contains an expired certificate
+func (c *ControlPlaneChecker) InjectionWebhookCABundle(req *http.Request) error {
+	return c.check(req, func(ctx context.Context, icp *v1alpha1.IstioControlPlane) error {
+		if icp.GetSpec().GetMode() != v1alpha1.ModeType_ACTIVE {
+			return nil
+		}
+
+		mwcs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+		if err := c.client.List(ctx, mwcs, client.MatchingLabels(icp.RevisionLabels())); err != nil {
+			return errors.WrapIf(err, "could not list mutating webhook configurations")
+		}
+
+		found := false
+		for _, mwc := range mwcs.Items {
+			// the webhook configurations of the revision tags are checked through the control plane they point to
+			if _, ok := mwc.GetLabels()[v1alpha1.RevisionTagLabel]; ok {
+				continue
+			}
+
+			for _, webhook := range mwc.Webhooks {
+				found = true
+				if err := c.checkCABundle(webhook.ClientConfig.CABundle); err != nil {
+					return errors.WrapIfWithDetails(err, "invalid CA bundle", "webhook", webhook.Name)
+				}
+			}
+		}
+
+		if !found {
+			return errors.New("sidecar injector webhook not found")
+		}
+
+		return nil
+	})
+}
+
+// GatewayAddresses fails when the mesh expansion gateway of a control plane has no address assigned
+func (c *ControlPlaneChecker) GatewayAddresses(req *http.Request) error {
+	return c.check(req, func(_ context.Context, icp *v1alpha1.IstioControlPlane) error {
+		if !utils.PointerToBool(icp.GetSpec().GetMeshExpansion().GetEnabled()) {
+			return nil
+		}
+
+		if condition := icp.GetCondition(v1alpha1.ConditionTypeGatewayAddressAssigned); condition == nil || condition.Status != v1alpha1.ConditionStatus_True {
+			return errors.New("gateway address is not assigned")
+		}
+
+		return nil
+	})
+}
+
+func (c *ControlPlaneChecker) check(req *http.Request, check func(ctx context.Context, icp *v1alpha1.IstioControlPlane) error) error {
+	ctx, cancel := context.WithTimeout(req.Context(), checkTimeout)
+	defer cancel()
+
+	icps := &v1alpha1.IstioControlPlaneList{}
+	if err := c.client.List(ctx, icps); err != nil {
+		return errors.WrapIf(err, "could not list control planes")
+	}
+
+	var combinedErr error
+	for i := range icps.Items {
+		icp := &icps.Items[i]
+		if !icp.GetDeletionTimestamp().IsZero() || v1alpha1.IsUnmanaged(icp) {
+			continue
+		}
+
+		if err := check(ctx, icp); err != nil {
+			combinedErr = errors.Append(combinedErr, errors.WrapIfWithDetails(err, fmt.Sprintf("control plane %s/%s is unhealthy", icp.GetNamespace(), icp.GetName()),
+				"namespace", icp.GetNamespace(), "name", icp.GetName()))
+		}
+	}
+
+	return combinedErr
+}
+
+func (c *ControlPlaneChecker) checkCABundle(caBundle []byte) error {
+	if len(caBundle) == 0 {
+		return errors.New("CA bundle is empty")
+	}
+
+	now := c.now()
+	certificates := 0
+	for rest := caBundle; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		certificates++
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return errors.WrapIf(err, "could not parse certificate")
+		}
+
+		if now.After(cert.NotAfter) {
+			return errors.NewWithDetails("certificate has expired", "subject", cert.Subject.String(), "notAfter", cert.NotAfter)
+		}
+	}
+
+	if certificates == 0 {
+		return errors.New("CA bundle contains no certificates")
+	}
+
+	return nil
+}
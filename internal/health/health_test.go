@@ -0,0 +1,181 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+func TestControlPlaneChecker(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	healthy := &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "cp-v112x", Namespace: "istio-system"},
+		Spec: &v1alpha1.IstioControlPlaneSpec{
+			Mode:          v1alpha1.ModeType_ACTIVE,
+			MeshExpansion: &v1alpha1.MeshExpansionConfiguration{Enabled: utils.BoolPointer(true)},
+		},
+		Status: v1alpha1.IstioControlPlaneStatus{
+			Conditions: []v1alpha1.Condition{
+				{Type: v1alpha1.ConditionTypeGatewayAddressAssigned, Status: v1alpha1.ConditionStatus_True},
+			},
+		},
+	}
+	unhealthy := &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "cp-v113x", Namespace: "istio-system"},
+		Spec: &v1alpha1.IstioControlPlaneSpec{
+			Mode:          v1alpha1.ModeType_ACTIVE,
+			MeshExpansion: &v1alpha1.MeshExpansionConfiguration{Enabled: utils.BoolPointer(true)},
+		},
+	}
+	unmanaged := &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cp-v114x",
+			Namespace:   "istio-system",
+			Annotations: map[string]string{v1alpha1.UnmanagedAnnotation: "true"},
+		},
+		Spec: &v1alpha1.IstioControlPlaneSpec{
+			Mode: v1alpha1.ModeType_ACTIVE,
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		healthy, unhealthy, unmanaged,
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: healthy.WithRevision("istiod"), Namespace: "istio-system"},
+			Subsets:    []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}}},
+		},
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: unhealthy.WithRevision("istiod"), Namespace: "istio-system"},
+		},
+		webhookConfiguration(t, healthy, now.Add(time.Hour)),
+		webhookConfiguration(t, unhealthy, now.Add(-time.Hour)),
+	).Build()
+
+	checker := NewControlPlaneChecker(c)
+	checker.now = func() time.Time {
+		return now
+	}
+
+	for name, check := range map[string]func() error{
+		"istiod endpoints": func() error {
+			return checker.IstiodEndpoints(httptest.NewRequest("GET", "/readyz", nil))
+		},
+		"injection webhook CA bundle": func() error {
+			return checker.InjectionWebhookCABundle(httptest.NewRequest("GET", "/readyz", nil))
+		},
+		"gateway addresses": func() error {
+			return checker.GatewayAddresses(httptest.NewRequest("GET", "/readyz", nil))
+		},
+	} {
+		err := check()
+		if err == nil {
+			t.Errorf("%s: expected the unhealthy control plane to fail the check", name)
+
+			continue
+		}
+		if !strings.Contains(err.Error(), "istio-system/cp-v113x") {
+			t.Errorf("%s: expected the unhealthy control plane to be reported: %s", name, err)
+		}
+		if strings.Contains(err.Error(), "istio-system/cp-v112x") || strings.Contains(err.Error(), "istio-system/cp-v114x") {
+			t.Errorf("%s: expected only the unhealthy control plane to be reported: %s", name, err)
+		}
+	}
+}
+
+func TestLeaderElected(t *testing.T) {
+	t.Parallel()
+
+	elected := make(chan struct{})
+	check := LeaderElected(elected)
+
+	if err := check(httptest.NewRequest("GET", "/readyz", nil)); err == nil {
+		t.Error("expected the check to fail before the election")
+	}
+
+	close(elected)
+
+	if err := check(httptest.NewRequest("GET", "/readyz", nil)); err != nil {
+		t.Errorf("expected the check to pass after the election: %s", err)
+	}
+}
+
+func webhookConfiguration(t *testing.T, icp *v1alpha1.IstioControlPlane, notAfter time.Time) *admissionregistrationv1.MutatingWebhookConfiguration {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"cluster.local"}},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   icp.WithNamespacedRevision("istio-sidecar-injector"),
+			Labels: icp.RevisionLabels(),
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name: "namespace.sidecar-injector.istio.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+const (
+	namespace = "istio_operator"
+
+	collectTimeout = time.Second * 10
+)
+
+var (
+	componentReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "component_reconcile_duration_seconds",
+		Help:      "Duration of the reconciliation of the components",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"component"})
+
+	componentReconcileFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "component_reconcile_failures_total",
+		Help:      "Number of failed reconciliations of the components by the class of the error",
+	}, []string{"component", "class"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(componentReconcileDuration, componentReconcileFailures)
+}
+
+// ObserveComponentReconcile records the duration and the failure of a reconciliation of a component
+func ObserveComponentReconcile(component string, start time.Time, err error) {
+	componentReconcileDuration.WithLabelValues(component).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		componentReconcileFailures.WithLabelValues(component, ErrorClass(err)).Inc()
+	}
+}
+
+// ErrorClass returns a low cardinality class of the error, the reason of the first Kubernetes API error
+// or the type of the failure if it can be determined
+func ErrorClass(err error) string {
+	for _, e := range errors.GetErrors(err) {
+		if reason := k8serrors.ReasonForError(e); reason != "" && reason != "Unknown" {
+			return string(reason)
+		}
+
+		switch {
+		case meta.IsNoMatchError(e):
+			return "NoKindMatch"
+		case errors.Is(e, context.DeadlineExceeded):
+			return "Timeout"
+		}
+	}
+
+	return "Other"
+}
+
+var (
+	controlPlanesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "control_planes"),
+		"Number of Istio control planes by their status",
+		[]string{"status"}, nil,
+	)
+	peerControlPlanesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "peer_control_planes"),
+		"Number of peer control planes of the Istio control plane",
+		[]string{"namespace", "name"}, nil,
+	)
+	injectionNamespacesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "injection_namespaces"),
+		"Number of namespaces with sidecar injection enabled for the Istio control plane",
+		[]string{"namespace", "name"}, nil,
+	)
+	gatewayAddressAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "gateway_address_age_seconds"),
+		"Time since the address of the gateway was assigned",
+		[]string{"kind", "namespace", "name"}, nil,
+	)
+)
+
+// Collector collects the state of the mesh from the custom resources of the operator on every scrape
+type Collector struct {
+	client client.Reader
+	now    func() time.Time
+}
+
+func NewCollector(c client.Reader) *Collector {
+	return &Collector{
+		client: c,
+		now:    time.Now,
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- controlPlanesDesc
+	ch <- peerControlPlanesDesc
+	ch <- injectionNamespacesDesc
+	ch <- gatewayAddressAgeDesc
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	icps := &v1alpha1.IstioControlPlaneList{}
+	if err := c.client.List(ctx, icps); err != nil {
+		ch <- prometheus.NewInvalidMetric(controlPlanesDesc, err)
+
+		return
+	}
+
+	picps := &v1alpha1.PeerIstioControlPlaneList{}
+	if err := c.client.List(ctx, picps); err != nil {
+		ch <- prometheus.NewInvalidMetric(peerControlPlanesDesc, err)
+
+		return
+	}
+
+	imgws := &v1alpha1.IstioMeshGatewayList{}
+	if err := c.client.List(ctx, imgws); err != nil {
+		ch <- prometheus.NewInvalidMetric(gatewayAddressAgeDesc, err)
+
+		return
+	}
+
+	states := map[string]int{}
+	for name := range v1alpha1.ConfigState_value {
+		states[name] = 0
+	}
+
+	for _, icp := range icps.Items {
+		states[icp.Status.Status.String()]++
+
+		peers := 0
+		for _, picp := range picps.Items {
+			if picp.GetNamespace() == icp.GetNamespace() && picp.Status.IstioControlPlaneName == icp.GetName() {
+				peers++
+			}
+		}
+		ch <- prometheus.MustNewConstMetric(peerControlPlanesDesc, prometheus.GaugeValue, float64(peers), icp.GetNamespace(), icp.GetName())
+		ch <- prometheus.MustNewConstMetric(injectionNamespacesDesc, prometheus.GaugeValue, float64(len(icp.Status.InjectionNamespaces)), icp.GetNamespace(), icp.GetName())
+
+		c.collectGatewayAddressAge(ch, "IstioControlPlane", icp.GetNamespace(), icp.GetName(), icp.Status.Conditions)
+	}
+
+	for state, count := range states {
+		ch <- prometheus.MustNewConstMetric(controlPlanesDesc, prometheus.GaugeValue, float64(count), state)
+	}
+
+	for _, imgw := range imgws.Items {
+		c.collectGatewayAddressAge(ch, "IstioMeshGateway", imgw.GetNamespace(), imgw.GetName(), imgw.Status.Conditions)
+	}
+}
+
+func (c *Collector) collectGatewayAddressAge(ch chan<- prometheus.Metric, kind, namespace, name string, conditions []v1alpha1.Condition) {
+	condition := v1alpha1.FindCondition(conditions, v1alpha1.ConditionTypeGatewayAddressAssigned)
+	if condition == nil || condition.Status != v1alpha1.ConditionStatus_True || condition.LastTransitionTime == nil {
+		return
+	}
+
+	assigned, err := types.TimestampFromProto(condition.LastTransitionTime)
+	if err != nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(gatewayAddressAgeDesc, prometheus.GaugeValue, c.now().Sub(assigned).Seconds(), kind, namespace, name)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	assigned, err := types.TimestampProto(now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cp-v112x", Namespace: "istio-system"},
+			Status: v1alpha1.IstioControlPlaneStatus{
+				Status:              v1alpha1.ConfigState_Available,
+				InjectionNamespaces: []string{"default", "demo"},
+			},
+		},
+		&v1alpha1.IstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cp-v113x", Namespace: "istio-system"},
+			Status: v1alpha1.IstioControlPlaneStatus{
+				Status: v1alpha1.ConfigState_ReconcileFailed,
+			},
+		},
+		&v1alpha1.PeerIstioControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cp-v112x-peer", Namespace: "istio-system"},
+			Status: v1alpha1.IstioControlPlaneStatus{
+				IstioControlPlaneName: "cp-v112x",
+			},
+		},
+		&v1alpha1.IstioMeshGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "imgw", Namespace: "istio-system"},
+			Status: v1alpha1.IstioMeshGatewayStatus{
+				Conditions: []v1alpha1.Condition{
+					{
+						Type:               v1alpha1.ConditionTypeGatewayAddressAssigned,
+						Status:             v1alpha1.ConditionStatus_True,
+						LastTransitionTime: assigned,
+					},
+				},
+			},
+		},
+	).Build()
+
+	collector := NewCollector(c)
+	collector.now = func() time.Time {
+		return now
+	}
+
+	expected := `
+# HELP istio_operator_control_planes Number of Istio control planes by their status
+# TYPE istio_operator_control_planes gauge
+istio_operator_control_planes{status="Available"} 1
+istio_operator_control_planes{status="Created"} 0
+istio_operator_control_planes{status="ReconcileFailed"} 1
+istio_operator_control_planes{status="Reconciling"} 0
+istio_operator_control_planes{status="Unmanaged"} 0
+istio_operator_control_planes{status="Unspecified"} 0
+# HELP istio_operator_gateway_address_age_seconds Time since the address of the gateway was assigned
+# TYPE istio_operator_gateway_address_age_seconds gauge
+istio_operator_gateway_address_age_seconds{kind="IstioMeshGateway",name="imgw",namespace="istio-system"} 60
+# HELP istio_operator_injection_namespaces Number of namespaces with sidecar injection enabled for the Istio control plane
+# TYPE istio_operator_injection_namespaces gauge
+istio_operator_injection_namespaces{name="cp-v112x",namespace="istio-system"} 2
+istio_operator_injection_namespaces{name="cp-v113x",namespace="istio-system"} 0
+# HELP istio_operator_peer_control_planes Number of peer control planes of the Istio control plane
+# TYPE istio_operator_peer_control_planes gauge
+istio_operator_peer_control_planes{name="cp-v112x",namespace="istio-system"} 1
+istio_operator_peer_control_planes{name="cp-v113x",namespace="istio-system"} 0
+`
+
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+
+	for expected, err := range map[string]error{
+		"Conflict": errors.Combine(
+			errors.New("failed"),
+			errors.WrapIf(k8serrors.NewConflict(schema.GroupResource{Resource: "deployments"}, "istiod", errors.New("conflict")), "updating resource failed"),
+		),
+		"NotFound": k8serrors.NewNotFound(schema.GroupResource{Resource: "services"}, "istiod"),
+		"Other":    errors.New("could not render chart"),
+	} {
+		if class := ErrorClass(err); class != expected {
+			t.Errorf("unexpected class for %q: %s != %s", err, class, expected)
+		}
+	}
+}
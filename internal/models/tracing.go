@@ -0,0 +1,30 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+// TracingConfiguration contains the settings of the tracing of the reconciliations, tracing is disabled
+// unless either the OTLP endpoint or the file is set
+type TracingConfiguration struct {
+	OTLPEndpoint string  `json:"otlpEndpoint,omitempty"`
+	OTLPInsecure bool    `json:"otlpInsecure,omitempty"`
+	File         string  `json:"file,omitempty"`
+	SampleRatio  float64 `json:"sampleRatio,omitempty"`
+}
+
+func (c TracingConfiguration) Enabled() bool {
+	return c.OTLPEndpoint != "" || c.File != ""
+}
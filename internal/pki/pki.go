@@ -0,0 +1,253 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// CA is a certificate authority the operator issues certificates with
+type CA struct {
+	Certificate    *x509.Certificate
+	CertificatePEM []byte
+	Key            crypto.Signer
+}
+
+// ParseCA parses the PEM encoded certificate and private key of a CA, the first certificate of the PEM data is the CA
+func ParseCA(certPEM, keyPEM []byte) (*CA, error) {
+	cert, err := ParseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cert.IsCA {
+		return nil, errors.NewPlain("certificate is not a CA certificate")
+	}
+
+	key, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return nil, errors.WrapIf(err, "private key does not match the certificate")
+	}
+
+	return &CA{
+		Certificate:    cert,
+		CertificatePEM: certPEM,
+		Key:            key,
+	}, nil
+}
+
+// SelfSigned creates a self-signed certificate from the template with a new key,
+// it returns the PEM encoded certificate and private key
+func SelfSigned(template *x509.Certificate) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not generate key")
+	}
+
+	template.SerialNumber, err = serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, errors.WrapIf(err, "could not create certificate")
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertificate(der), keyPEM, nil
+}
+
+// Issue issues a certificate from the template with a new key, it returns the certificate
+// along with the PEM encoded certificate and private key
+func (ca *CA) Issue(template *x509.Certificate) (*x509.Certificate, []byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, errors.WrapIf(err, "could not generate key")
+	}
+
+	template.SerialNumber, err = serialNumber()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, key.Public(), ca.Key)
+	if err != nil {
+		return nil, nil, nil, errors.WrapIf(err, "could not issue certificate")
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return cert, encodeCertificate(der), keyPEM, nil
+}
+
+// Validity parses the validity of a certificate and the time before its expiry when it is renewed,
+// the defaults are used for the empty values
+func Validity(duration, renewBefore string, defaultDuration, defaultRenewBefore time.Duration) (time.Duration, time.Duration, error) {
+	d, err := parseDuration(duration, defaultDuration)
+	if err != nil {
+		return 0, 0, errors.WrapIf(err, "invalid duration")
+	}
+
+	r, err := parseDuration(renewBefore, defaultRenewBefore)
+	if err != nil {
+		return 0, 0, errors.WrapIf(err, "invalid renewBefore")
+	}
+
+	if r >= d {
+		return 0, 0, errors.Errorf("renewBefore (%s) must be shorter than duration (%s)", r, d)
+	}
+
+	return d, r, nil
+}
+
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if d <= 0 {
+		return 0, errors.Errorf("%s is not positive", value)
+	}
+
+	return d, nil
+}
+
+// ParseCertificate parses the first certificate of the PEM data
+func ParseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.NewPlain("could not decode PEM encoded certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not parse certificate")
+	}
+
+	return cert, nil
+}
+
+// ParseCertificates parses every certificate of the PEM data, the blocks which cannot be parsed are skipped
+func ParseCertificates(data []byte) []*x509.Certificate {
+	certs := make([]*x509.Certificate, 0)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
+
+// ContainsCertificate returns whether the PEM data contains the certificate
+func ContainsCertificate(data []byte, cert *x509.Certificate) bool {
+	for _, c := range ParseCertificates(data) {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #8, EC or PKCS #1 private key
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.NewPlain("could not decode PEM encoded private key")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+
+		return nil, errors.NewPlain("unsupported private key type")
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.NewPlain("could not parse private key")
+}
+
+func encodeCertificate(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func encodePrivateKey(key crypto.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not encode private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func serialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not generate serial number")
+	}
+
+	return serial, nil
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginca
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gogo/protobuf/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+)
+
+const (
+	// SecretName is the name of the secret istiod loads its plug-in CA from
+	SecretName = "cacerts"
+
+	// keys of the plug-in CA secret of istiod
+	CACertKey    = "ca-cert.pem"
+	CAKeyKey     = "ca-key.pem"
+	RootCertKey  = "root-cert.pem"
+	CertChainKey = "cert-chain.pem"
+
+	// keys of the root CA secret
+	RootCACertKey = "ca.crt"
+	RootCAKeyKey  = "ca.key"
+)
+
+const (
+	// DefaultIntermediateDuration is the validity of the intermediate CAs when it is not set
+	DefaultIntermediateDuration = 8760 * time.Hour
+	// DefaultIntermediateRenewBefore is the time before the expiry when the intermediate CAs are renewed when it is not set
+	DefaultIntermediateRenewBefore = 720 * time.Hour
+)
+
+// Durations returns the validity of the intermediate CA and the time before its expiry when it is renewed
+// with the defaults applied
+func Durations(config *servicemeshv1alpha1.CAConfiguration) (time.Duration, time.Duration, error) {
+	return pki.Validity(config.GetIntermediateDuration(), config.GetIntermediateRenewBefore(), DefaultIntermediateDuration, DefaultIntermediateRenewBefore)
+}
+
+// RootCASecretKey returns the key of the root CA secret, the namespace of the control plane is used by default
+func RootCASecretKey(icp *servicemeshv1alpha1.IstioControlPlane) client.ObjectKey {
+	ref := icp.GetSpec().GetCa().GetRootCASecret()
+
+	key := client.ObjectKey{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}
+	if key.Namespace == "" {
+		key.Namespace = icp.GetNamespace()
+	}
+
+	return key
+}
+
+// NextRootCASecretKey returns the key of the secret of the root CA the plug-in CA is rotated to
+func NextRootCASecretKey(icp *servicemeshv1alpha1.IstioControlPlane) client.ObjectKey {
+	ref := icp.GetSpec().GetCa().GetNextRootCASecret()
+
+	key := client.ObjectKey{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}
+	if key.Namespace == "" {
+		key.Namespace = icp.GetNamespace()
+	}
+
+	return key
+}
+
+// Result is the state of the plug-in CA of a control plane after its reconciliation
+type Result struct {
+	// Status is the CA status of the control plane, it is nil when the plug-in CA is not configured
+	Status *servicemeshv1alpha1.CAStatus
+	// Checksum changes whenever the content of the plug-in CA secret changes, so istiod can be restarted to load it
+	Checksum string
+	// RequeueAfter is the time after which the intermediate CA has to be checked again for renewal
+	RequeueAfter time.Duration
+	// Managed is true when the cacerts secret is managed by the control plane
+	Managed bool
+	// RootCertificate is the certificate of the root CA
+	RootCertificate *x509.Certificate
+	// NextRootCertificate is the certificate of the root CA the plug-in CA is rotated to if any
+	NextRootCertificate *x509.Certificate
+}
+
+// Reconciler issues the intermediate CA of the cluster from the configured root CA into the cacerts secret of istiod
+type Reconciler struct {
+	client client.Client
+	scheme *runtime.Scheme
+	now    func() time.Time
+}
+
+func NewReconciler(c client.Client, scheme *runtime.Scheme) *Reconciler {
+	return &Reconciler{
+		client: c,
+		scheme: scheme,
+		now:    time.Now,
+	}
+}
+
+// Reconcile makes sure the cacerts secret in the namespace of the control plane holds a valid intermediate CA
+// issued by the configured root CA. The secret is shared by the control planes of the namespace, it is only
+// updated by the control plane which created it.
+// During a root CA rotation the issuer of the intermediate CA and the trusted root CAs are determined by the
+// phase of the rotation in the status of the control plane, the phases are advanced by the caller.
+func (r *Reconciler) Reconcile(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) (Result, error) {
+	config := icp.GetSpec().GetCa()
+	if config == nil {
+		return Result{}, r.removeSecret(ctx, icp)
+	}
+
+	duration, renewBefore, err := Durations(config)
+	if err != nil {
+		return Result{}, err
+	}
+
+	rootKey, nextKey := RootCASecretKey(icp), NextRootCASecretKey(icp)
+	root, err := r.getRootCA(ctx, rootKey)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var next *pki.CA
+	var rotation *servicemeshv1alpha1.RootCARotationStatus
+	if config.GetNextRootCASecret() != nil {
+		next, err = r.getRootCA(ctx, nextKey)
+		if err != nil {
+			return Result{}, err
+		}
+
+		rotation = icp.Status.GetCa().GetRotation().DeepCopy()
+		if rotation == nil || rotation.GetNextRootCASecret() != nextKey.String() {
+			rotation = &servicemeshv1alpha1.RootCARotationStatus{
+				Phase:              servicemeshv1alpha1.RootCARotationPhase_AddingTrust,
+				NextRootCASecret:   nextKey.String(),
+				LastTransitionTime: timestamp(r.now()),
+			}
+		}
+	}
+
+	now := r.now()
+
+	secret := &corev1.Secret{}
+	err = r.client.Get(ctx, client.ObjectKey{Name: SecretName, Namespace: icp.GetNamespace()}, secret)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return Result{}, errors.WrapIf(err, "could not get plug-in CA secret")
+	}
+
+	if err == nil && !metav1.IsControlledBy(secret, icp) {
+		owner := metav1.GetControllerOf(secret)
+		if owner == nil || owner.Kind != "IstioControlPlane" {
+			return Result{}, errors.Errorf("secret %s already exists and it is not managed by the operator", client.ObjectKeyFromObject(secret))
+		}
+
+		// the intermediate CA and its rotation are managed by the control plane which created the secret
+		for _, issuer := range []struct {
+			key client.ObjectKey
+			ca  *pki.CA
+		}{{key: rootKey, ca: root}, {key: nextKey, ca: next}} {
+			if issuer.ca == nil {
+				continue
+			}
+
+			if cert := issuedBy(secret, issuer.ca); cert != nil && now.Before(cert.NotAfter) {
+				result := newResult(secret, cert, issuer.key, renewBefore, now)
+				result.RootCertificate = root.Certificate
+
+				return result, nil
+			}
+		}
+
+		return Result{}, errors.Errorf("intermediate CA in secret %s managed by control plane %s is not issued by the root CA", client.ObjectKeyFromObject(secret), owner.Name)
+	}
+
+	issuerKey, issuer, trusted := rootKey, root, []*pki.CA{root}
+	if rotation != nil {
+		issuer, trusted = Bundle(rotation.GetPhase(), root, next)
+		if issuer == next {
+			issuerKey = nextKey
+		}
+	}
+
+	trustBundle := make([]byte, 0)
+	for _, ca := range trusted {
+		trustBundle = append(trustBundle, ca.CertificatePEM...)
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SecretName,
+			Namespace: icp.GetNamespace(),
+		},
+	}
+
+	var cert *x509.Certificate
+	_, err = controllerutil.CreateOrUpdate(ctx, r.client, secret, func() error {
+		cert = validIntermediate(secret, issuer, duration, now.Add(renewBefore))
+		if cert == nil {
+			var certPEM, keyPEM []byte
+			var err error
+			cert, certPEM, keyPEM, err = issuer.Issue(intermediateTemplate(icp.GetSpec().GetClusterID(), issuer, now, duration))
+			if err != nil {
+				return err
+			}
+
+			secret.Data = map[string][]byte{
+				CACertKey: certPEM,
+				CAKeyKey:  keyPEM,
+			}
+		}
+
+		secret.Data[RootCertKey] = trustBundle
+		secret.Data[CertChainKey] = append(append([]byte{}, secret.Data[CACertKey]...), issuer.CertificatePEM...)
+
+		return controllerutil.SetControllerReference(icp, secret, r.scheme)
+	})
+	if err != nil {
+		return Result{}, errors.WrapIfWithDetails(err, "could not reconcile plug-in CA secret", "name", secret.GetName(), "namespace", secret.GetNamespace())
+	}
+
+	result := newResult(secret, cert, issuerKey, renewBefore, now)
+	result.Managed = true
+	result.RootCertificate = root.Certificate
+	if next != nil {
+		result.Status.Rotation = rotation
+		result.NextRootCertificate = next.Certificate
+	}
+
+	return result, nil
+}
+
+// Checksum returns the checksum of the content of a plug-in CA secret which is loaded by istiod
+func Checksum(secret *corev1.Secret) string {
+	checksum := sha256.New()
+	for _, key := range []string{CACertKey, CertChainKey, RootCertKey} {
+		checksum.Write(secret.Data[key])
+	}
+
+	return fmt.Sprintf("%x", checksum.Sum(nil))
+}
+
+// Bundle returns the CA which issues the intermediate CA and the root CAs which are trusted in a phase of the
+// rotation from the current to the next root CA. The new root CA is trusted before it issues the intermediate CA,
+// and the old root CA is trusted until the workloads of the mesh got certificates issued by the new one.
+func Bundle(phase servicemeshv1alpha1.RootCARotationPhase, current, next *pki.CA) (*pki.CA, []*pki.CA) {
+	switch phase {
+	case servicemeshv1alpha1.RootCARotationPhase_AddingTrust, servicemeshv1alpha1.RootCARotationPhase_WaitingForPeers:
+		return current, []*pki.CA{current, next}
+	case servicemeshv1alpha1.RootCARotationPhase_SwitchingSigner, servicemeshv1alpha1.RootCARotationPhase_RestartingWorkloads:
+		return next, []*pki.CA{next, current}
+	default:
+		return next, []*pki.CA{next}
+	}
+}
+
+func newResult(secret *corev1.Secret, cert *x509.Certificate, issuerKey client.ObjectKey, renewBefore time.Duration, now time.Time) Result {
+	renewalTime := cert.NotAfter.Add(-renewBefore)
+
+	result := Result{
+		Status: &servicemeshv1alpha1.CAStatus{
+			RootCASecret:     issuerKey.String(),
+			CertificateChain: string(secret.Data[CertChainKey]),
+			NotBefore:        timestamp(cert.NotBefore),
+			NotAfter:         timestamp(cert.NotAfter),
+			RenewalTime:      timestamp(renewalTime),
+		},
+		Checksum: Checksum(secret),
+	}
+
+	if renewalTime.After(now) {
+		result.RequeueAfter = renewalTime.Sub(now)
+	}
+
+	return result
+}
+
+// validIntermediate returns the intermediate CA of the secret when it can be kept, that is it is issued by the root CA
+// with the configured validity and it does not have to be renewed before the given time yet
+func validIntermediate(secret *corev1.Secret, root *pki.CA, duration time.Duration, renewAt time.Time) *x509.Certificate {
+	cert := issuedBy(secret, root)
+	if cert == nil {
+		return nil
+	}
+
+	// the validity of the intermediate CA is capped by the expiry of the root CA,
+	// such intermediates cannot be renewed until the root CA itself is rotated
+	if cert.NotAfter.Equal(root.Certificate.NotAfter) {
+		return cert
+	}
+
+	if cert.NotAfter.Sub(cert.NotBefore) != duration || !renewAt.Before(cert.NotAfter) {
+		return nil
+	}
+
+	return cert
+}
+
+// issuedBy returns the intermediate CA of the secret if it is issued by the root CA and it matches its private key
+func issuedBy(secret *corev1.Secret, root *pki.CA) *x509.Certificate {
+	if _, err := tls.X509KeyPair(secret.Data[CACertKey], secret.Data[CAKeyKey]); err != nil {
+		return nil
+	}
+
+	cert, err := pki.ParseCertificate(secret.Data[CACertKey])
+	if err != nil || !cert.IsCA || cert.CheckSignatureFrom(root.Certificate) != nil {
+		return nil
+	}
+
+	return cert
+}
+
+// intermediateTemplate returns the template of the intermediate CA of the cluster, which is valid from now
+// for the given duration but not longer than the root CA
+func intermediateTemplate(clusterID string, root *pki.CA, now time.Time, duration time.Duration) *x509.Certificate {
+	notBefore := now.Truncate(time.Second)
+	notAfter := notBefore.Add(duration)
+	if notAfter.After(root.Certificate.NotAfter) {
+		notAfter = root.Certificate.NotAfter
+	}
+
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			Organization: []string{"Istio"},
+			CommonName:   "Intermediate CA",
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	if clusterID != "" {
+		template.Subject.Locality = []string{clusterID}
+	}
+
+	return template
+}
+
+func (r *Reconciler) getRootCA(ctx context.Context, key client.ObjectKey) (*pki.CA, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, key, secret); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get root CA secret", "name", key.Name, "namespace", key.Namespace)
+	}
+
+	root, err := pki.ParseCA(secret.Data[RootCACertKey], secret.Data[RootCAKeyKey])
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "invalid root CA secret", "name", key.Name, "namespace", key.Namespace)
+	}
+
+	return root, nil
+}
+
+// removeSecret removes the plug-in CA secret of the control plane when the plug-in CA is not configured anymore,
+// istiod falls back to its self-signed CA then
+func (r *Reconciler) removeSecret(ctx context.Context, icp *servicemeshv1alpha1.IstioControlPlane) error {
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, client.ObjectKey{Name: SecretName, Namespace: icp.GetNamespace()}, secret)
+	if err != nil {
+		return errors.WrapIf(client.IgnoreNotFound(err), "could not get plug-in CA secret")
+	}
+
+	if !metav1.IsControlledBy(secret, icp) {
+		return nil
+	}
+
+	return errors.WrapIf(client.IgnoreNotFound(r.client.Delete(ctx, secret)), "could not remove plug-in CA secret")
+}
+
+func timestamp(t time.Time) *types.Timestamp {
+	ts, err := types.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+
+	return ts
+}
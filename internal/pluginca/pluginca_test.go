@@ -0,0 +1,380 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginca
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/pki"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return scheme
+}
+
+func newControlPlane(name string, config *v1alpha1.CAConfiguration) *v1alpha1.IstioControlPlane {
+	return &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "istio-system", UID: types.UID(name + "-uid")},
+		Spec: &v1alpha1.IstioControlPlaneSpec{
+			ClusterID: "cluster1",
+			Ca:        config,
+		},
+	}
+}
+
+func newRootCASecret(t *testing.T, notBefore time.Time, validity time.Duration) *corev1.Secret {
+	t.Helper()
+
+	certPEM, keyPEM, err := pki.SelfSigned(&x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"Istio"}, CommonName: "Root CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "root-ca", Namespace: "cert-manager"},
+		Data: map[string][]byte{
+			RootCACertKey: certPEM,
+			RootCAKeyKey:  keyPEM,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	icp := newControlPlane("cp-v112x", &v1alpha1.CAConfiguration{
+		RootCASecret:            &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "cert-manager"},
+		IntermediateDuration:    "48h",
+		IntermediateRenewBefore: "12h",
+	})
+	root := newRootCASecret(t, now, 365*24*time.Hour)
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, root).Build()
+	r := NewReconciler(c, c.Scheme())
+	r.now = func() time.Time {
+		return now
+	}
+
+	result, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status.GetRootCASecret() != "cert-manager/root-ca" || result.Checksum == "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.RequeueAfter != 36*time.Hour {
+		t.Errorf("got requeue after %s, want 36h", result.RequeueAfter)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: SecretName, Namespace: "istio-system"}, secret); err != nil {
+		t.Fatal(err)
+	}
+	if !metav1.IsControlledBy(secret, icp) {
+		t.Fatalf("secret is not controlled by the control plane: %v", secret.GetOwnerReferences())
+	}
+	if !bytes.Equal(secret.Data[RootCertKey], root.Data[RootCACertKey]) {
+		t.Error("unexpected root certificate in the plug-in CA secret")
+	}
+	if !bytes.Equal(secret.Data[CertChainKey], append(append([]byte{}, secret.Data[CACertKey]...), root.Data[RootCACertKey]...)) {
+		t.Error("certificate chain does not consist of the intermediate and the root CA")
+	}
+
+	authority, err := pki.ParseCA(root.Data[RootCACertKey], root.Data[RootCAKeyKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err := pki.ParseCA(secret.Data[CACertKey], secret.Data[CAKeyKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := intermediate.Certificate
+	if err := cert.CheckSignatureFrom(authority.Certificate); err != nil {
+		t.Errorf("intermediate CA is not issued by the root CA: %s", err)
+	}
+	if !cert.IsCA || !cert.MaxPathLenZero || len(cert.Subject.Locality) != 1 || cert.Subject.Locality[0] != "cluster1" {
+		t.Errorf("unexpected intermediate CA: %s", cert.Subject)
+	}
+	if !cert.NotAfter.Equal(now.Add(48 * time.Hour)) {
+		t.Errorf("unexpected expiry: %s", cert.NotAfter)
+	}
+
+	// the intermediate CA is kept until the renewal time
+	now = now.Add(24 * time.Hour)
+	kept, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.Checksum != result.Checksum || kept.RequeueAfter != 12*time.Hour {
+		t.Errorf("intermediate CA is renewed before its renewal time: %+v", kept)
+	}
+
+	// and it is renewed after the renewal time
+	now = now.Add(13 * time.Hour)
+	renewed, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.Checksum == result.Checksum || !renewed.Status.GetNotBefore().Equal(timestamp(now)) {
+		t.Errorf("intermediate CA is not renewed after its renewal time: %+v", renewed.Status)
+	}
+
+	// a new root CA issues a new intermediate CA right away
+	rotated := newRootCASecret(t, now, 365*24*time.Hour)
+	root.Data = rotated.Data
+	if err := c.Update(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(ctx, icp); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(secret.Data[RootCertKey], rotated.Data[RootCACertKey]) {
+		t.Error("intermediate CA is not reissued from the new root CA")
+	}
+
+	// the secret is removed when the plug-in CA is not configured anymore
+	icp.Spec.Ca = nil
+	result, err = r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != nil || result.Checksum != "" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); !k8serrors.IsNotFound(err) {
+		t.Errorf("expected the secret to be removed, got %v", err)
+	}
+}
+
+func TestReconcileCappedByRootCA(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// the root CA is namespaced to the control plane by default
+	icp := newControlPlane("cp-v112x", &v1alpha1.CAConfiguration{
+		RootCASecret: &v1alpha1.NamespacedName{Name: "root-ca"},
+	})
+	root := newRootCASecret(t, now, 10*24*time.Hour)
+	root.Namespace = icp.GetNamespace()
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, root).Build()
+	r := NewReconciler(c, c.Scheme())
+	r.now = func() time.Time {
+		return now
+	}
+
+	result, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Status.GetNotAfter().Equal(timestamp(now.Add(10 * 24 * time.Hour))) {
+		t.Errorf("intermediate CA outlives the root CA: %s", result.Status.GetNotAfter())
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("got requeue after %s, want none", result.RequeueAfter)
+	}
+
+	// such intermediate CAs cannot be renewed until the root CA is rotated
+	now = now.Add(24 * time.Hour)
+	kept, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.Checksum != result.Checksum {
+		t.Error("intermediate CA capped by the root CA is reissued")
+	}
+}
+
+func TestReconcileSharedSecret(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &v1alpha1.CAConfiguration{
+		RootCASecret: &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "cert-manager"},
+	}
+	icp := newControlPlane("cp-v112x", config)
+	other := newControlPlane("cp-v113x", config)
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, other, newRootCASecret(t, time.Now(), 365*24*time.Hour)).Build()
+	r := NewReconciler(c, c.Scheme())
+
+	result, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the other control plane of the namespace uses the same intermediate CA
+	shared, err := r.Reconcile(ctx, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shared.Checksum != result.Checksum {
+		t.Error("intermediate CA of the namespace is reissued by another control plane")
+	}
+
+	// and it does not remove the secret of the control plane which manages it
+	other.Spec.Ca = nil
+	if _, err := r.Reconcile(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: SecretName, Namespace: "istio-system"}, &corev1.Secret{}); err != nil {
+		t.Errorf("expected the secret to be kept, got %v", err)
+	}
+}
+
+func TestReconcileUnmanagedSecret(t *testing.T) {
+	t.Parallel()
+
+	icp := newControlPlane("cp-v112x", &v1alpha1.CAConfiguration{
+		RootCASecret: &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "cert-manager"},
+	})
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, newRootCASecret(t, time.Now(), 365*24*time.Hour), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: "istio-system"},
+	}).Build()
+
+	if _, err := NewReconciler(c, c.Scheme()).Reconcile(context.Background(), icp); err == nil {
+		t.Fatal("expected an error for a plug-in CA secret which is not managed by the operator")
+	}
+}
+
+func TestReconcileRootCARotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	icp := newControlPlane("cp-v112x", &v1alpha1.CAConfiguration{
+		RootCASecret: &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "cert-manager"},
+	})
+	root := newRootCASecret(t, now, 365*24*time.Hour)
+	next := newRootCASecret(t, now, 2*365*24*time.Hour)
+	next.Name = "root-ca-2023"
+
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(icp, root, next).Build()
+	r := NewReconciler(c, c.Scheme())
+	r.now = func() time.Time {
+		return now
+	}
+
+	result, err := r.Reconcile(ctx, icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	icp.Status.Ca = result.Status
+
+	icp.Spec.Ca.NextRootCASecret = &v1alpha1.NamespacedName{Name: "root-ca-2023", Namespace: "cert-manager"}
+
+	tests := []struct {
+		phase   v1alpha1.RootCARotationPhase
+		issuer  *corev1.Secret
+		trusted []*corev1.Secret
+		changed bool
+	}{
+		{phase: v1alpha1.RootCARotationPhase_AddingTrust, issuer: root, trusted: []*corev1.Secret{root, next}, changed: true},
+		{phase: v1alpha1.RootCARotationPhase_WaitingForPeers, issuer: root, trusted: []*corev1.Secret{root, next}},
+		{phase: v1alpha1.RootCARotationPhase_SwitchingSigner, issuer: next, trusted: []*corev1.Secret{next, root}, changed: true},
+		{phase: v1alpha1.RootCARotationPhase_RestartingWorkloads, issuer: next, trusted: []*corev1.Secret{next, root}},
+		{phase: v1alpha1.RootCARotationPhase_RemovingOldRoot, issuer: next, trusted: []*corev1.Secret{next}, changed: true},
+		{phase: v1alpha1.RootCARotationPhase_RotationCompleted, issuer: next, trusted: []*corev1.Secret{next}},
+	}
+
+	for _, test := range tests {
+		previous := result
+
+		result, err = r.Reconcile(ctx, icp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rotation := result.Status.GetRotation()
+		if rotation.GetPhase() != test.phase || rotation.GetNextRootCASecret() != "cert-manager/root-ca-2023" {
+			t.Fatalf("unexpected rotation status: %+v", rotation)
+		}
+		if result.NextRootCertificate == nil || (result.Checksum != previous.Checksum) != test.changed {
+			t.Errorf("%s: plug-in CA secret is expected to change: %t", test.phase, test.changed)
+		}
+
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Name: SecretName, Namespace: "istio-system"}, secret); err != nil {
+			t.Fatal(err)
+		}
+
+		issuer, err := pki.ParseCertificate(test.issuer.Data[RootCACertKey])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cert, _ := pki.ParseCertificate(secret.Data[CACertKey]); cert.CheckSignatureFrom(issuer) != nil {
+			t.Errorf("%s: intermediate CA is not issued by root CA %s", test.phase, test.issuer.Name)
+		}
+		if result.Status.GetRootCASecret() != client.ObjectKeyFromObject(test.issuer).String() {
+			t.Errorf("%s: unexpected issuer in the status: %s", test.phase, result.Status.GetRootCASecret())
+		}
+
+		trusted := make([]byte, 0)
+		for _, ca := range test.trusted {
+			trusted = append(trusted, ca.Data[RootCACertKey]...)
+		}
+		if !bytes.Equal(secret.Data[RootCertKey], trusted) {
+			t.Errorf("%s: unexpected trust bundle", test.phase)
+		}
+
+		// the caller advances the rotation to the next phase
+		icp.Status.Ca = result.Status
+		icp.Status.Ca.Rotation.Phase++
+	}
+}
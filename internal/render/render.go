@@ -0,0 +1,307 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"emperror.dev/errors"
+	logr "github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/controllers"
+	"github.com/banzaicloud/istio-operator/v2/internal/components"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/base"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/cni"
+	discovery_component "github.com/banzaicloud/istio-operator/v2/internal/components/discovery"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/istiomeshgateway"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/meshexpansion"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/nodeproxy"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/resourcesyncrule"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/sidecarinjector"
+	"github.com/banzaicloud/operator-tools/pkg/helm/templatereconciler"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+const (
+	defaultNamespace = "istio-system"
+	defaultClusterID = "Kubernetes"
+)
+
+type Options struct {
+	// Path of the IstioControlPlane resource
+	ControlPlaneFile string
+	// Path of the IstioMesh resource the control plane belongs to, optional
+	MeshFile string
+	// Paths of the IstioMeshGateway resources of the control plane
+	MeshGatewayFiles []string
+	// Namespace of the resources which do not specify one
+	Namespace string
+	// Cluster ID of the control plane if it is not specified in its spec
+	ClusterID string
+	// JWT policy of the control plane if it is not specified in its spec
+	JWTPolicy                string
+	ResourceSyncRulesEnabled bool
+	SupportedIstioVersion    string
+}
+
+type stringSliceValue []string
+
+func (s *stringSliceValue) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSliceValue) Set(value string) error {
+	*s = append(*s, value)
+
+	return nil
+}
+
+// Command parses the arguments of the render command and writes the rendered manifests to the output
+func Command(args []string, out io.Writer, supportedIstioVersion string) error {
+	options := Options{
+		SupportedIstioVersion: supportedIstioVersion,
+	}
+
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.StringVar(&options.ControlPlaneFile, "f", "", "Path of the IstioControlPlane resource to render.")
+	flags.StringVar(&options.MeshFile, "mesh", "", "Path of the IstioMesh resource the control plane belongs to.")
+	flags.Var((*stringSliceValue)(&options.MeshGatewayFiles), "imgw", "Path of an IstioMeshGateway resource of the control plane to render, can be repeated.")
+	flags.StringVar(&options.Namespace, "namespace", defaultNamespace, "Namespace of the resources which do not specify one.")
+	flags.StringVar(&options.ClusterID, "cluster-id", defaultClusterID, "Cluster ID of the control plane if it is not specified in its spec.")
+	flags.StringVar(&options.JWTPolicy, "jwt-policy", v1alpha1.JWTPolicyType_THIRD_PARTY_JWT.String(), "JWT policy of the control plane if it is not specified in its spec.")
+	flags.BoolVar(&options.ResourceSyncRulesEnabled, "cluster-registry-sync-rules-enabled", false, "Render the ResourceSyncRule resources for multi cluster setups.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: istio-operator render -f icp.yaml [--imgw imgw.yaml ...] [--mesh mesh.yaml]\n\n"+
+			"Renders the resources the operator would apply for the given custom resources without a cluster.\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if options.ControlPlaneFile == "" {
+		flags.Usage()
+
+		return errors.New("the IstioControlPlane resource must be specified with -f")
+	}
+
+	return Render(options, out)
+}
+
+// Render writes the resources of every component of the control plane and its mesh gateways
+// as a multi document YAML to the output
+func Render(options Options, out io.Writer) error {
+	if options.Namespace == "" {
+		options.Namespace = defaultNamespace
+	}
+
+	icp := &v1alpha1.IstioControlPlane{}
+	if err := readObject(options.ControlPlaneFile, options.Namespace, icp); err != nil {
+		return err
+	}
+
+	if icp.Spec == nil {
+		return errors.NewWithDetails("IstioControlPlane has no spec", "file", options.ControlPlaneFile)
+	}
+
+	if !controllers.IsIstioVersionSupported(icp.Spec.GetVersion()) {
+		return errors.NewWithDetails("unsupported Istio version", "version", icp.Spec.GetVersion())
+	}
+
+	mesh := &v1alpha1.IstioMesh{}
+	if options.MeshFile != "" {
+		if err := readObject(options.MeshFile, options.Namespace, mesh); err != nil {
+			return err
+		}
+
+		if icp.Spec.MeshID == "" {
+			icp.Spec.MeshID = mesh.GetName()
+		}
+
+		if icp.Spec.MeshID != mesh.GetName() || icp.GetNamespace() != mesh.GetNamespace() {
+			return errors.NewWithDetails("IstioMesh does not belong to the IstioControlPlane", "mesh", mesh.GetName(), "meshID", icp.Spec.MeshID)
+		}
+	}
+
+	if err := setDefaults(icp, options); err != nil {
+		return err
+	}
+
+	helmReconciler := newHelmReconciler()
+	log := logger.NewWithLogrLogger(logr.Discard())
+
+	componentReconcilers := []components.ComponentReconciler{}
+	if icp.GetSpec().GetMode() == v1alpha1.ModeType_ACTIVE {
+		componentReconcilers = append(componentReconcilers, base.NewComponentReconciler(helmReconciler, log, options.SupportedIstioVersion))
+	}
+	componentReconcilers = append(componentReconcilers,
+		discovery_component.NewChartReconciler(helmReconciler, v1alpha1.IstioControlPlaneProperties{
+			Mesh: mesh,
+		}, log),
+		cni.NewChartReconciler(helmReconciler),
+	)
+	if nodeproxy.Supported(icp) || utils.PointerToBool(icp.GetSpec().GetNodeProxy().GetEnabled()) {
+		componentReconcilers = append(componentReconcilers, nodeproxy.NewChartReconciler(helmReconciler))
+	}
+	componentReconcilers = append(componentReconcilers,
+		meshexpansion.NewChartReconciler(helmReconciler),
+		sidecarinjector.NewChartReconciler(helmReconciler),
+		resourcesyncrule.NewChartReconciler(helmReconciler, options.ResourceSyncRulesEnabled),
+	)
+
+	manifests := &bytes.Buffer{}
+	for _, reconciler := range componentReconcilers {
+		manifest, err := reconciler.GetManifest(icp)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not render component", "component", reconciler.Name())
+		}
+		manifests.Write(manifest)
+	}
+
+	// the mesh gateways depend on the checksums of the rendered configmaps of the control plane
+	if err := setStatusFromManifests(icp, manifests.Bytes()); err != nil {
+		return err
+	}
+
+	for _, file := range options.MeshGatewayFiles {
+		imgw := &v1alpha1.IstioMeshGateway{}
+		if err := readObject(file, options.Namespace, imgw); err != nil {
+			return err
+		}
+
+		if ref := imgw.GetSpec().GetIstioControlPlane(); ref.GetName() != icp.GetName() || ref.GetNamespace() != icp.GetNamespace() {
+			return errors.NewWithDetails("IstioMeshGateway does not belong to the IstioControlPlane", "file", file, "istioControlPlane", fmt.Sprintf("%s/%s", ref.GetNamespace(), ref.GetName()))
+		}
+
+		manifest, err := istiomeshgateway.NewChartReconciler(helmReconciler, controllers.NewIstioMeshGatewayProperties(imgw, icp), log).GetManifest(imgw)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "could not render IstioMeshGateway", "name", imgw.GetName())
+		}
+		manifests.Write(manifest)
+	}
+
+	_, err := manifests.WriteTo(out)
+
+	return errors.WithStackIf(err)
+}
+
+func readObject(path string, namespace string, object runtime.Object) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "could not read file", "file", path)
+	}
+
+	if err := yaml.Unmarshal(content, object); err != nil {
+		return errors.WrapIfWithDetails(err, "could not parse file", "file", path)
+	}
+
+	if o, ok := object.(interface {
+		GetNamespace() string
+		SetNamespace(string)
+	}); ok && o.GetNamespace() == "" {
+		o.SetNamespace(namespace)
+	}
+
+	return nil
+}
+
+// setDefaults sets the defaults the operator would set with the values which would be detected from the cluster
+// replaced by the options
+func setDefaults(icp *v1alpha1.IstioControlPlane, options Options) error {
+	if err := controllers.SetStaticDefaults(icp, nil); err != nil {
+		return err
+	}
+
+	if icp.Spec.JwtPolicy == v1alpha1.JWTPolicyType_UNSPECIFIED {
+		policy, ok := v1alpha1.JWTPolicyType_value[options.JWTPolicy]
+		if !ok {
+			return errors.NewWithDetails("invalid JWT policy", "policy", options.JWTPolicy)
+		}
+		icp.Spec.JwtPolicy = v1alpha1.JWTPolicyType(policy)
+	}
+
+	if icp.Spec.ClusterID == "" {
+		icp.Spec.ClusterID = options.ClusterID
+	}
+
+	icp.Status.ClusterID = icp.Spec.ClusterID
+
+	return nil
+}
+
+// setStatusFromManifests sets the mesh config and the checksums to the status of the control plane
+// the same way the operator does from the applied configmaps
+func setStatusFromManifests(icp *v1alpha1.IstioControlPlane, manifests []byte) error {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifests), 4096)
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return errors.WrapIf(err, "could not parse rendered manifests")
+		}
+
+		if object.GetKind() != "ConfigMap" || object.GetNamespace() != icp.GetNamespace() ||
+			!labels.SelectorFromSet(icp.RevisionLabels()).Matches(labels.Set(object.GetLabels())) {
+			continue
+		}
+
+		configmap := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, configmap); err != nil {
+			return errors.WrapIf(err, "could not convert configmap")
+		}
+
+		var err error
+		switch configmap.GetLabels()["istio"] {
+		case "meshconfig":
+			err = controllers.SetMeshConfigToStatus(icp, configmap)
+		case "sidecar-injector":
+			err = controllers.SetSidecarInjectorChecksumToStatus(icp, configmap)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func newHelmReconciler() *templatereconciler.HelmReconciler {
+	return templatereconciler.NewHelmReconcilerWith(
+		nil,
+		nil,
+		logr.Discard(),
+		&fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{}},
+		templatereconciler.ManageNamespace(false),
+	)
+}
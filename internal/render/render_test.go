@@ -0,0 +1,106 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/kylelemons/godebug/pretty"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/render"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	if err := render.Render(render.Options{
+		ControlPlaneFile: "testdata/icp.yaml",
+		MeshFile:         "testdata/mesh.yaml",
+		MeshGatewayFiles: []string{"testdata/imgw.yaml"},
+		ClusterID:        "Kubernetes",
+		JWTPolicy:        v1alpha1.JWTPolicyType_THIRD_PARTY_JWT.String(),
+	}, out); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := map[string]*unstructured.Unstructured{}
+	decoder := k8syaml.NewYAMLOrJSONDecoder(out, 4096)
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			t.Fatal(err)
+		}
+		if object.Object == nil {
+			continue
+		}
+		objects[fmt.Sprintf("%s/%s/%s", object.GetKind(), object.GetNamespace(), object.GetName())] = object
+	}
+
+	for _, key := range []string{
+		"CustomResourceDefinition//virtualservices.networking.istio.io",
+		"Deployment/istio-system/istiod-icp-v112x-sample",
+		"ConfigMap/istio-system/istio-icp-v112x-sample.istio-system",
+		"ConfigMap/istio-system/istio-sidecar-injector-icp-v112x-sample.istio-system",
+		"Deployment/istio-system/imgw-sample",
+		"Service/istio-system/imgw-sample",
+	} {
+		if _, ok := objects[key]; !ok {
+			t.Errorf("%s is missing from the rendered manifests", key)
+		}
+	}
+
+	if t.Failed() {
+		return
+	}
+
+	mesh, _, _ := unstructured.NestedString(objects["ConfigMap/istio-system/istio-icp-v112x-sample.istio-system"].Object, "data", "mesh")
+	annotations, _, _ := unstructured.NestedStringMap(objects["Deployment/istio-system/imgw-sample"].Object, "spec", "template", "metadata", "annotations")
+
+	if diff := pretty.Compare(annotations[v1alpha1.MeshConfigChecksumAnnotation], fmt.Sprintf("%x", sha256.Sum256([]byte(mesh)))); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if annotations[v1alpha1.SidecarInjectionChecksumAnnotation] == "" {
+		t.Error("sidecar injection checksum annotation is missing from the mesh gateway")
+	}
+}
+
+func TestRenderForeignMeshGateway(t *testing.T) {
+	t.Parallel()
+
+	err := render.Render(render.Options{
+		ControlPlaneFile: "testdata/icp.yaml",
+		MeshGatewayFiles: []string{"testdata/imgw.yaml"},
+		Namespace:        "default",
+		ClusterID:        "Kubernetes",
+		JWTPolicy:        v1alpha1.JWTPolicyType_THIRD_PARTY_JWT.String(),
+	}, io.Discard)
+	if err == nil {
+		t.Fatal("mesh gateway of another control plane must not be rendered")
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/models"
+)
+
+const (
+	serviceName = "istio-operator"
+	tracerName  = "github.com/banzaicloud/istio-operator/v2"
+)
+
+// Setup installs the global tracer provider which exports the spans either to an OTLP collector or to a file,
+// the returned function flushes the remaining spans and shuts the provider down
+func Setup(ctx context.Context, config models.TracingConfiguration, version string) (func(context.Context) error, error) {
+	if !config.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	sampler, err := newSampler(config.SampleRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+
+	if config.File != "" {
+		file, err = os.OpenFile(config.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not open trace file", "file", config.File)
+		}
+
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, errors.WrapIf(err, "could not create file exporter")
+		}
+	} else {
+		options := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(config.OTLPEndpoint),
+		}
+		if config.OTLPInsecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err = otlptracegrpc.New(ctx, options...)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "could not create OTLP exporter", "endpoint", config.OTLPEndpoint)
+		}
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			err = errors.Combine(err, file.Close())
+		}
+
+		return err
+	}, nil
+}
+
+// newSampler returns the sampler of the ratio of the reconciliations to trace, which must be between 0 and 1
+func newSampler(ratio float64) (sdktrace.Sampler, error) {
+	switch {
+	case ratio < 0 || ratio > 1:
+		return nil, errors.NewWithDetails("tracing sample ratio must be between 0 and 1", "ratio", ratio)
+	case ratio == 0:
+		return sdktrace.NeverSample(), nil
+	case ratio == 1:
+		return sdktrace.AlwaysSample(), nil
+	default:
+		return sdktrace.TraceIDRatioBased(ratio), nil
+	}
+}
+
+// Start starts a span with the tracer of the operator, the global tracer provider is a no-op unless tracing is set up
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span and records the error on it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Step runs a step of a reconciliation in its own span
+func Step(ctx context.Context, name string, step func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, name)
+	err := step(ctx)
+	End(span, err)
+
+	return err
+}
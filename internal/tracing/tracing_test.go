@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/istio-operator/v2/internal/models"
+)
+
+func TestFileExporter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := Setup(context.Background(), models.TracingConfiguration{
+		File:        file,
+		SampleRatio: 1,
+	}, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, span := Start(context.Background(), "IstioControlPlane")
+	if err := Step(ctx, "getMeshNetworks", func(ctx context.Context) error {
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Step(ctx, "component/istio-discovery", func(ctx context.Context) error {
+		return errors.New("could not reconcile")
+	}); err == nil {
+		t.Fatal("error of the step is not returned")
+	}
+	End(span, nil)
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{`"Name":"IstioControlPlane"`, `"Name":"getMeshNetworks"`, `"Name":"component/istio-discovery"`, `could not reconcile`} {
+		if !strings.Contains(string(content), s) {
+			t.Errorf("exported spans do not contain %s:\n%s", s, content)
+		}
+	}
+}
+
+func TestNewSampler(t *testing.T) {
+	tests := []struct {
+		ratio       float64
+		description string
+		invalid     bool
+	}{
+		{ratio: 0, description: "AlwaysOffSampler"},
+		{ratio: 0.5, description: "TraceIDRatioBased{0.5}"},
+		{ratio: 1, description: "AlwaysOnSampler"},
+		{ratio: -0.1, invalid: true},
+		{ratio: 1.5, invalid: true},
+	}
+
+	for _, tc := range tests {
+		sampler, err := newSampler(tc.ratio)
+		if tc.invalid {
+			if err == nil {
+				t.Errorf("ratio %v should be rejected", tc.ratio)
+			}
+
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if sampler.Description() != tc.description {
+			t.Errorf("unexpected sampler of ratio %v: %s", tc.ratio, sampler.Description())
+		}
+	}
+}
+
+func TestSetupRejectsInvalidSampleRatio(t *testing.T) {
+	if _, err := Setup(context.Background(), models.TracingConfiguration{
+		File:        filepath.Join(t.TempDir(), "traces.json"),
+		SampleRatio: 2,
+	}, "test"); err == nil {
+		t.Fatal("invalid sample ratio should be rejected")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -212,6 +213,36 @@ func (p IMGWAddressChangePredicate) Generic(e event.GenericEvent) bool {
 	return false
 }
 
+// UnstructuredStatusChangePredicate triggers on the status changes of unstructured objects,
+// like the cert-manager certificates whose types are not known by the operator
+type UnstructuredStatusChangePredicate struct{}
+
+func (p UnstructuredStatusChangePredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+func (p UnstructuredStatusChangePredicate) Update(e event.UpdateEvent) bool {
+	o, ok := e.ObjectOld.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+
+	n, ok := e.ObjectNew.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(o.Object["status"], n.Object["status"])
+}
+
+func (p UnstructuredStatusChangePredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+func (p UnstructuredStatusChangePredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
+
 type PICPStatusChangePredicate struct{}
 
 func (p PICPStatusChangePredicate) Create(e event.CreateEvent) bool {
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/controllers"
+	"github.com/banzaicloud/istio-operator/v2/internal/assets"
+	"github.com/banzaicloud/istio-operator/v2/internal/components/nodeproxy"
+	"github.com/banzaicloud/istio-operator/v2/internal/pluginca"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+// +kubebuilder:webhook:path=/validate-servicemesh-cisco-com-v1alpha1-istiocontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=istiocontrolplanes,verbs=create;update,versions=v1alpha1,name=vistiocontrolplane.servicemesh.cisco.com,admissionReviewVersions=v1
+
+type IstioControlPlaneValidator struct {
+	Client client.Client
+}
+
+func (v *IstioControlPlaneValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	icp, ok := obj.(*v1alpha1.IstioControlPlane)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", obj))
+	}
+
+	return v.validate(ctx, icp, nil)
+}
+
+func (v *IstioControlPlaneValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	icp, ok := newObj.(*v1alpha1.IstioControlPlane)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", newObj))
+	}
+
+	old, ok := oldObj.(*v1alpha1.IstioControlPlane)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", oldObj))
+	}
+
+	// metadata only updates, like removing finalizers, must not be blocked by objects stored before the webhook was in place
+	if icp.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(old.GetSpec(), icp.GetSpec()) {
+		return nil
+	}
+
+	return v.validate(ctx, icp, old)
+}
+
+func (v *IstioControlPlaneValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *IstioControlPlaneValidator) validate(ctx context.Context, icp, old *v1alpha1.IstioControlPlane) error {
+	allErrs := ValidateIstioControlPlane(icp, old)
+	if len(allErrs) == 0 {
+		clusterErrs, err := v.validateClusterConsistency(ctx, icp)
+		if err != nil {
+			return err
+		}
+		allErrs = append(allErrs, clusterErrs...)
+	}
+
+	return newInvalidError("IstioControlPlane", icp.GetName(), allErrs)
+}
+
+// validateClusterConsistency makes sure that control planes of the same mesh agree on the cluster ID and the network
+// of the cluster they are running on, since both are cluster level properties
+func (v *IstioControlPlaneValidator) validateClusterConsistency(ctx context.Context, icp *v1alpha1.IstioControlPlane) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	icps := &v1alpha1.IstioControlPlaneList{}
+	if err := v.Client.List(ctx, icps); err != nil {
+		return nil, errors.WrapIf(err, "could not list Istio control planes")
+	}
+
+	for _, other := range icps.Items {
+		if other.GetName() == icp.GetName() && other.GetNamespace() == icp.GetNamespace() {
+			continue
+		}
+
+		if other.GetSpec().GetMeshID() != icp.GetSpec().GetMeshID() {
+			continue
+		}
+
+		if clusterID := other.GetSpec().GetClusterID(); clusterID != "" && icp.GetSpec().GetClusterID() != "" && clusterID != icp.GetSpec().GetClusterID() {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("clusterID"), icp.GetSpec().GetClusterID(),
+				fmt.Sprintf("must match the cluster ID of Istio control plane %s/%s (%s)", other.GetNamespace(), other.GetName(), clusterID)))
+		}
+
+		if other.GetSpec().GetNetworkName() != icp.GetSpec().GetNetworkName() {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("networkName"), icp.GetSpec().GetNetworkName(),
+				fmt.Sprintf("must match the network of Istio control plane %s/%s (%s)", other.GetNamespace(), other.GetName(), other.GetSpec().GetNetworkName())))
+		}
+	}
+
+	return allErrs, nil
+}
+
+// ValidateIstioControlPlane validates the spec of an IstioControlPlane, old is nil on create
+func ValidateIstioControlPlane(icp *v1alpha1.IstioControlPlane, old *v1alpha1.IstioControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	spec := icp.GetSpec()
+	if spec == nil {
+		return append(allErrs, field.Required(specPath, ""))
+	}
+
+	if spec.GetVersion() == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("version"), "the intended Istio version must be set"))
+	} else if !controllers.IsIstioVersionSupported(spec.GetVersion()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("version"), spec.GetVersion(), fmt.Sprintf("intended Istio version is unsupported by this version of the operator, supported versions: %s", strings.Join(assets.SupportedVersions(), ", "))))
+	}
+
+	switch spec.GetMode() {
+	case v1alpha1.ModeType_ACTIVE, v1alpha1.ModeType_PASSIVE:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("mode"), spec.GetMode().String(), []string{
+			v1alpha1.ModeType_ACTIVE.String(),
+			v1alpha1.ModeType_PASSIVE.String(),
+		}))
+	}
+
+	// network name and cluster ID end up in topology labels on the workloads
+	allErrs = append(allErrs, validateLabelValue(spec.GetNetworkName(), specPath.Child("networkName"))...)
+	allErrs = append(allErrs, validateLabelValue(spec.GetClusterID(), specPath.Child("clusterID"))...)
+
+	if old != nil && old.GetSpec() != nil {
+		// peers reference each other through the cluster ID, changing it would break the multi cluster setup
+		if oldClusterID := old.GetSpec().GetClusterID(); oldClusterID != "" && spec.GetClusterID() != "" && oldClusterID != spec.GetClusterID() {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("clusterID"), spec.GetClusterID(), "field is immutable once set"))
+		}
+	}
+
+	allErrs = append(allErrs, validateK8sResourceOverlays(spec.GetK8SResourceOverlays(), specPath.Child("k8sResourceOverlays"))...)
+	allErrs = append(allErrs, validateBaseKubernetesResourceConfig(spec.GetIstiod().GetDeployment(), specPath.Child("istiod", "deployment"))...)
+	allErrs = append(allErrs, validateBaseKubernetesResourceConfig(spec.GetSidecarInjector().GetDeployment(), specPath.Child("sidecarInjector", "deployment"))...)
+
+	gatewayPath := specPath.Child("meshExpansion", "gateway")
+	allErrs = append(allErrs, validateBaseKubernetesResourceConfig(spec.GetMeshExpansion().GetGateway().GetDeployment(), gatewayPath.Child("deployment"))...)
+	allErrs = append(allErrs, validateK8sResourceOverlays(spec.GetMeshExpansion().GetGateway().GetK8SResourceOverlays(), gatewayPath.Child("k8sResourceOverlays"))...)
+
+	// the proxies of the Istio version must be able to run as node proxies of the sidecarless data plane
+	if utils.PointerToBool(spec.GetNodeProxy().GetEnabled()) && controllers.IsIstioVersionSupported(spec.GetVersion()) && !nodeproxy.Supported(icp) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("nodeProxy", "enabled"), fmt.Sprintf("the node proxy is not supported with Istio version %s", spec.GetVersion())))
+	}
+
+	allErrs = append(allErrs, validateCAConfiguration(icp, specPath.Child("ca"))...)
+	allErrs = append(allErrs, validateNetworks(spec.GetNetworks(), specPath.Child("networks"))...)
+
+	return allErrs
+}
+
+func validateNetworks(networks []*v1alpha1.NetworkConfiguration, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := make(map[string]bool)
+	for i, network := range networks {
+		networkPath := path.Index(i)
+
+		if network.GetName() == "" {
+			allErrs = append(allErrs, field.Required(networkPath.Child("name"), ""))
+		} else if names[network.GetName()] {
+			allErrs = append(allErrs, field.Duplicate(networkPath.Child("name"), network.GetName()))
+		} else {
+			allErrs = append(allErrs, validateLabelValue(network.GetName(), networkPath.Child("name"))...)
+		}
+		names[network.GetName()] = true
+
+		// an empty selector would select every gateway of the control plane
+		if len(network.GetGatewaySelector()) == 0 && len(network.GetGatewayAddresses()) == 0 && len(network.GetClusterSelector()) == 0 {
+			allErrs = append(allErrs, field.Required(networkPath.Child("gatewaySelector"), "either gatewaySelector, gatewayAddresses or clusterSelector must be set"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabels(network.GetGatewaySelector(), networkPath.Child("gatewaySelector"))...)
+		allErrs = append(allErrs, metav1validation.ValidateLabels(network.GetClusterSelector(), networkPath.Child("clusterSelector"))...)
+
+		if network.GetGatewayPort() > 65535 {
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("gatewayPort"), network.GetGatewayPort(), validation.InclusiveRangeError(1, 65535)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCAConfiguration(icp *v1alpha1.IstioControlPlane, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	config := icp.GetSpec().GetCa()
+	if config == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateSecretName(config.GetRootCASecret().GetName(), path.Child("rootCASecret", "name"))...)
+
+	if config.GetNextRootCASecret() != nil {
+		nextPath := path.Child("nextRootCASecret")
+		allErrs = append(allErrs, validateSecretName(config.GetNextRootCASecret().GetName(), nextPath.Child("name"))...)
+
+		if pluginca.NextRootCASecretKey(icp) == pluginca.RootCASecretKey(icp) {
+			allErrs = append(allErrs, field.Invalid(nextPath, pluginca.NextRootCASecretKey(icp).String(), "the next root CA secret must differ from the current one"))
+		}
+	}
+
+	if size := config.GetRestartBatchSize(); size != nil && *size < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("restartBatchSize"), *size, "must be at least 1"))
+	}
+
+	if _, _, err := pluginca.Durations(config); err != nil {
+		allErrs = append(allErrs, field.Invalid(path, fmt.Sprintf("intermediateDuration: %q, intermediateRenewBefore: %q", config.GetIntermediateDuration(), config.GetIntermediateRenewBefore()), err.Error()))
+	}
+
+	return allErrs
+}
+
+func validateSecretName(name string, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == "" {
+		return append(allErrs, field.Required(path, ""))
+	}
+
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		allErrs = append(allErrs, field.Invalid(path, name, msg))
+	}
+
+	return allErrs
+}
+
+// +kubebuilder:webhook:path=/mutate-servicemesh-cisco-com-v1alpha1-istiocontrolplane,mutating=true,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=istiocontrolplanes,verbs=create;update,versions=v1alpha1,name=mistiocontrolplane.servicemesh.cisco.com,admissionReviewVersions=v1
+
+// IstioControlPlaneDefaulter persists the defaults of Istio control planes, so that the stored spec shows the
+// configuration that is in effect
+type IstioControlPlaneDefaulter struct {
+	Client                    client.Client
+	Config                    *rest.Config
+	Log                       logger.Logger
+	ClusterRegistryAPIEnabled bool
+
+	decoder *admission.Decoder
+}
+
+func (d *IstioControlPlaneDefaulter) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+
+	return nil
+}
+
+func (d *IstioControlPlaneDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	icp := &v1alpha1.IstioControlPlane{}
+	if err := d.decoder.Decode(req, icp); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var old *v1alpha1.IstioControlPlane
+	if req.Operation == admissionv1.Update {
+		old = &v1alpha1.IstioControlPlane{}
+		if err := d.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	if icp.GetSpec() == nil || icp.GetDeletionTimestamp() != nil {
+		return admission.Allowed("")
+	}
+
+	logger := d.Log.WithValues("istiocontrolplane", client.ObjectKeyFromObject(icp))
+	if err := controllers.SetDefaults(ctx, d.Client, icp, old, d.Config, logger, d.ClusterRegistryAPIEnabled); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	marshalled, err := json.Marshal(icp)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled)
+}
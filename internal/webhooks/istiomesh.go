@@ -0,0 +1,95 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-servicemesh-cisco-com-v1alpha1-istiomesh,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=istiomeshes,verbs=create;update,versions=v1alpha1,name=vistiomesh.servicemesh.cisco.com,admissionReviewVersions=v1
+
+type IstioMeshValidator struct{}
+
+func (v *IstioMeshValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return v.validate(obj)
+}
+
+func (v *IstioMeshValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	return v.validate(newObj)
+}
+
+func (v *IstioMeshValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *IstioMeshValidator) validate(obj runtime.Object) error {
+	mesh, ok := obj.(*v1alpha1.IstioMesh)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", obj))
+	}
+
+	return newInvalidError("IstioMesh", mesh.GetName(), ValidateIstioMesh(mesh))
+}
+
+// ValidateIstioMesh validates the mesh config of an IstioMesh
+func ValidateIstioMesh(mesh *v1alpha1.IstioMesh) field.ErrorList {
+	allErrs := field.ErrorList{}
+	configPath := field.NewPath("spec", "config")
+
+	config := mesh.GetSpec().GetConfig()
+	if config == nil {
+		return allErrs
+	}
+
+	if config.GetTrustDomain() != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(config.GetTrustDomain()) {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("trustDomain"), config.GetTrustDomain(), msg))
+		}
+	}
+
+	for i, alias := range config.GetTrustDomainAliases() {
+		for _, msg := range validation.IsDNS1123Subdomain(alias) {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("trustDomainAliases").Index(i), alias, msg))
+		}
+	}
+
+	if config.GetRootNamespace() != "" {
+		for _, msg := range validation.IsDNS1123Label(config.GetRootNamespace()) {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("rootNamespace"), config.GetRootNamespace(), msg))
+		}
+	}
+
+	if address := config.GetDefaultConfig().GetDiscoveryAddress(); address != "" {
+		if _, port, err := net.SplitHostPort(address); err != nil {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("defaultConfig", "discoveryAddress"), address, err.Error()))
+		} else if p, err := strconv.Atoi(port); err != nil || validation.IsValidPortNum(p) != nil {
+			allErrs = append(allErrs, field.Invalid(configPath.Child("defaultConfig", "discoveryAddress"), address, "invalid port number"))
+		}
+	}
+
+	return allErrs
+}
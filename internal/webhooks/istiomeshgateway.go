@@ -0,0 +1,286 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/gatewaytls"
+)
+
+// +kubebuilder:webhook:path=/validate-servicemesh-cisco-com-v1alpha1-istiomeshgateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=istiomeshgateways,verbs=create;update,versions=v1alpha1,name=vistiomeshgateway.servicemesh.cisco.com,admissionReviewVersions=v1
+
+type IstioMeshGatewayValidator struct {
+	Client client.Client
+}
+
+func (v *IstioMeshGatewayValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	imgw, ok := obj.(*v1alpha1.IstioMeshGateway)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", obj))
+	}
+
+	return v.validate(ctx, imgw)
+}
+
+func (v *IstioMeshGatewayValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	imgw, ok := newObj.(*v1alpha1.IstioMeshGateway)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", newObj))
+	}
+
+	old, ok := oldObj.(*v1alpha1.IstioMeshGateway)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", oldObj))
+	}
+
+	// metadata only updates, like removing finalizers, must not be blocked by objects stored before the webhook was in place
+	if imgw.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(old.GetSpec(), imgw.GetSpec()) {
+		return nil
+	}
+
+	return v.validate(ctx, imgw)
+}
+
+func (v *IstioMeshGatewayValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *IstioMeshGatewayValidator) validate(ctx context.Context, imgw *v1alpha1.IstioMeshGateway) error {
+	allErrs := ValidateIstioMeshGateway(imgw)
+	if len(allErrs) == 0 {
+		refErrs, err := v.validateIstioControlPlaneReference(ctx, imgw)
+		if err != nil {
+			return err
+		}
+		allErrs = append(allErrs, refErrs...)
+	}
+
+	return newInvalidError("IstioMeshGateway", imgw.GetName(), allErrs)
+}
+
+func (v *IstioMeshGatewayValidator) validateIstioControlPlaneReference(ctx context.Context, imgw *v1alpha1.IstioMeshGateway) (field.ErrorList, error) {
+	ref := imgw.GetSpec().GetIstioControlPlane()
+
+	err := v.Client.Get(ctx, client.ObjectKey{
+		Name:      ref.GetName(),
+		Namespace: ref.GetNamespace(),
+	}, &v1alpha1.IstioControlPlane{})
+	if k8serrors.IsNotFound(err) {
+		return field.ErrorList{
+			field.NotFound(field.NewPath("spec", "istioControlPlane"), fmt.Sprintf("%s/%s", ref.GetNamespace(), ref.GetName())),
+		}, nil
+	}
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get Istio control plane", "name", ref.GetName(), "namespace", ref.GetNamespace())
+	}
+
+	return nil, nil
+}
+
+// ValidateIstioMeshGateway validates the spec of an IstioMeshGateway
+func ValidateIstioMeshGateway(imgw *v1alpha1.IstioMeshGateway) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	spec := imgw.GetSpec()
+	if spec == nil {
+		return append(allErrs, field.Required(specPath, ""))
+	}
+
+	switch spec.GetType() {
+	case v1alpha1.GatewayType_ingress, v1alpha1.GatewayType_egress:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("type"), spec.GetType().String(), []string{
+			v1alpha1.GatewayType_ingress.String(),
+			v1alpha1.GatewayType_egress.String(),
+		}))
+	}
+
+	if spec.GetService() == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("service"), ""))
+	}
+
+	icpPath := specPath.Child("istioControlPlane")
+	if spec.GetIstioControlPlane() == nil {
+		allErrs = append(allErrs, field.Required(icpPath, "reference to the Istio control plane must be set"))
+	} else {
+		if spec.GetIstioControlPlane().GetName() == "" {
+			allErrs = append(allErrs, field.Required(icpPath.Child("name"), ""))
+		}
+		if spec.GetIstioControlPlane().GetNamespace() == "" {
+			allErrs = append(allErrs, field.Required(icpPath.Child("namespace"), ""))
+		}
+	}
+
+	allErrs = append(allErrs, validateBaseKubernetesResourceConfig(spec.GetDeployment(), specPath.Child("deployment"))...)
+	allErrs = append(allErrs, validateK8sResourceOverlays(spec.GetK8SResourceOverlays(), specPath.Child("k8sResourceOverlays"))...)
+	allErrs = append(allErrs, validateEgressConfiguration(spec, specPath.Child("egress"))...)
+	allErrs = append(allErrs, validateTLSConfiguration(spec.GetTls(), specPath.Child("tls"))...)
+
+	return allErrs
+}
+
+// egressPortKinds maps the protocols of the egress ports to the kind of the gateway server they are served by
+var egressPortKinds = map[string]string{
+	"HTTP":  "HTTP",
+	"HTTPS": "TLS",
+	"TLS":   "TLS",
+	"TCP":   "TCP",
+}
+
+func validateEgressConfiguration(spec *v1alpha1.IstioMeshGatewaySpec, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	hosts := spec.GetEgress().GetHosts()
+	if len(hosts) == 0 {
+		return allErrs
+	}
+
+	if spec.GetType() != v1alpha1.GatewayType_egress {
+		return append(allErrs, field.Forbidden(path, "only egress gateways can have egress hosts"))
+	}
+
+	// the traffic of the sidecars is sent to the same port of the gateway service
+	servicePorts := make(map[int32]bool)
+	for _, port := range spec.GetService().GetPorts() {
+		servicePorts[port.GetPort()] = true
+	}
+
+	seenHosts := make(map[string]bool)
+	portKinds := make(map[uint32]string)
+	for i, host := range hosts {
+		hostPath := path.Child("hosts").Index(i)
+
+		if host.GetHost() == "" {
+			allErrs = append(allErrs, field.Required(hostPath.Child("host"), ""))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(host.GetHost()) {
+				allErrs = append(allErrs, field.Invalid(hostPath.Child("host"), host.GetHost(), msg))
+			}
+			if seenHosts[host.GetHost()] {
+				allErrs = append(allErrs, field.Duplicate(hostPath.Child("host"), host.GetHost()))
+			}
+			seenHosts[host.GetHost()] = true
+		}
+
+		if len(host.GetPorts()) == 0 {
+			allErrs = append(allErrs, field.Required(hostPath.Child("ports"), ""))
+		}
+
+		hostPorts := make(map[uint32]bool)
+		for j, port := range host.GetPorts() {
+			portPath := hostPath.Child("ports").Index(j)
+
+			if hostPorts[port.GetNumber()] {
+				allErrs = append(allErrs, field.Duplicate(portPath.Child("number"), port.GetNumber()))
+
+				continue
+			}
+			hostPorts[port.GetNumber()] = true
+
+			if port.GetNumber() < 1 || port.GetNumber() > 65535 {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("number"), port.GetNumber(), validation.InclusiveRangeError(1, 65535)))
+			} else if !servicePorts[int32(port.GetNumber())] {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("number"), port.GetNumber(), "port must be exposed by the service of the gateway"))
+			}
+
+			kind, ok := egressPortKinds[port.GetProtocol()]
+			if !ok {
+				allErrs = append(allErrs, field.NotSupported(portPath.Child("protocol"), port.GetProtocol(), []string{"HTTP", "HTTPS", "TLS", "TCP"}))
+
+				continue
+			}
+
+			// TCP traffic cannot be told apart by host, so TCP ports cannot be shared by hosts
+			if current, ok := portKinds[port.GetNumber()]; ok && (current != kind || kind == "TCP") {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("protocol"), port.GetProtocol(), fmt.Sprintf("port %d is already used by another host with a conflicting protocol", port.GetNumber())))
+			}
+			portKinds[port.GetNumber()] = kind
+		}
+	}
+
+	return allErrs
+}
+
+func validateTLSConfiguration(config *v1alpha1.IstioMeshGatewayTLS, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.GetSecretName() == "" {
+		allErrs = append(allErrs, field.Required(path.Child("secretName"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(config.GetSecretName()) {
+			allErrs = append(allErrs, field.Invalid(path.Child("secretName"), config.GetSecretName(), msg))
+		}
+	}
+
+	if len(config.GetDnsNames()) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("dnsNames"), ""))
+	}
+
+	seenNames := make(map[string]bool)
+	for i, name := range config.GetDnsNames() {
+		namePath := path.Child("dnsNames").Index(i)
+
+		if seenNames[name] {
+			allErrs = append(allErrs, field.Duplicate(namePath, name))
+
+			continue
+		}
+		seenNames[name] = true
+
+		// the certificates can be issued for wildcard names as well
+		for _, msg := range validation.IsDNS1123Subdomain(strings.TrimPrefix(name, "*.")) {
+			allErrs = append(allErrs, field.Invalid(namePath, name, msg))
+		}
+	}
+
+	if _, _, err := gatewaytls.Durations(config); err != nil {
+		allErrs = append(allErrs, field.Invalid(path, fmt.Sprintf("duration: %q, renewBefore: %q", config.GetDuration(), config.GetRenewBefore()), err.Error()))
+	}
+
+	if issuer := config.GetCertManagerIssuer(); issuer != nil {
+		issuerPath := path.Child("certManagerIssuer")
+
+		if issuer.GetName() == "" {
+			allErrs = append(allErrs, field.Required(issuerPath.Child("name"), ""))
+		}
+
+		switch issuer.GetKind() {
+		case "", "Issuer", "ClusterIssuer":
+		default:
+			allErrs = append(allErrs, field.NotSupported(issuerPath.Child("kind"), issuer.GetKind(), []string{"Issuer", "ClusterIssuer"}))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-servicemesh-cisco-com-v1alpha1-istiorevisiontag,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=istiorevisiontags,verbs=create;update,versions=v1alpha1,name=vistiorevisiontag.servicemesh.cisco.com,admissionReviewVersions=v1
+
+type IstioRevisionTagValidator struct {
+	Client client.Client
+}
+
+func (v *IstioRevisionTagValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return v.validate(ctx, obj)
+}
+
+func (v *IstioRevisionTagValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	return v.validate(ctx, newObj)
+}
+
+func (v *IstioRevisionTagValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *IstioRevisionTagValidator) validate(ctx context.Context, obj runtime.Object) error {
+	tag, ok := obj.(*v1alpha1.IstioRevisionTag)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", obj))
+	}
+
+	if tag.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	allErrs := ValidateIstioRevisionTag(tag)
+	if len(allErrs) == 0 {
+		ref := tag.GetSpec().GetIstioControlPlane()
+
+		err := v.Client.Get(ctx, client.ObjectKey{
+			Name:      ref.GetName(),
+			Namespace: ref.GetNamespace(),
+		}, &v1alpha1.IstioControlPlane{})
+		if k8serrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(field.NewPath("spec", "istioControlPlane"), fmt.Sprintf("%s/%s", ref.GetNamespace(), ref.GetName())))
+		} else if err != nil {
+			return errors.WrapIfWithDetails(err, "could not get Istio control plane", "name", ref.GetName(), "namespace", ref.GetNamespace())
+		}
+	}
+
+	return newInvalidError("IstioRevisionTag", tag.GetName(), allErrs)
+}
+
+// ValidateIstioRevisionTag validates the name and the spec of an IstioRevisionTag
+func ValidateIstioRevisionTag(tag *v1alpha1.IstioRevisionTag) field.ErrorList {
+	allErrs := field.ErrorList{}
+	namePath := field.NewPath("metadata", "name")
+	specPath := field.NewPath("spec")
+
+	// the name of the tag is used as the value of the istio.io/rev label, it must not contain dots
+	// so that it cannot be mistaken for the namespaced revision of a control plane
+	for _, msg := range validation.IsDNS1123Label(tag.GetName()) {
+		allErrs = append(allErrs, field.Invalid(namePath, tag.GetName(), msg))
+	}
+	if tag.GetName() == "default" {
+		allErrs = append(allErrs, field.Invalid(namePath, tag.GetName(), "the default tag is not supported, namespaces labeled with istio-injection=enabled are injected by the default revision"))
+	}
+
+	spec := tag.GetSpec()
+	if spec == nil {
+		return append(allErrs, field.Required(specPath, ""))
+	}
+
+	icpPath := specPath.Child("istioControlPlane")
+	if spec.GetIstioControlPlane() == nil {
+		allErrs = append(allErrs, field.Required(icpPath, "reference to the Istio control plane must be set"))
+	} else {
+		if spec.GetIstioControlPlane().GetName() == "" {
+			allErrs = append(allErrs, field.Required(icpPath.Child("name"), ""))
+		}
+		if spec.GetIstioControlPlane().GetNamespace() == "" {
+			allErrs = append(allErrs, field.Required(icpPath.Child("namespace"), ""))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-servicemesh-cisco-com-v1alpha1-meshpeer,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicemesh.cisco.com,resources=meshpeers,verbs=create;update,versions=v1alpha1,name=vmeshpeer.servicemesh.cisco.com,admissionReviewVersions=v1
+
+type MeshPeerValidator struct{}
+
+func (v *MeshPeerValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return v.validate(obj)
+}
+
+func (v *MeshPeerValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	return v.validate(newObj)
+}
+
+func (v *MeshPeerValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *MeshPeerValidator) validate(obj runtime.Object) error {
+	peer, ok := obj.(*v1alpha1.MeshPeer)
+	if !ok {
+		return errors.NewWithDetails("unexpected object type", "type", fmt.Sprintf("%T", obj))
+	}
+
+	if peer.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	return newInvalidError("MeshPeer", peer.GetName(), ValidateMeshPeer(peer))
+}
+
+// ValidateMeshPeer validates the spec of a MeshPeer
+func ValidateMeshPeer(peer *v1alpha1.MeshPeer) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	spec := peer.GetSpec()
+	if spec == nil {
+		return append(allErrs, field.Required(specPath, ""))
+	}
+
+	secretPath := specPath.Child("kubeconfigSecret")
+	if spec.GetKubeconfigSecret() == nil {
+		allErrs = append(allErrs, field.Required(secretPath, "reference to the kubeconfig secret of the remote cluster must be set"))
+	} else {
+		allErrs = append(allErrs, validateSecretName(spec.GetKubeconfigSecret().GetName(), secretPath.Child("name"))...)
+	}
+
+	if key := spec.GetKubeconfigSecretKey(); key != "" {
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("kubeconfigSecretKey"), key, msg))
+		}
+	}
+
+	icpPath := specPath.Child("istioControlPlane")
+	if spec.GetIstioControlPlane() == nil {
+		allErrs = append(allErrs, field.Required(icpPath, "reference to the remote Istio control plane must be set"))
+	} else if spec.GetIstioControlPlane().GetName() == "" {
+		allErrs = append(allErrs, field.Required(icpPath.Child("name"), ""))
+	} else {
+		// the remote control plane is mirrored to a peer control plane named after the control plane and the mesh peer
+		for _, msg := range validation.IsDNS1123Subdomain(peer.PeerIstioControlPlaneName()) {
+			allErrs = append(allErrs, field.Invalid(icpPath.Child("name"), spec.GetIstioControlPlane().GetName(), msg))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"fmt"
+
+	ypatch "github.com/cppforlife/go-patch/patch"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+)
+
+func newInvalidError(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return k8serrors.NewInvalid(v1alpha1.GroupVersion.WithKind(kind).GroupKind(), name, allErrs)
+}
+
+func validateK8sResourceOverlays(overlays []*v1alpha1.K8SResourceOverlayPatch, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, overlay := range overlays {
+		if overlay == nil {
+			continue
+		}
+
+		for j, patch := range overlay.GetPatches() {
+			allErrs = append(allErrs, validateK8sResourceOverlayPatch(patch, path.Index(i).Child("patches").Index(j))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateK8sResourceOverlayPatch(patch v1alpha1.K8SResourceOverlayPatch_Patch, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if patch.GetPath() == "" {
+		allErrs = append(allErrs, field.Required(path.Child("path"), "patch path must be set"))
+	} else if _, err := ypatch.NewPointerFromString(patch.GetPath()); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("path"), patch.GetPath(), err.Error()))
+	}
+
+	switch patch.GetType() {
+	case v1alpha1.K8SResourceOverlayPatch_replace:
+		if patch.GetParseValue() {
+			var value interface{}
+			if err := yaml.Unmarshal([]byte(patch.GetValue()), &value); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("value"), patch.GetValue(), fmt.Sprintf("could not parse value: %s", err)))
+			}
+		}
+	case v1alpha1.K8SResourceOverlayPatch_remove:
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), patch.GetType().String(), []string{
+			v1alpha1.K8SResourceOverlayPatch_replace.String(),
+			v1alpha1.K8SResourceOverlayPatch_remove.String(),
+		}))
+	}
+
+	return allErrs
+}
+
+func validateBaseKubernetesResourceConfig(config *v1alpha1.BaseKubernetesResourceConfig, path *field.Path) field.ErrorList {
+	if config == nil {
+		return nil
+	}
+
+	return validateReplicas(config.GetReplicas(), path.Child("replicas"))
+}
+
+func validateReplicas(replicas *v1alpha1.Replicas, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if replicas == nil {
+		return allErrs
+	}
+
+	if replicas.Count != nil && *replicas.Count < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("count"), *replicas.Count, validation.InclusiveRangeError(0, 1<<31-1)))
+	}
+
+	if replicas.Min != nil && *replicas.Min < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("min"), *replicas.Min, validation.InclusiveRangeError(0, 1<<31-1)))
+	}
+
+	if replicas.Max != nil && *replicas.Max < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("max"), *replicas.Max, validation.InclusiveRangeError(1, 1<<31-1)))
+	}
+
+	if replicas.Min != nil && replicas.Max != nil && *replicas.Min > *replicas.Max {
+		allErrs = append(allErrs, field.Invalid(path.Child("max"), *replicas.Max, fmt.Sprintf("must be greater than or equal to min (%d)", *replicas.Min)))
+	}
+
+	return allErrs
+}
+
+func validateLabelValue(value string, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range validation.IsValidLabelValue(value) {
+		allErrs = append(allErrs, field.Invalid(path, value, msg))
+	}
+
+	return allErrs
+}
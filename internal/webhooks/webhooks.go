@@ -0,0 +1,90 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"emperror.dev/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+)
+
+const (
+	istioControlPlaneMutatingPath = "/mutate-servicemesh-cisco-com-v1alpha1-istiocontrolplane"
+)
+
+// SetupWithManager registers the admission webhooks of the operator on the webhook server of the manager
+func SetupWithManager(mgr ctrl.Manager, logger logger.Logger, clusterRegistryAPIEnabled bool) error {
+	mgr.GetWebhookServer().Register(istioControlPlaneMutatingPath, &webhook.Admission{
+		Handler: &IstioControlPlaneDefaulter{
+			Client:                    mgr.GetClient(),
+			Config:                    mgr.GetConfig(),
+			Log:                       logger.WithName("defaulter"),
+			ClusterRegistryAPIEnabled: clusterRegistryAPIEnabled,
+		},
+	})
+
+	err := ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.IstioControlPlane{}).
+		WithValidator(&IstioControlPlaneValidator{
+			Client: mgr.GetClient(),
+		}).
+		Complete()
+	if err != nil {
+		return errors.WrapIf(err, "could not register Istio control plane webhooks")
+	}
+
+	err = ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.IstioMeshGateway{}).
+		WithValidator(&IstioMeshGatewayValidator{
+			Client: mgr.GetClient(),
+		}).
+		Complete()
+	if err != nil {
+		return errors.WrapIf(err, "could not register Istio mesh gateway webhooks")
+	}
+
+	err = ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.IstioMesh{}).
+		WithValidator(&IstioMeshValidator{}).
+		Complete()
+	if err != nil {
+		return errors.WrapIf(err, "could not register Istio mesh webhooks")
+	}
+
+	err = ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.IstioRevisionTag{}).
+		WithValidator(&IstioRevisionTagValidator{
+			Client: mgr.GetClient(),
+		}).
+		Complete()
+	if err != nil {
+		return errors.WrapIf(err, "could not register Istio revision tag webhooks")
+	}
+
+	err = ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.MeshPeer{}).
+		WithValidator(&MeshPeerValidator{}).
+		Complete()
+	if err != nil {
+		return errors.WrapIf(err, "could not register mesh peer webhooks")
+	}
+
+	return nil
+}
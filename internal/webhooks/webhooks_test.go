@@ -0,0 +1,748 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/internal/webhooks"
+	"github.com/banzaicloud/operator-tools/pkg/logger"
+	"github.com/banzaicloud/operator-tools/pkg/utils"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func newIstioControlPlane(name string, mutate func(spec *v1alpha1.IstioControlPlaneSpec)) *v1alpha1.IstioControlPlane {
+	icp := &v1alpha1.IstioControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+		},
+		Spec: &v1alpha1.IstioControlPlaneSpec{
+			Version:     "1.12.5",
+			Mode:        v1alpha1.ModeType_ACTIVE,
+			NetworkName: "network1",
+		},
+	}
+
+	if mutate != nil {
+		mutate(icp.Spec)
+	}
+
+	return icp
+}
+
+func fieldsOf(allErrs field.ErrorList) []string {
+	fields := make([]string, 0, len(allErrs))
+	for _, err := range allErrs {
+		fields = append(fields, err.Field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+func TestValidateIstioControlPlane(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		icp            *v1alpha1.IstioControlPlane
+		old            *v1alpha1.IstioControlPlane
+		expectedFields []string
+	}{
+		{
+			name:           "valid",
+			icp:            newIstioControlPlane("icp-v112x", nil),
+			expectedFields: []string{},
+		},
+		{
+			name:           "missing version",
+			icp:            newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.Version = "" }),
+			expectedFields: []string{"spec.version"},
+		},
+		{
+			name:           "unsupported version",
+			icp:            newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.Version = "1.11.4" }),
+			expectedFields: []string{"spec.version"},
+		},
+		{
+			name:           "unspecified mode",
+			icp:            newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.Mode = v1alpha1.ModeType_UNSPECIFIED }),
+			expectedFields: []string{"spec.mode"},
+		},
+		{
+			name: "invalid network name and cluster ID",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.NetworkName = "network 1"
+				spec.ClusterID = "cluster/1"
+			}),
+			expectedFields: []string{"spec.clusterID", "spec.networkName"},
+		},
+		{
+			name:           "changed cluster ID",
+			icp:            newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.ClusterID = "cluster2" }),
+			old:            newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.ClusterID = "cluster1" }),
+			expectedFields: []string{"spec.clusterID"},
+		},
+		{
+			name: "replicas min greater than max",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Istiod = &v1alpha1.IstiodConfiguration{
+					Deployment: &v1alpha1.BaseKubernetesResourceConfig{
+						Replicas: &v1alpha1.Replicas{
+							Min: int32Ptr(3),
+							Max: int32Ptr(2),
+						},
+					},
+				}
+			}),
+			expectedFields: []string{"spec.istiod.deployment.replicas.max"},
+		},
+		{
+			name: "malformed overlay patches",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.K8SResourceOverlays = []*v1alpha1.K8SResourceOverlayPatch{
+					{
+						Patches: []v1alpha1.K8SResourceOverlayPatch_Patch{
+							{
+								Path: "/spec/replicas",
+								Type: v1alpha1.K8SResourceOverlayPatch_remove,
+							},
+							{
+								Path: "spec/replicas",
+								Type: v1alpha1.K8SResourceOverlayPatch_replace,
+							},
+							{
+								Path:       "/spec/template/metadata/labels",
+								Value:      "{key: value",
+								ParseValue: true,
+								Type:       v1alpha1.K8SResourceOverlayPatch_replace,
+							},
+							{
+								Type: v1alpha1.K8SResourceOverlayPatch_unspecified,
+							},
+						},
+					},
+				}
+			}),
+			expectedFields: []string{
+				"spec.k8sResourceOverlays[0].patches[1].path",
+				"spec.k8sResourceOverlays[0].patches[2].value",
+				"spec.k8sResourceOverlays[0].patches[3].path",
+				"spec.k8sResourceOverlays[0].patches[3].type",
+			},
+		},
+		{
+			name: "valid plug-in CA",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Ca = &v1alpha1.CAConfiguration{
+					RootCASecret:         &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "cert-manager"},
+					IntermediateDuration: "4380h",
+				}
+			}),
+			expectedFields: []string{},
+		},
+		{
+			name: "plug-in CA without root CA secret",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Ca = &v1alpha1.CAConfiguration{}
+			}),
+			expectedFields: []string{"spec.ca.rootCASecret.name"},
+		},
+		{
+			name: "plug-in CA renewal after expiry",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Ca = &v1alpha1.CAConfiguration{
+					RootCASecret:            &v1alpha1.NamespacedName{Name: "root-ca"},
+					IntermediateDuration:    "720h",
+					IntermediateRenewBefore: "1000h",
+				}
+			}),
+			expectedFields: []string{"spec.ca"},
+		},
+		{
+			name: "root CA rotation",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Ca = &v1alpha1.CAConfiguration{
+					RootCASecret:     &v1alpha1.NamespacedName{Name: "root-ca"},
+					NextRootCASecret: &v1alpha1.NamespacedName{Name: "root-ca-2023"},
+					RestartBatchSize: int32Ptr(3),
+				}
+			}),
+			expectedFields: []string{},
+		},
+		{
+			name: "root CA rotation to the same secret",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Ca = &v1alpha1.CAConfiguration{
+					RootCASecret:     &v1alpha1.NamespacedName{Name: "root-ca"},
+					NextRootCASecret: &v1alpha1.NamespacedName{Name: "root-ca", Namespace: "istio-system"},
+					RestartBatchSize: int32Ptr(0),
+				}
+			}),
+			expectedFields: []string{"spec.ca.nextRootCASecret", "spec.ca.restartBatchSize"},
+		},
+		{
+			name: "node proxy without ambient support",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.NodeProxy = &v1alpha1.NodeProxyConfiguration{Enabled: utils.BoolPointer(true)}
+			}),
+			expectedFields: []string{"spec.nodeProxy.enabled"},
+		},
+		{
+			name: "disabled node proxy without ambient support",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.NodeProxy = &v1alpha1.NodeProxyConfiguration{Enabled: utils.BoolPointer(false)}
+			}),
+			expectedFields: []string{},
+		},
+		{
+			name: "networks",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Networks = []*v1alpha1.NetworkConfiguration{
+					{
+						Name:            "network1",
+						GatewaySelector: map[string]string{"gateway": "east-west"},
+					},
+					{
+						Name:             "network2",
+						GatewayPort:      443,
+						GatewayAddresses: []string{"east-west.example.com"},
+					},
+					{
+						Name:            "network3",
+						ClusterSelector: map[string]string{"region": "us-east-1"},
+					},
+				}
+			}),
+			expectedFields: []string{},
+		},
+		{
+			name: "invalid networks",
+			icp: newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+				spec.Networks = []*v1alpha1.NetworkConfiguration{
+					{
+						Name:            "network1",
+						GatewaySelector: map[string]string{"gateway": "east west"},
+						GatewayPort:     70000,
+					},
+					{
+						Name: "network1",
+					},
+					{
+						Name:            "network2",
+						ClusterSelector: map[string]string{"region": "us east"},
+					},
+				}
+			}),
+			expectedFields: []string{
+				"spec.networks[0].gatewayPort",
+				"spec.networks[0].gatewaySelector",
+				"spec.networks[1].gatewaySelector",
+				"spec.networks[1].name",
+				"spec.networks[2].clusterSelector",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := pretty.Compare(fieldsOf(webhooks.ValidateIstioControlPlane(tt.icp, tt.old)), tt.expectedFields); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIstioControlPlaneValidatorClusterConsistency(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	existing := newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) { spec.ClusterID = "cluster1" })
+	validator := &webhooks.IstioControlPlaneValidator{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build(),
+	}
+
+	icp := newIstioControlPlane("icp-v112y", func(spec *v1alpha1.IstioControlPlaneSpec) {
+		spec.ClusterID = "cluster2"
+		spec.NetworkName = "network2"
+	})
+	err := validator.ValidateCreate(context.Background(), icp)
+	if !k8serrors.IsInvalid(err) {
+		t.Fatalf("expected invalid error, got %v", err)
+	}
+	if diff := pretty.Compare(len(err.(*k8serrors.StatusError).ErrStatus.Details.Causes), 2); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	icp = newIstioControlPlane("icp-v112y", func(spec *v1alpha1.IstioControlPlaneSpec) {
+		spec.ClusterID = "cluster2"
+		spec.MeshID = "other-mesh"
+	})
+	if err := validator.ValidateCreate(context.Background(), icp); err != nil {
+		t.Errorf("control planes of different meshes should not be compared: %v", err)
+	}
+}
+
+func TestIstioMeshGatewayValidator(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	validator := &webhooks.IstioMeshGatewayValidator{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newIstioControlPlane("icp-v112x", nil)).Build(),
+	}
+
+	newIMGW := func(icpName string) *v1alpha1.IstioMeshGateway {
+		return &v1alpha1.IstioMeshGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "imgw",
+				Namespace: "default",
+			},
+			Spec: &v1alpha1.IstioMeshGatewaySpec{
+				Service: &v1alpha1.Service{},
+				Type:    v1alpha1.GatewayType_ingress,
+				IstioControlPlane: &v1alpha1.NamespacedName{
+					Name:      icpName,
+					Namespace: "istio-system",
+				},
+			},
+		}
+	}
+
+	if err := validator.ValidateCreate(context.Background(), newIMGW("icp-v112x")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := validator.ValidateCreate(context.Background(), newIMGW("missing"))
+	if !k8serrors.IsInvalid(err) {
+		t.Fatalf("expected invalid error, got %v", err)
+	}
+	if diff := pretty.Compare(err.(*k8serrors.StatusError).ErrStatus.Details.Causes[0].Field, "spec.istioControlPlane"); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	newEgressIMGW := func(hosts ...*v1alpha1.EgressHost) *v1alpha1.IstioMeshGateway {
+		imgw := newIMGW("icp-v112x")
+		imgw.Spec.Type = v1alpha1.GatewayType_egress
+		imgw.Spec.Service.Ports = []v1alpha1.ServicePort{
+			{Name: "http", Port: 80, Protocol: "TCP"},
+			{Name: "tls", Port: 443, Protocol: "TCP"},
+			{Name: "tcp-postgres", Port: 5432, Protocol: "TCP"},
+		}
+		imgw.Spec.Egress = &v1alpha1.EgressConfiguration{
+			Hosts: hosts,
+		}
+
+		return imgw
+	}
+	egressHost := func(host string, ports ...*v1alpha1.EgressPort) *v1alpha1.EgressHost {
+		return &v1alpha1.EgressHost{Host: host, Ports: ports}
+	}
+	egressPort := func(number uint32, protocol string) *v1alpha1.EgressPort {
+		return &v1alpha1.EgressPort{Number: number, Protocol: protocol}
+	}
+
+	ingressWithEgress := newEgressIMGW(egressHost("httpbin.org", egressPort(80, "HTTP")))
+	ingressWithEgress.Spec.Type = v1alpha1.GatewayType_ingress
+
+	newTLSIMGW := func(tls *v1alpha1.IstioMeshGatewayTLS) *v1alpha1.IstioMeshGateway {
+		imgw := newIMGW("icp-v112x")
+		imgw.Spec.Tls = tls
+
+		return imgw
+	}
+
+	specTests := []struct {
+		name           string
+		imgw           *v1alpha1.IstioMeshGateway
+		expectedFields []string
+	}{
+		{
+			name: "valid",
+			imgw: newEgressIMGW(
+				egressHost("httpbin.org", egressPort(80, "HTTP"), egressPort(443, "HTTPS")),
+				egressHost("api.example.com", egressPort(80, "HTTP"), egressPort(443, "TLS")),
+				egressHost("db.example.com", egressPort(5432, "TCP")),
+			),
+		},
+		{
+			name:           "ingress gateway",
+			imgw:           ingressWithEgress,
+			expectedFields: []string{"spec.egress"},
+		},
+		{
+			name:           "invalid host",
+			imgw:           newEgressIMGW(egressHost("*.example.com", egressPort(443, "TLS"))),
+			expectedFields: []string{"spec.egress.hosts[0].host"},
+		},
+		{
+			name:           "port not exposed by the service",
+			imgw:           newEgressIMGW(egressHost("httpbin.org", egressPort(8080, "HTTP"))),
+			expectedFields: []string{"spec.egress.hosts[0].ports[0].number"},
+		},
+		{
+			name: "conflicting protocols",
+			imgw: newEgressIMGW(
+				egressHost("httpbin.org", egressPort(443, "HTTPS")),
+				egressHost("api.example.com", egressPort(443, "TCP")),
+			),
+			expectedFields: []string{"spec.egress.hosts[1].ports[0].protocol"},
+		},
+		{
+			name: "shared TCP port",
+			imgw: newEgressIMGW(
+				egressHost("db.example.com", egressPort(5432, "TCP")),
+				egressHost("replica.example.com", egressPort(5432, "TCP")),
+			),
+			expectedFields: []string{"spec.egress.hosts[1].ports[0].protocol"},
+		},
+		{
+			name: "valid TLS from the operator CA",
+			imgw: newTLSIMGW(&v1alpha1.IstioMeshGatewayTLS{
+				SecretName:  "imgw-tls",
+				DnsNames:    []string{"example.com", "*.example.com"},
+				Duration:    "720h",
+				RenewBefore: "240h",
+			}),
+		},
+		{
+			name: "valid TLS from cert-manager",
+			imgw: newTLSIMGW(&v1alpha1.IstioMeshGatewayTLS{
+				SecretName:        "imgw-tls",
+				DnsNames:          []string{"example.com"},
+				CertManagerIssuer: &v1alpha1.CertManagerIssuerReference{Name: "letsencrypt", Kind: "ClusterIssuer"},
+			}),
+		},
+		{
+			name: "invalid TLS names",
+			imgw: newTLSIMGW(&v1alpha1.IstioMeshGatewayTLS{
+				SecretName: "imgw_tls",
+				DnsNames:   []string{"example.com", "example.com", "*.*.example.com"},
+			}),
+			expectedFields: []string{"spec.tls.secretName", "spec.tls.dnsNames[1]", "spec.tls.dnsNames[2]"},
+		},
+		{
+			name: "TLS renewal after expiry",
+			imgw: newTLSIMGW(&v1alpha1.IstioMeshGatewayTLS{
+				SecretName:  "imgw-tls",
+				DnsNames:    []string{"example.com"},
+				Duration:    "24h",
+				RenewBefore: "48h",
+			}),
+			expectedFields: []string{"spec.tls"},
+		},
+		{
+			name: "invalid TLS issuer",
+			imgw: newTLSIMGW(&v1alpha1.IstioMeshGatewayTLS{
+				SecretName:        "imgw-tls",
+				DnsNames:          []string{"example.com"},
+				CertManagerIssuer: &v1alpha1.CertManagerIssuerReference{Kind: "Vault"},
+			}),
+			expectedFields: []string{"spec.tls.certManagerIssuer.name", "spec.tls.certManagerIssuer.kind"},
+		},
+	}
+
+	for _, test := range specTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validator.ValidateCreate(context.Background(), test.imgw)
+			if len(test.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !k8serrors.IsInvalid(err) {
+				t.Fatalf("expected invalid error, got %v", err)
+			}
+
+			fields := make([]string, 0)
+			for _, cause := range err.(*k8serrors.StatusError).ErrStatus.Details.Causes {
+				fields = append(fields, cause.Field)
+			}
+			if diff := pretty.Compare(fields, test.expectedFields); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIstioRevisionTagValidator(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	validator := &webhooks.IstioRevisionTagValidator{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newIstioControlPlane("icp-v112x", nil)).Build(),
+	}
+
+	newTag := func(name, icpName string) *v1alpha1.IstioRevisionTag {
+		return &v1alpha1.IstioRevisionTag{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: &v1alpha1.IstioRevisionTagSpec{
+				IstioControlPlane: &v1alpha1.NamespacedName{
+					Name:      icpName,
+					Namespace: "istio-system",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		tag            *v1alpha1.IstioRevisionTag
+		expectedFields []string
+	}{
+		{
+			name: "valid",
+			tag:  newTag("prod-stable", "icp-v112x"),
+		},
+		{
+			name:           "name with dots",
+			tag:            newTag("icp-v112x.istio-system", "icp-v112x"),
+			expectedFields: []string{"metadata.name"},
+		},
+		{
+			name:           "default tag",
+			tag:            newTag("default", "icp-v112x"),
+			expectedFields: []string{"metadata.name"},
+		},
+		{
+			name:           "missing control plane",
+			tag:            newTag("prod-stable", "missing"),
+			expectedFields: []string{"spec.istioControlPlane"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validator.ValidateCreate(context.Background(), tt.tag)
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !k8serrors.IsInvalid(err) {
+				t.Fatalf("expected invalid error, got %v", err)
+			}
+
+			fields := make([]string, 0)
+			for _, cause := range err.(*k8serrors.StatusError).ErrStatus.Details.Causes {
+				fields = append(fields, cause.Field)
+			}
+			if diff := pretty.Compare(fields, tt.expectedFields); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMeshPeer(t *testing.T) {
+	t.Parallel()
+
+	newPeer := func(name string, mutate func(spec *v1alpha1.MeshPeerSpec)) *v1alpha1.MeshPeer {
+		peer := &v1alpha1.MeshPeer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "istio-system",
+			},
+			Spec: &v1alpha1.MeshPeerSpec{
+				KubeconfigSecret: &v1alpha1.NamespacedName{
+					Name: "cluster-2-kubeconfig",
+				},
+				IstioControlPlane: &v1alpha1.NamespacedName{
+					Name: "icp-v112x",
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(peer.Spec)
+		}
+
+		return peer
+	}
+
+	tests := []struct {
+		name           string
+		peer           *v1alpha1.MeshPeer
+		expectedFields []string
+	}{
+		{
+			name: "valid",
+			peer: newPeer("cluster-2", nil),
+		},
+		{
+			name: "missing spec",
+			peer: &v1alpha1.MeshPeer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-2",
+				},
+			},
+			expectedFields: []string{"spec"},
+		},
+		{
+			name: "missing references",
+			peer: newPeer("cluster-2", func(spec *v1alpha1.MeshPeerSpec) {
+				spec.KubeconfigSecret = nil
+				spec.IstioControlPlane = &v1alpha1.NamespacedName{}
+			}),
+			expectedFields: []string{"spec.istioControlPlane.name", "spec.kubeconfigSecret"},
+		},
+		{
+			name: "invalid secret name and key",
+			peer: newPeer("cluster-2", func(spec *v1alpha1.MeshPeerSpec) {
+				spec.KubeconfigSecret.Name = "Cluster_2"
+				spec.KubeconfigSecretKey = "kube/config"
+			}),
+			expectedFields: []string{"spec.kubeconfigSecret.name", "spec.kubeconfigSecretKey"},
+		},
+		{
+			name: "invalid peer control plane name",
+			peer: newPeer("cluster-2", func(spec *v1alpha1.MeshPeerSpec) {
+				spec.IstioControlPlane.Name = strings.Repeat("a", 250)
+			}),
+			expectedFields: []string{"spec.istioControlPlane.name"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := pretty.Compare(fieldsOf(webhooks.ValidateMeshPeer(tt.peer)), tt.expectedFields); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIstioControlPlaneDefaulter(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	mesh := &v1alpha1.IstioMesh{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mesh1",
+			Namespace: "istio-system",
+		},
+	}
+
+	defaulter := &webhooks.IstioControlPlaneDefaulter{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(mesh).Build(),
+		Log:    logger.NewWithLogrLogger(ctrl.Log),
+	}
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := defaulter.InjectDecoder(decoder); err != nil {
+		t.Fatal(err)
+	}
+
+	icp := newIstioControlPlane("icp-v112x", func(spec *v1alpha1.IstioControlPlaneSpec) {
+		spec.Mode = v1alpha1.ModeType_UNSPECIFIED
+		spec.NetworkName = ""
+		spec.JwtPolicy = v1alpha1.JWTPolicyType_THIRD_PARTY_JWT
+		spec.ContainerImageConfiguration = &v1alpha1.ContainerImageConfiguration{
+			Tag: "1.12.6",
+		}
+	})
+	icp.SetGroupVersionKind(v1alpha1.GroupVersion.WithKind("IstioControlPlane"))
+
+	raw, err := json.Marshal(icp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := defaulter.Handle(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object: runtime.RawExtension{
+				Raw: raw,
+			},
+		},
+	})
+	if !resp.Allowed {
+		t.Fatalf("unexpected response: %v", resp.Result)
+	}
+
+	patches := map[string]interface{}{}
+	for _, patch := range resp.Patches {
+		patches[patch.Path] = patch.Value
+	}
+
+	expectedPatches := map[string]interface{}{
+		"/spec/clusterID":   "Kubernetes",
+		"/spec/meshID":      "mesh1",
+		"/spec/mode":        "ACTIVE",
+		"/spec/networkName": "network1",
+		"/spec/proxy":       map[string]interface{}{"image": "istio/proxyv2:1.12.6"},
+		"/spec/proxyInit":   map[string]interface{}{"image": "istio/proxyv2:1.12.6"},
+	}
+	if diff := pretty.Compare(patches, expectedPatches); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+}
@@ -19,8 +19,12 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
+	"time"
 
+	"emperror.dev/errors"
+	"emperror.dev/errors/utils/keyval"
 	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 	apiextensionv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -28,12 +32,21 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	// +kubebuilder:scaffold:imports
 	clusterregistryv1alpha1 "github.com/banzaicloud/cluster-registry/api/v1alpha1"
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 	"github.com/banzaicloud/istio-operator/v2/controllers"
+	"github.com/banzaicloud/istio-operator/v2/internal/health"
+	"github.com/banzaicloud/istio-operator/v2/internal/metrics"
 	"github.com/banzaicloud/istio-operator/v2/internal/models"
+	"github.com/banzaicloud/istio-operator/v2/internal/render"
+	"github.com/banzaicloud/istio-operator/v2/internal/tracing"
+	"github.com/banzaicloud/istio-operator/v2/internal/webhooks"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
 	"github.com/banzaicloud/istio-operator/v2/pkg/util"
 	"github.com/banzaicloud/operator-tools/pkg/logger"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
@@ -53,14 +66,33 @@ func init() {
 	_ = istiosecurityv1beta1.AddToScheme(scheme)
 	_ = apiextensionv1.AddToScheme(scheme)
 	_ = clusterregistryv1alpha1.AddToScheme(scheme)
+	_ = gatewayapiv1alpha2.AddToScheme(scheme)
 
 	_ = servicemeshv1alpha1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		if err := render.Command(os.Args[2:], os.Stdout, SupportedIstioVersion); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(0)
+			}
+			if details := errors.GetDetails(err); len(details) > 0 {
+				fmt.Fprintf(os.Stderr, "render failed: %v %v\n", err, keyval.ToMap(details))
+			} else {
+				fmt.Fprintf(os.Stderr, "render failed: %v\n", err)
+			}
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	var metricsAddr string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
+	var healthProbeAddr string
+	flag.StringVar(&healthProbeAddr, "health-probe-addr", ":8081", "The address the health and readiness endpoints bind to.")
 	var developmentMode bool
 	flag.BoolVar(&developmentMode, "devel-mode", false, "Set development mode (mainly for logging).")
 	var leaderElectionEnabled bool
@@ -72,21 +104,48 @@ func main() {
 	flag.StringVar(&leaderElectionName, "leader-election-name", "istio-operator-leader-election", "Determines the name of the leader election configmap.")
 	var apiServerEndpointAddress string
 	flag.StringVar(&apiServerEndpointAddress, "apiserver-endpoint-address", "", "Endpoint address of the API server of the cluster the controller is running on.")
+	var readerTokenExpiration time.Duration
+	flag.DurationVar(&readerTokenExpiration, "reader-token-expiration", time.Hour*24, "Expiration of the service account tokens requested for the reader kubeconfigs of the clusters, at least 10m, long-lived token secrets are used when set to 0.")
 	var clusterRegistryConfiguration models.ClusterRegistryConfiguration
 	flag.BoolVar(&clusterRegistryConfiguration.ClusterAPI.Enabled, "cluster-registry-api-enabled", false, "Enable using cluster registry API from the cluster when applicable.")
 	flag.BoolVar(&clusterRegistryConfiguration.ResourceSyncRules.Enabled, "cluster-registry-sync-rules-enabled", false, "Enable automatically creating the necessary ResourceSyncRule resources from the cluster registry API for multi cluster setups.")
 	var webhookServerPort uint
 	flag.UintVar(&webhookServerPort, "webhook-server-port", 9443, "The port that the webhook server serves at.")
+	var webhooksEnabled bool
+	flag.BoolVar(&webhooksEnabled, "webhooks-enabled", false, "Enable the admission webhooks of the operator.")
+	var webhookCertDir string
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "", "The directory that contains the server key and certificate for the webhook server.")
+	var gatewayAPIEnabled bool
+	flag.BoolVar(&gatewayAPIEnabled, "gateway-api-enabled", false, "Enable provisioning the Gateway API gateways of the gateway classes handled by the operator, the Gateway API CRDs must be installed.")
 	var verboseLogging bool
 	flag.BoolVar(&verboseLogging, "verbose", false, "Enable verbose logging")
+	var tracingConfiguration models.TracingConfiguration
+	flag.StringVar(&tracingConfiguration.OTLPEndpoint, "tracing-otlp-endpoint", "", "Enable tracing of the reconciliations and export the spans to this OTLP gRPC collector endpoint.")
+	flag.BoolVar(&tracingConfiguration.OTLPInsecure, "tracing-otlp-insecure", false, "Connect to the OTLP collector endpoint without TLS.")
+	flag.StringVar(&tracingConfiguration.File, "tracing-file", "", "Enable tracing of the reconciliations and write the spans to this file instead of an OTLP collector.")
+	flag.Float64Var(&tracingConfiguration.SampleRatio, "tracing-sample-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	flag.Parse()
 
 	ctrl.SetLogger(util.CreateLogger(verboseLogging, developmentMode))
 
+	if readerTokenExpiration < 0 || readerTokenExpiration > 0 && readerTokenExpiration < k8sutil.MinReaderTokenExpiration {
+		setupLog.Error(errors.NewWithDetails("invalid reader token expiration", "value", readerTokenExpiration.String()),
+			fmt.Sprintf("the reader token expiration must be 0 or at least %s", k8sutil.MinReaderTokenExpiration))
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfiguration, Version)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                  scheme,
 		MetricsBindAddress:      metricsAddr,
+		HealthProbeBindAddress:  healthProbeAddr,
 		Port:                    int(webhookServerPort),
+		CertDir:                 webhookCertDir,
 		LeaderElection:          leaderElectionEnabled,
 		LeaderElectionID:        leaderElectionName,
 		LeaderElectionNamespace: leaderElectionNamespace,
@@ -96,6 +155,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	ctrlmetrics.Registry.MustRegister(metrics.NewCollector(mgr.GetClient()))
+
+	controlPlaneChecker := health.NewControlPlaneChecker(mgr.GetClient())
+	for name, check := range map[string]healthz.Checker{
+		"informers":                   health.CacheSynced(mgr.GetCache()),
+		"leader":                      health.LeaderElected(mgr.Elected()),
+		"istiod-endpoints":            controlPlaneChecker.IstiodEndpoints,
+		"injection-webhook-ca-bundle": controlPlaneChecker.InjectionWebhookCABundle,
+		"gateway-addresses":           controlPlaneChecker.GatewayAddresses,
+	} {
+		if err := mgr.AddReadyzCheck(name, check); err != nil {
+			setupLog.Error(err, "unable to set up readiness check", "check", name)
+			os.Exit(1)
+		}
+	}
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+
 	istioControlPlaneLogger := logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioControlPlane"))
 	if err = (&controllers.IstioControlPlaneReconciler{
 		Client: mgr.GetClient(),
@@ -109,6 +188,7 @@ func main() {
 		),
 		ClusterRegistry:          clusterRegistryConfiguration,
 		APIServerEndpointAddress: apiServerEndpointAddress,
+		ReaderTokenExpiration:    readerTokenExpiration,
 		SupportedIstioVersion:    SupportedIstioVersion,
 		Version:                  Version,
 		Recorder:                 mgr.GetEventRecorderFor("IstioControlPlane"),
@@ -117,13 +197,66 @@ func main() {
 		os.Exit(1)
 	}
 	if err = (&controllers.IstioMeshGatewayReconciler{
-		Client: mgr.GetClient(),
-		Log:    logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioMeshGateway")),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Log:      logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioMeshGateway")),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("IstioMeshGateway"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IstioMeshGateway")
 		os.Exit(1)
 	}
+	if err = (&controllers.IstioControlPlaneUpgradeReconciler{
+		Client:   mgr.GetClient(),
+		Log:      logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioControlPlaneUpgrade")),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("IstioControlPlaneUpgrade"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IstioControlPlaneUpgrade")
+		os.Exit(1)
+	}
+	if err = (&controllers.IstioRevisionTagReconciler{
+		Client:   mgr.GetClient(),
+		Log:      logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("IstioRevisionTag")),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("IstioRevisionTag"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IstioRevisionTag")
+		os.Exit(1)
+	}
+	if err = (&controllers.MeshPeerReconciler{
+		Client:   mgr.GetClient(),
+		Log:      logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("MeshPeer")),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("MeshPeer"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MeshPeer")
+		os.Exit(1)
+	}
+	if gatewayAPIEnabled {
+		if err = (&controllers.GatewayClassReconciler{
+			Client: mgr.GetClient(),
+			Log:    logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("GatewayClass")),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "GatewayClass")
+			os.Exit(1)
+		}
+		if err = (&controllers.GatewayReconciler{
+			Client:   mgr.GetClient(),
+			Log:      logger.NewWithLogrLogger(ctrl.Log.WithName("controllers").WithName("Gateway")),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("Gateway"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+	}
+	if webhooksEnabled {
+		if err = webhooks.SetupWithManager(mgr, logger.NewWithLogrLogger(ctrl.Log.WithName("webhooks")), clusterRegistryConfiguration.ClusterAPI.Enabled); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
@@ -132,6 +265,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "could not flush traces")
+	}
+
 	// remove finalizers
 	setupLog.Info("removing finalizer from controlled resources")
 	err = controllers.RemoveFinalizers(context.Background(), mgr.GetClient())
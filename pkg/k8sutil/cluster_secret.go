@@ -22,13 +22,18 @@ import (
 	"net"
 	"net/url"
 	"strings"
+	"time"
 
 	"emperror.dev/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	k8sclientapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
@@ -63,27 +68,59 @@ func GetExternalAddressOfAPIServer(kubeConfig *rest.Config) (string, error) {
 	return "", errors.New("could not determine external apiserver address")
 }
 
-func GetReaderSecretForCluster(ctx context.Context, kubeClient client.Client, kubeConfig *rest.Config, clusterName string, secretRef types.NamespacedName, saRef types.NamespacedName, apiServerEndpointAddress string, clusterRegistryAPIEnabled bool) (*corev1.Secret, error) {
+const (
+	// ReaderTokenExpirationAnnotation holds the expiration time of the service account token of a reader kubeconfig
+	ReaderTokenExpirationAnnotation = "servicemesh.cisco.com/reader-token-expiration"
+	// ReaderTokenIssuedAtAnnotation holds the time the service account token of a reader kubeconfig was issued at
+	ReaderTokenIssuedAtAnnotation = "servicemesh.cisco.com/reader-token-issued-at"
+
+	// MinReaderTokenExpiration is the shortest expiration the TokenRequest API accepts
+	MinReaderTokenExpiration = time.Minute * 10
+	// minReaderTokenRefreshInterval keeps the tokens from being refreshed in a tight loop
+	minReaderTokenRefreshInterval = time.Minute
+
+	serviceAccountTokenSecretSuffix = "-token"
+	rootCAConfigMapName             = "kube-root-ca.crt"
+)
+
+// GetReaderSecretForCluster returns the secret with the kubeconfig of the reader service account for the cluster,
+// the token of the kubeconfig is requested through the TokenRequest API when the token expiration is set, and it is
+// reused until the token is due for refresh. Without token expiration, or when the TokenRequest API is not available,
+// the token is read from a service account token secret, which is created explicitly as Kubernetes 1.24+ does not
+// create them for the service accounts anymore
+func GetReaderSecretForCluster(ctx context.Context, kubeClient client.Client, kubeConfig *rest.Config, clusterName string, secretRef types.NamespacedName, saRef types.NamespacedName, apiServerEndpointAddress string, clusterRegistryAPIEnabled bool, tokenExpiration time.Duration) (*corev1.Secret, error) {
 	sa := &corev1.ServiceAccount{}
 	err := kubeClient.Get(ctx, saRef, sa)
 	if err != nil {
 		return nil, errors.WithStackIf(err)
 	}
 
-	if len(sa.Secrets) == 0 {
-		return nil, errors.NewWithDetails("could not find secret reference for sa", "sa", saRef)
+	var token string
+	var caData []byte
+	var issuedAt, expiresAt time.Time
+	if tokenExpiration > 0 {
+		token, issuedAt, expiresAt, err = getBoundServiceAccountToken(ctx, kubeClient, kubeConfig, clusterName, secretRef, sa, tokenExpiration)
+		if err != nil {
+			return nil, err
+		}
+
+		caData, err = getRootCAData(ctx, kubeClient, sa.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	secret := &corev1.Secret{}
-	err = kubeClient.Get(ctx, types.NamespacedName{
-		Name:      sa.Secrets[0].Name,
-		Namespace: sa.GetNamespace(),
-	}, secret)
-	if err != nil {
-		return nil, errors.WithStackIf(err)
+	if token == "" {
+		secret, err := getServiceAccountTokenSecret(ctx, kubeClient, sa)
+		if err != nil {
+			return nil, err
+		}
+
+		token = string(secret.Data[corev1.ServiceAccountTokenKey])
+		caData = secret.Data[corev1.ServiceAccountRootCAKey]
+		issuedAt, expiresAt = time.Time{}, time.Time{}
 	}
 
-	caData := secret.Data["ca.crt"]
 	if !bytes.Contains(caData, kubeConfig.CAData) {
 		caData = append(append(caData, []byte("\n")...), kubeConfig.CAData...)
 	}
@@ -114,19 +151,28 @@ func GetReaderSecretForCluster(ctx context.Context, kubeClient client.Client, ku
 		apiServerEndpointAddress = kubeConfig.Host
 	}
 
-	kubeconfig, err := GetKubeconfigWithSAToken(secretRef.Name, sa.GetName(), apiServerEndpointAddress, caData, string(secret.Data["token"]))
+	kubeconfig, err := GetKubeconfigWithSAToken(secretRef.Name, sa.GetName(), apiServerEndpointAddress, caData, token)
 	if err != nil {
 		return nil, errors.WithStackIf(err)
 	}
 
+	var annotations map[string]string
+	if !expiresAt.IsZero() {
+		annotations = map[string]string{
+			ReaderTokenIssuedAtAnnotation:   issuedAt.UTC().Format(time.RFC3339),
+			ReaderTokenExpirationAnnotation: expiresAt.UTC().Format(time.RFC3339),
+		}
+	}
+
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Secret",
 			APIVersion: corev1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretRef.Name,
-			Namespace: secretRef.Namespace,
+			Name:        secretRef.Name,
+			Namespace:   secretRef.Namespace,
+			Annotations: annotations,
 		},
 		Data: map[string][]byte{
 			clusterName: []byte(kubeconfig),
@@ -134,6 +180,150 @@ func GetReaderSecretForCluster(ctx context.Context, kubeClient client.Client, ku
 	}, nil
 }
 
+// ReaderTokenRefreshAfter returns the duration after which the token of the reader secret is due for refresh,
+// which is after two thirds of its actual lifetime, and whether the secret has an expiring token at all
+func ReaderTokenRefreshAfter(secret *corev1.Secret) (time.Duration, bool) {
+	refreshAt, ok := readerTokenRefreshAt(secret)
+	if !ok {
+		return 0, false
+	}
+
+	refreshAfter := time.Until(refreshAt)
+	if refreshAfter < minReaderTokenRefreshInterval {
+		refreshAfter = minReaderTokenRefreshInterval
+	}
+
+	return refreshAfter, true
+}
+
+// readerTokenRefreshAt returns the time the token of the reader secret is due for refresh, the lifetime of the token
+// is taken from the secret since the API server might have shortened the requested expiration
+func readerTokenRefreshAt(secret *corev1.Secret) (time.Time, bool) {
+	expiresAt, err := time.Parse(time.RFC3339, secret.GetAnnotations()[ReaderTokenExpirationAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	issuedAt, err := time.Parse(time.RFC3339, secret.GetAnnotations()[ReaderTokenIssuedAtAnnotation])
+	if err != nil || !issuedAt.Before(expiresAt) {
+		// the token is refreshed right away when its lifetime is unknown
+		return time.Time{}, true
+	}
+
+	return issuedAt.Add(expiresAt.Sub(issuedAt) * 2 / 3), true
+}
+
+// getBoundServiceAccountToken returns the token of the current reader secret while it is not due for refresh,
+// otherwise a new token is requested for the service account, an empty token is returned when the TokenRequest
+// API is not available on the cluster
+func getBoundServiceAccountToken(ctx context.Context, kubeClient client.Client, kubeConfig *rest.Config, clusterName string, secretRef types.NamespacedName, sa *corev1.ServiceAccount, tokenExpiration time.Duration) (string, time.Time, time.Time, error) {
+	current := &corev1.Secret{}
+	err := kubeClient.Get(ctx, secretRef, current)
+	if client.IgnoreNotFound(err) != nil {
+		return "", time.Time{}, time.Time{}, errors.WithStackIf(err)
+	}
+	if refreshAt, ok := readerTokenRefreshAt(current); err == nil && ok && time.Now().Before(refreshAt) {
+		if config, err := clientcmd.Load(current.Data[clusterName]); err == nil {
+			if authInfo, ok := config.AuthInfos[sa.GetName()]; ok && authInfo.Token != "" {
+				issuedAt, _ := time.Parse(time.RFC3339, current.GetAnnotations()[ReaderTokenIssuedAtAnnotation])
+				expiresAt, _ := time.Parse(time.RFC3339, current.GetAnnotations()[ReaderTokenExpirationAnnotation])
+
+				return authInfo.Token, issuedAt, expiresAt, nil
+			}
+		}
+	}
+
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return "", time.Time{}, time.Time{}, errors.WithStackIf(err)
+	}
+
+	issuedAt := time.Now()
+	expirationSeconds := int64(tokenExpiration.Seconds())
+	tokenRequest, err := clientset.CoreV1().ServiceAccounts(sa.GetNamespace()).CreateToken(ctx, sa.GetName(), &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if k8serrors.IsNotFound(err) || k8serrors.IsMethodNotSupported(err) {
+		return "", time.Time{}, time.Time{}, nil
+	}
+	if err != nil {
+		return "", time.Time{}, time.Time{}, errors.WrapIfWithDetails(err, "could not request token for sa", "sa", client.ObjectKeyFromObject(sa))
+	}
+
+	// the API server might shorten the expiration, so it is taken from the status
+	return tokenRequest.Status.Token, issuedAt, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// getServiceAccountTokenSecret returns the long-lived token secret of the service account, the secret is created
+// when the service account does not reference one and the token controller populates it
+func getServiceAccountTokenSecret(ctx context.Context, kubeClient client.Client, sa *corev1.ServiceAccount) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	for _, ref := range sa.Secrets {
+		err := kubeClient.Get(ctx, types.NamespacedName{
+			Name:      ref.Name,
+			Namespace: sa.GetNamespace(),
+		}, secret)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, errors.WithStackIf(err)
+		}
+		if err == nil && secret.Type == corev1.SecretTypeServiceAccountToken {
+			return secret, nil
+		}
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sa.GetName() + serviceAccountTokenSecretSuffix,
+			Namespace: sa.GetNamespace(),
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey: sa.GetName(),
+			},
+			// the secret is garbage collected along with the service account
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: corev1.SchemeGroupVersion.String(),
+					Kind:       "ServiceAccount",
+					Name:       sa.GetName(),
+					UID:        sa.GetUID(),
+				},
+			},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	err := kubeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	if k8serrors.IsNotFound(err) {
+		err = kubeClient.Create(ctx, secret)
+	}
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not get token secret for sa", "sa", client.ObjectKeyFromObject(sa))
+	}
+
+	if len(secret.Data[corev1.ServiceAccountTokenKey]) == 0 {
+		return nil, errors.NewWithDetails("token secret of sa is not populated yet", "sa", client.ObjectKeyFromObject(sa), "secret", secret.GetName())
+	}
+
+	return secret, nil
+}
+
+// getRootCAData returns the CA bundle of the API server published in every namespace of the cluster
+func getRootCAData(ctx context.Context, kubeClient client.Client, namespace string) ([]byte, error) {
+	cm := &corev1.ConfigMap{}
+	err := kubeClient.Get(ctx, types.NamespacedName{
+		Name:      rootCAConfigMapName,
+		Namespace: namespace,
+	}, cm)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	return []byte(cm.Data[corev1.ServiceAccountRootCAKey]), nil
+}
+
 func GetKubeconfigWithSAToken(name, username, endpointURL string, caData []byte, saToken string) (string, error) {
 	if !strings.Contains(endpointURL, "//") {
 		endpointURL = "//" + endpointURL
@@ -0,0 +1,167 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+)
+
+func TestGetReaderSecretForClusterWithTokenSecret(t *testing.T) {
+	t.Parallel()
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio-reader",
+				Namespace: "istio-system",
+			},
+		},
+	).Build()
+
+	secretRef := types.NamespacedName{Name: "cluster-1", Namespace: "istio-system"}
+	saRef := types.NamespacedName{Name: "istio-reader", Namespace: "istio-system"}
+	getReaderSecret := func() (*corev1.Secret, error) {
+		return k8sutil.GetReaderSecretForCluster(context.Background(), c, &rest.Config{}, "cluster-1", secretRef, saRef, "https://cluster-1.example.com:6443", false, 0)
+	}
+
+	// the token secret is created for the service account, but it is not populated yet
+	if _, err := getReaderSecret(); err == nil || !strings.Contains(err.Error(), "not populated yet") {
+		t.Fatalf("expected error for unpopulated token secret, got %v", err)
+	}
+
+	tokenSecret := &corev1.Secret{}
+	if err := c.Get(context.Background(), client.ObjectKey{Name: "istio-reader-token", Namespace: "istio-system"}, tokenSecret); err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(tokenSecret.Type, corev1.SecretTypeServiceAccountToken); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if diff := pretty.Compare(tokenSecret.GetAnnotations()[corev1.ServiceAccountNameKey], "istio-reader"); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	tokenSecret.Data = map[string][]byte{
+		corev1.ServiceAccountTokenKey: []byte("token"),
+	}
+	if err := c.Update(context.Background(), tokenSecret); err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := getReaderSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := clientcmd.Load(secret.Data["cluster-1"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(config.AuthInfos["istio-reader"].Token, "token"); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+	if diff := pretty.Compare(config.Clusters["cluster-1"].Server, "https://cluster-1.example.com:6443"); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	// long-lived tokens do not have to be refreshed
+	if _, ok := k8sutil.ReaderTokenRefreshAfter(secret); ok {
+		t.Error("expected no refresh for long-lived token")
+	}
+}
+
+func TestReaderTokenRefreshAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newSecret := func(issuedAt, expiresAt time.Time) *corev1.Secret {
+		annotations := map[string]string{
+			k8sutil.ReaderTokenExpirationAnnotation: expiresAt.UTC().Format(time.RFC3339),
+		}
+		if !issuedAt.IsZero() {
+			annotations[k8sutil.ReaderTokenIssuedAtAnnotation] = issuedAt.UTC().Format(time.RFC3339)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: annotations,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		secret   *corev1.Secret
+		expected time.Duration
+	}{
+		{
+			name:     "fresh token",
+			secret:   newSecret(now, now.Add(time.Hour*24)),
+			expected: time.Hour * 16,
+		},
+		{
+			name:     "token with expiration shortened by the API server",
+			secret:   newSecret(now, now.Add(time.Hour)),
+			expected: time.Minute * 40,
+		},
+		{
+			name:     "token in its last third",
+			secret:   newSecret(now.Add(-time.Hour*18), now.Add(time.Hour*6)),
+			expected: time.Minute,
+		},
+		{
+			name:     "expired token",
+			secret:   newSecret(now.Add(-time.Hour*25), now.Add(-time.Hour)),
+			expected: time.Minute,
+		},
+		{
+			name:     "token without issue time",
+			secret:   newSecret(time.Time{}, now.Add(time.Hour*24)),
+			expected: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			refreshAfter, ok := k8sutil.ReaderTokenRefreshAfter(tt.secret)
+			if !ok {
+				t.Fatal("expected expiring token")
+			}
+			// the annotations are truncated to seconds
+			if diff := refreshAfter - tt.expected; diff < -time.Second*2 || diff > time.Second*2 {
+				t.Errorf("expected refresh after %s, got %s", tt.expected, refreshAfter)
+			}
+		})
+	}
+}
@@ -26,13 +26,17 @@ import (
 	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 )
 
-func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, icpName string, icpNetworkName string, namespace string) ([]corev1.EndpointAddress, error) {
+// GetIstiodEndpointAddresses returns the addresses of the istiod endpoints of the active peers of the control plane,
+// the gateway addresses of the peers on other networks are resolved to IP addresses according to the address
+// resolution since endpoints can not point to hostnames. The returned bool reports whether any hostname was resolved.
+func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, icpName string, icpNetworkName string, namespace string, resolution servicemeshv1alpha1.AddressResolution) ([]corev1.EndpointAddress, bool, error) {
 	var istiodEndpointAddresses []corev1.EndpointAddress
+	var resolved bool
 
 	picpList := &servicemeshv1alpha1.PeerIstioControlPlaneList{}
 	err := kubeClient.List(ctx, picpList, client.InNamespace(namespace))
 	if err != nil {
-		return istiodEndpointAddresses, errors.WithStackIf(err)
+		return istiodEndpointAddresses, resolved, errors.WithStackIf(err)
 	}
 
 	for _, picp := range picpList.Items {
@@ -48,7 +52,13 @@ func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, i
 					})
 			}
 		} else {
-			for _, address := range picp.Status.GatewayAddress {
+			addresses, hostnameResolved, err := ResolveAddresses(picp.Status.GatewayAddress, resolution)
+			if err != nil {
+				return nil, true, errors.WrapIfWithDetails(err, "could not resolve gateway address of peer Istio control plane", "name", picp.GetName())
+			}
+			resolved = resolved || hostnameResolved
+
+			for _, address := range addresses {
 				istiodEndpointAddresses = append(istiodEndpointAddresses,
 					corev1.EndpointAddress{
 						IP: address,
@@ -57,7 +67,29 @@ func GetIstiodEndpointAddresses(ctx context.Context, kubeClient client.Client, i
 		}
 	}
 
-	return istiodEndpointAddresses, nil
+	return istiodEndpointAddresses, resolved, nil
+}
+
+// GetAddressResolutionForService returns the address resolution matching the IP families of the service
+func GetAddressResolutionForService(service *corev1.Service) servicemeshv1alpha1.AddressResolution {
+	var ipv4, ipv6 bool
+	for _, family := range service.Spec.IPFamilies {
+		switch family {
+		case corev1.IPv4Protocol:
+			ipv4 = true
+		case corev1.IPv6Protocol:
+			ipv6 = true
+		}
+	}
+
+	switch {
+	case ipv4 && ipv6:
+		return servicemeshv1alpha1.AddressResolution_DualStack
+	case ipv6:
+		return servicemeshv1alpha1.AddressResolution_IPv6
+	default:
+		return servicemeshv1alpha1.AddressResolution_IPv4
+	}
 }
 
 func GetIstiodEndpointPorts(ctx context.Context, kubeClient client.Client, serviceName string, serviceNamespace string) ([]corev1.EndpointPort, error) {
@@ -26,6 +26,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
 )
 
 const (
@@ -39,8 +41,11 @@ func (e IngressSetupPendingError) Error() string {
 	return "ingress gateway endpoint address is pending"
 }
 
-func GetServiceEndpointIPs(service corev1.Service) ([]string, bool, error) {
-	ips := make([]string, 0)
+// GetServiceEndpointAddresses returns the addresses the service is reachable on. The hostname of the load balancer is
+// returned as is or resolved to its IP addresses depending on the address resolution, the returned bool reports
+// whether the addresses were resolved from a hostname and therefore could change without the service being updated.
+func GetServiceEndpointAddresses(service corev1.Service, resolution servicemeshv1alpha1.AddressResolution) ([]string, bool, error) {
+	addresses := make([]string, 0)
 
 	// check whether the load balancer was assigned
 	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) < 1 {
@@ -54,55 +59,93 @@ func GetServiceEndpointIPs(service corev1.Service) ([]string, bool, error) {
 
 	// hostname is overridden by annotation
 	if overriddenHostname, ok := service.GetAnnotations()[serviceHostnameOverrideAnnotation]; ok && overriddenHostname != "" {
-		hostIPs, err := getIPsForHostname(overriddenHostname)
-		if err != nil {
-			return nil, true, err
-		}
-		ips = append(ips, hostIPs...)
-
-		return ips, true, nil
+		return getAddressesForHostname(overriddenHostname, resolution)
 	}
 
 	switch service.Spec.Type {
 	case corev1.ServiceTypeClusterIP:
 		if service.Spec.ClusterIP != corev1.ClusterIPNone {
-			ips = []string{
+			addresses = []string{
 				service.Spec.ClusterIP,
 			}
 		}
 	case corev1.ServiceTypeLoadBalancer:
 		if service.Status.LoadBalancer.Ingress[0].IP != "" {
-			ips = []string{
+			addresses = []string{
 				service.Status.LoadBalancer.Ingress[0].IP,
 			}
 		} else if service.Status.LoadBalancer.Ingress[0].Hostname != "" {
-			hostIPs, err := getIPsForHostname(service.Status.LoadBalancer.Ingress[0].Hostname)
-			if err != nil {
-				return nil, true, err
-			}
-			ips = append(ips, hostIPs...)
+			return getAddressesForHostname(service.Status.LoadBalancer.Ingress[0].Hostname, resolution)
+		}
+	}
+
+	return addresses, false, nil
+}
+
+// ResolveAddresses resolves the hostnames among the addresses to their IP addresses, the returned bool reports
+// whether any of the addresses was a hostname
+func ResolveAddresses(addresses []string, resolution servicemeshv1alpha1.AddressResolution) ([]string, bool, error) {
+	ips := make([]string, 0, len(addresses))
+	resolved := false
+
+	for _, address := range addresses {
+		if net.ParseIP(address) != nil {
+			ips = append(ips, address)
+
+			continue
+		}
 
-			return ips, true, nil
+		hostIPs, err := getIPsForHostname(address, resolution)
+		if err != nil {
+			return nil, true, err
 		}
+		ips = append(ips, hostIPs...)
+		resolved = true
 	}
 
-	return ips, false, nil
+	return ips, resolved, nil
 }
 
-func getIPsForHostname(hostname string) ([]string, error) {
+func getAddressesForHostname(hostname string, resolution servicemeshv1alpha1.AddressResolution) ([]string, bool, error) {
+	if resolution == servicemeshv1alpha1.AddressResolution_Hostname {
+		return []string{hostname}, false, nil
+	}
+
+	ips, err := getIPsForHostname(hostname, resolution)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return ips, true, nil
+}
+
+func getIPsForHostname(hostname string, resolution servicemeshv1alpha1.AddressResolution) ([]string, error) {
 	ips := make([]string, 0)
 
 	hostIPs, err := net.LookupIP(hostname)
 	if err != nil {
-		return ips, err
+		return ips, errors.WrapIfWithDetails(err, "could not resolve hostname", "hostname", hostname)
 	}
 	sort.Slice(hostIPs, func(i, j int) bool {
 		return bytes.Compare(hostIPs[i], hostIPs[j]) < 0
 	})
 	for _, ip := range hostIPs {
-		if ip.To4() != nil {
-			ips = append(ips, ip.String())
+		isIPv4 := ip.To4() != nil
+		switch resolution {
+		case servicemeshv1alpha1.AddressResolution_IPv4:
+			if !isIPv4 {
+				continue
+			}
+		case servicemeshv1alpha1.AddressResolution_IPv6:
+			if isIPv4 {
+				continue
+			}
 		}
+		ips = append(ips, ip.String())
+	}
+
+	if len(ips) == 0 {
+		return ips, errors.NewWithDetails("hostname has no address of the requested family", "hostname", hostname, "resolution", resolution.String())
 	}
 
 	return ips, nil
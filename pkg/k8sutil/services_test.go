@@ -0,0 +1,159 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil_test
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicemeshv1alpha1 "github.com/banzaicloud/istio-operator/api/v2/v1alpha1"
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+)
+
+func newLoadBalancerService(annotations map[string]string, ingress ...corev1.LoadBalancerIngress) corev1.Service {
+	return corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "istio-meshexpansion-gateway",
+			Namespace:   "istio-system",
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: ingress,
+			},
+		},
+	}
+}
+
+func TestGetServiceEndpointAddresses(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		service           corev1.Service
+		resolution        servicemeshv1alpha1.AddressResolution
+		expectedAddresses []string
+		expectedResolved  bool
+		expectedErr       bool
+	}{
+		{
+			name:        "pending load balancer",
+			service:     newLoadBalancerService(nil),
+			expectedErr: true,
+		},
+		{
+			name:              "load balancer ip",
+			service:           newLoadBalancerService(nil, corev1.LoadBalancerIngress{IP: "10.10.10.10"}),
+			expectedAddresses: []string{"10.10.10.10"},
+		},
+		{
+			name:              "load balancer hostname is kept",
+			service:           newLoadBalancerService(nil, corev1.LoadBalancerIngress{Hostname: "gateway.example.com"}),
+			expectedAddresses: []string{"gateway.example.com"},
+		},
+		{
+			name: "hostname override is kept",
+			service: newLoadBalancerService(map[string]string{
+				"service.banzaicloud.io/hostname-override": "east-west.example.com",
+			}, corev1.LoadBalancerIngress{IP: "10.10.10.10"}),
+			expectedAddresses: []string{"east-west.example.com"},
+		},
+		{
+			name: "ip address override",
+			service: newLoadBalancerService(map[string]string{
+				"service.banzaicloud.io/ip-address-override": "10.20.30.40",
+			}, corev1.LoadBalancerIngress{Hostname: "gateway.example.com"}),
+			resolution:        servicemeshv1alpha1.AddressResolution_IPv4,
+			expectedAddresses: []string{"10.20.30.40"},
+		},
+		{
+			name:              "load balancer hostname is resolved",
+			service:           newLoadBalancerService(nil, corev1.LoadBalancerIngress{Hostname: "localhost"}),
+			resolution:        servicemeshv1alpha1.AddressResolution_IPv4,
+			expectedAddresses: []string{"127.0.0.1"},
+			expectedResolved:  true,
+		},
+		{
+			name: "cluster ip",
+			service: corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type:      corev1.ServiceTypeClusterIP,
+					ClusterIP: "10.96.0.10",
+				},
+			},
+			expectedAddresses: []string{"10.96.0.10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			addresses, resolved, err := k8sutil.GetServiceEndpointAddresses(tc.service, tc.resolution)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := pretty.Compare(addresses, tc.expectedAddresses); diff != "" {
+				t.Errorf("unexpected addresses (-got +want):\n%s", diff)
+			}
+			if resolved != tc.expectedResolved {
+				t.Errorf("expected resolved to be %t, got %t", tc.expectedResolved, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveAddresses(t *testing.T) {
+	t.Parallel()
+
+	addresses, resolved, err := k8sutil.ResolveAddresses([]string{"10.10.10.10", "fd00::10"}, servicemeshv1alpha1.AddressResolution_IPv4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolved {
+		t.Error("IP addresses should not be reported as resolved")
+	}
+	if diff := pretty.Compare(addresses, []string{"10.10.10.10", "fd00::10"}); diff != "" {
+		t.Errorf("unexpected addresses (-got +want):\n%s", diff)
+	}
+
+	addresses, resolved, err = k8sutil.ResolveAddresses([]string{"localhost"}, servicemeshv1alpha1.AddressResolution_IPv4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resolved {
+		t.Error("hostname should be reported as resolved")
+	}
+	if diff := pretty.Compare(addresses, []string{"127.0.0.1"}); diff != "" {
+		t.Errorf("unexpected addresses (-got +want):\n%s", diff)
+	}
+}
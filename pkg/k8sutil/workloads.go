@@ -0,0 +1,161 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RestartedAtAnnotation is the annotation on the pod template of a workload which restarts its pods when it changes
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// WorkloadFilter decides whether a workload is affected by an operation based on its pod template
+type WorkloadFilter func(template *corev1.PodTemplateSpec) bool
+
+// RestartWorkloads triggers a rolling restart of the deployments, statefulsets and daemonsets of a namespace
+// the same way as kubectl rollout restart does, it returns the number of restarted workloads
+func RestartWorkloads(ctx context.Context, c client.Client, namespace string, filter WorkloadFilter) (int, error) {
+	workloads, err := listWorkloads(ctx, c, namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	restartedAt := time.Now().Format(time.RFC3339)
+
+	restarted := 0
+	for _, workload := range workloads {
+		template := podTemplateOf(workload)
+		if filter != nil && !filter(template) {
+			continue
+		}
+
+		patch := client.MergeFrom(workload.DeepCopyObject().(client.Object))
+		if template.Annotations == nil {
+			template.Annotations = make(map[string]string)
+		}
+		template.Annotations[RestartedAtAnnotation] = restartedAt
+
+		if err := c.Patch(ctx, workload, patch); err != nil {
+			return restarted, errors.WrapIfWithDetails(err, "could not restart workload",
+				"kind", workload.GetObjectKind().GroupVersionKind().Kind, "name", workload.GetName(), "namespace", namespace)
+		}
+		restarted++
+	}
+
+	return restarted, nil
+}
+
+// AreWorkloadsReady returns whether every deployment, statefulset and daemonset of a namespace has finished
+// its rollout and has all of its replicas available
+func AreWorkloadsReady(ctx context.Context, c client.Client, namespace string, filter WorkloadFilter) (bool, error) {
+	workloads, err := listWorkloads(ctx, c, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	for _, workload := range workloads {
+		if filter != nil && !filter(podTemplateOf(workload)) {
+			continue
+		}
+
+		if !IsWorkloadReady(workload) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// IsWorkloadReady returns whether the rollout of a deployment, statefulset or daemonset is finished
+func IsWorkloadReady(workload client.Object) bool {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		replicas := int32(1)
+		if w.Spec.Replicas != nil {
+			replicas = *w.Spec.Replicas
+		}
+
+		return w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdatedReplicas == replicas &&
+			w.Status.Replicas == replicas &&
+			w.Status.AvailableReplicas == replicas
+	case *appsv1.StatefulSet:
+		replicas := int32(1)
+		if w.Spec.Replicas != nil {
+			replicas = *w.Spec.Replicas
+		}
+
+		return w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdatedReplicas == replicas &&
+			w.Status.ReadyReplicas == replicas
+	case *appsv1.DaemonSet:
+		return w.Status.ObservedGeneration >= w.Generation &&
+			w.Status.UpdatedNumberScheduled == w.Status.DesiredNumberScheduled &&
+			w.Status.NumberAvailable == w.Status.DesiredNumberScheduled
+	}
+
+	return true
+}
+
+func listWorkloads(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	workloads := make([]client.Object, 0)
+
+	deployments := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list deployments", "namespace", namespace)
+	}
+	for i := range deployments.Items {
+		workloads = append(workloads, &deployments.Items[i])
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := c.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list statefulsets", "namespace", namespace)
+	}
+	for i := range statefulSets.Items {
+		workloads = append(workloads, &statefulSets.Items[i])
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := c.List(ctx, daemonSets, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "could not list daemonsets", "namespace", namespace)
+	}
+	for i := range daemonSets.Items {
+		workloads = append(workloads, &daemonSets.Items[i])
+	}
+
+	return workloads, nil
+}
+
+func podTemplateOf(workload client.Object) *corev1.PodTemplateSpec {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		return &w.Spec.Template
+	case *appsv1.StatefulSet:
+		return &w.Spec.Template
+	case *appsv1.DaemonSet:
+		return &w.Spec.Template
+	}
+
+	return &corev1.PodTemplateSpec{}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 Cisco Systems, Inc. and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8sutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/banzaicloud/istio-operator/v2/pkg/k8sutil"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func newDeployment(name string, annotations map[string]string, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(2),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+			},
+		},
+		Status: status,
+	}
+}
+
+func TestRestartWorkloads(t *testing.T) {
+	t.Parallel()
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		newDeployment("app", nil, appsv1.DeploymentStatus{}),
+		newDeployment("opted-out", map[string]string{"sidecar.istio.io/inject": "false"}, appsv1.DeploymentStatus{}),
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db",
+				Namespace: "default",
+			},
+		},
+	).Build()
+
+	filter := func(template *corev1.PodTemplateSpec) bool {
+		return template.GetAnnotations()["sidecar.istio.io/inject"] != "false"
+	}
+
+	restarted, err := k8sutil.RestartWorkloads(context.Background(), c, "default", filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(restarted, 2); diff != "" {
+		t.Errorf("diff: (-got +want)\n%s", diff)
+	}
+
+	for name, expected := range map[string]bool{"app": true, "opted-out": false} {
+		deployment := &appsv1.Deployment{}
+		if err := c.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "default"}, deployment); err != nil {
+			t.Fatal(err)
+		}
+
+		_, ok := deployment.Spec.Template.GetAnnotations()["kubectl.kubernetes.io/restartedAt"]
+		if diff := pretty.Compare(ok, expected); diff != "" {
+			t.Errorf("%s diff: (-got +want)\n%s", name, diff)
+		}
+	}
+}
+
+func TestIsWorkloadReady(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		workload client.Object
+		expected bool
+	}{
+		{
+			name: "rolled out deployment",
+			workload: newDeployment("app", nil, appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				AvailableReplicas:  2,
+			}),
+			expected: true,
+		},
+		{
+			name: "deployment with old replicas",
+			workload: newDeployment("app", nil, appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           3,
+				UpdatedReplicas:    2,
+				AvailableReplicas:  3,
+			}),
+			expected: false,
+		},
+		{
+			name: "deployment with unobserved generation",
+			workload: newDeployment("app", nil, appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   2,
+				AvailableReplicas: 2,
+			}),
+			expected: false,
+		},
+		{
+			name: "daemonset in progress",
+			workload: &appsv1.DaemonSet{
+				Status: appsv1.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					UpdatedNumberScheduled: 1,
+					NumberAvailable:        3,
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := pretty.Compare(k8sutil.IsWorkloadReady(tt.workload), tt.expected); diff != "" {
+				t.Errorf("diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}